@@ -23,13 +23,21 @@
 //
 //	# convert list of files to JSON Lines
 //	find . -name '*.go' | gss -i csv --input-header path -o jsonl
+//
+//	# select columns from a Parquet file
+//	gss -i parquet --input-uri data.parquet --input-columns name,population -o jsonl
+//
+//	# edit a value in a YAML file in place, keeping comments
+//	gss set values.yaml image.tag stable
 package main
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -39,10 +47,15 @@ import (
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/cli"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/cli/formats"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/cli/get"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/cli/set"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/cli/version"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/gob"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/gss"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/iterator"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/properties"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/writer"
@@ -92,6 +105,11 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 
 			inputLineSeparator := v.GetString(cli.FlagInputLineSeparator)
 
+			var inputLineSeparatorRegexp *regexp.Regexp
+			if str := v.GetString(cli.FlagInputLineSeparatorRegexp); len(str) > 0 {
+				inputLineSeparatorRegexp = regexp.MustCompile(str) // already validated by CheckInputConfig
+			}
+
 			outputFormat := v.GetString(cli.FlagOutputFormat)
 
 			outputHeader := stringify.StringSliceToInterfaceSlice(v.GetStringSlice(cli.FlagOutputHeader))
@@ -102,18 +120,24 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 			outputKeyValueSeparator := v.GetString(cli.FlagOutputKeyValueSeparator)
 			outputLineSeparator := v.GetString(cli.FlagOutputLineSeparator)
 
-			outputKeySerializer := stringify.NewStringer(
-				"",
+			outputKeySerializer := number.NewStringer(
+				stringify.NewStringer(
+					"",
+					v.GetBool(cli.FlagOutputDecimal),
+					v.GetBool(cli.FlagOutputKeyLower),
+					v.GetBool(cli.FlagOutputKeyUpper),
+				),
 				v.GetBool(cli.FlagOutputDecimal),
-				v.GetBool(cli.FlagOutputKeyLower),
-				v.GetBool(cli.FlagOutputKeyUpper),
 			)
 
-			outputValueSerializer := stringify.NewStringer(
-				v.GetString(cli.FlagOutputNoDataValue),
+			outputValueSerializer := number.NewStringer(
+				stringify.NewStringer(
+					v.GetString(cli.FlagOutputNoDataValue),
+					v.GetBool(cli.FlagOutputDecimal),
+					v.GetBool(cli.FlagOutputValueLower),
+					v.GetBool(cli.FlagOutputValueUpper),
+				),
 				v.GetBool(cli.FlagOutputDecimal),
-				v.GetBool(cli.FlagOutputValueLower),
-				v.GetBool(cli.FlagOutputValueUpper),
 			)
 
 			outputPretty := v.GetBool(cli.FlagOutputPretty)
@@ -122,6 +146,34 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 
 			outputLimit := v.GetInt(cli.FlagOutputLimit)
 
+			// If the output schema is not JSON, then read the schema from a file.
+			outputSchema := strings.TrimSpace(v.GetString(cli.FlagOutputSchema))
+			if len(outputSchema) > 0 && !strings.ContainsAny(outputSchema[0:1], "{[\"") {
+				b, err := ioutil.ReadFile(outputSchema)
+				if err != nil {
+					return errors.Wrap(err, "error reading output schema")
+				}
+				outputSchema = string(b)
+			}
+
+			// Read the descriptor sets of protobuf message types.
+			var inputDescriptorSet []byte
+			if path := v.GetString(cli.FlagInputDescriptorSet); len(path) > 0 {
+				b, err := ioutil.ReadFile(path)
+				if err != nil {
+					return errors.Wrap(err, "error reading input descriptor set")
+				}
+				inputDescriptorSet = b
+			}
+			var outputDescriptorSet []byte
+			if path := v.GetString(cli.FlagOutputDescriptorSet); len(path) > 0 {
+				b, err := ioutil.ReadFile(path)
+				if err != nil {
+					return errors.Wrap(err, "error reading output descriptor set")
+				}
+				outputDescriptorSet = b
+			}
+
 			verbose := v.GetBool(cli.FlagVerbose)
 
 			if verbose {
@@ -146,18 +198,39 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 				fmt.Println("")
 			}
 
-			fi, err := os.Stdin.Stat()
-			if err != nil {
-				return errors.Wrap(err, "error stating stdin")
-			}
+			var inputReader io.Reader = os.Stdin
+
+			if inputURI := v.GetString(cli.FlagInputURI); len(inputURI) > 0 {
+				f, err := os.Open(inputURI)
+				if err != nil {
+					return errors.Wrap(err, "error opening input file")
+				}
+				defer f.Close()
+				inputReader = f
+			} else {
+				fi, err := os.Stdin.Stat()
+				if err != nil {
+					return errors.Wrap(err, "error stating stdin")
+				}
 
-			if fi.Mode()&os.ModeNamedPipe == 0 {
-				return errors.New("no data provided on stdin")
+				if fi.Mode()&os.ModeNamedPipe == 0 {
+					return errors.New("no data provided on stdin")
+				}
 			}
 
+			inputColumns := v.GetStringSlice(cli.FlagInputColumns)
+
 			noStream := v.GetBool("no-stream")
 
-			if (!noStream) && gss.CanStream(inputFormat, outputFormat, outputSorted) {
+			inputLimits := limits.Limits{
+				MaxRecordBytes: v.GetInt(cli.FlagInputMaxRecordBytes),
+				MaxDepth:       v.GetInt(cli.FlagInputMaxDepth),
+				MaxKeys:        v.GetInt(cli.FlagInputMaxKeys),
+				MaxTotalBytes:  v.GetInt(cli.FlagInputMaxTotalBytes),
+				MaxAliases:     v.GetInt(cli.FlagInputMaxAliases),
+			}
+
+			if (!noStream) && gss.CanStreamWithHeader(inputFormat, outputFormat, outputHeader, outputSorted) {
 
 				if verbose {
 					fmt.Println("Streaming: yes")
@@ -168,7 +241,7 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 				var inputType reflect.Type
 				if inputFormat == "gob" {
 					inputType = mapStringInterfaceType
-				} else if inputFormat == "csv" || inputFormat == "tsv" {
+				} else if inputFormat == "csv" || inputFormat == "tsv" || inputFormat == "xlsx" {
 					inputType = mapStringStringType
 				}
 
@@ -177,18 +250,34 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 				}
 
 				it, errorIterator := iterator.NewIterator(&iterator.NewIteratorInput{
-					Reader:            os.Stdin,
-					Type:              inputType,
-					Format:            inputFormat,
-					Header:            inputHeader,
-					ScannerBufferSize: v.GetInt(cli.FlagInputScannerBufferSize),
-					SkipLines:         v.GetInt(cli.FlagInputSkipLines),
-					SkipBlanks:        true,
-					SkipComments:      true,
-					Comment:           v.GetString(cli.FlagInputComment),
-					Trim:              v.GetBool(cli.FlagInputTrim),
-					LineSeparator:     inputLineSeparator,
-					DropCR:            v.GetBool(cli.FlagInputDropCR),
+					Reader:              inputReader,
+					Type:                inputType,
+					Format:              inputFormat,
+					Header:              inputHeader,
+					ScannerBufferSize:   v.GetInt(cli.FlagInputScannerBufferSize),
+					SkipLines:           v.GetInt(cli.FlagInputSkipLines),
+					SkipBlanks:          true,
+					SkipComments:        true,
+					Comment:             v.GetString(cli.FlagInputComment),
+					Trim:                v.GetBool(cli.FlagInputTrim),
+					LineSeparator:       inputLineSeparator,
+					LineSeparatorRegexp: inputLineSeparatorRegexp,
+					DropCR:              v.GetBool(cli.FlagInputDropCR),
+					NumberMode:          v.GetString(cli.FlagInputNumber),
+					Strict:              v.GetBool(cli.FlagInputStrict),
+					Limits:              inputLimits,
+					XMLPath:             v.GetString(cli.FlagInputXMLPath),
+					Pattern:             v.GetString(cli.FlagInputPattern),
+					NoMatch:             v.GetString(cli.FlagInputNoMatch),
+					Columns:             inputColumns,
+					Sheet:               v.GetString(cli.FlagInputSheet),
+					DescriptorSet:       inputDescriptorSet,
+					Message:             v.GetString(cli.FlagInputMessage),
+					Flatten:             v.GetBool(cli.FlagInputFlatten),
+					Longitude:           v.GetString(cli.FlagInputLongitude),
+					Latitude:            v.GetString(cli.FlagInputLatitude),
+					WKT:                 v.GetString(cli.FlagInputWKT),
+					Encoding:            v.GetString(cli.FlagInputEncoding),
 				})
 				if errorIterator != nil {
 					return errors.Wrap(errorIterator, "error creating input iterator: %w")
@@ -215,6 +304,29 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 					Pretty:            outputPretty,
 					Sorted:            outputSorted,
 					Reversed:          outputReversed,
+					Borders:           v.GetString(cli.FlagOutputBorders),
+					Align:             v.GetString(cli.FlagOutputAlign),
+					MaxWidth:          v.GetInt(cli.FlagOutputMaxWidth),
+					Wrap:              v.GetBool(cli.FlagOutputWrap),
+					Schema:            outputSchema,
+					Codec:             v.GetString(cli.FlagOutputCodec),
+					SampleSize:        v.GetInt(cli.FlagOutputSchemaSample),
+					Sheet:             v.GetString(cli.FlagOutputSheet),
+					SheetKey:          v.GetString(cli.FlagOutputSheetKey),
+					AutoFilter:        v.GetBool(cli.FlagOutputAutoFilter),
+					Table:             v.GetString(cli.FlagOutputTable),
+					Dialect:           v.GetString(cli.FlagOutputDialect),
+					Statement:         v.GetString(cli.FlagOutputStatement),
+					BatchSize:         v.GetInt(cli.FlagOutputBatchSize),
+					CreateTable:       v.GetBool(cli.FlagOutputCreateTable),
+					DescriptorSet:     outputDescriptorSet,
+					Message:           v.GetString(cli.FlagOutputMessage),
+					Longitude:         v.GetString(cli.FlagOutputLongitude),
+					Latitude:          v.GetString(cli.FlagOutputLatitude),
+					WKT:               v.GetString(cli.FlagOutputWKT),
+					ExtendedJSON:      v.GetString(cli.FlagOutputExtendedJSON),
+					Encoding:          v.GetString(cli.FlagOutputEncoding),
+					BOM:               v.GetBool(cli.FlagOutputBOM),
 				})
 				if errWriter != nil {
 					return errors.Wrap(errWriter, "error building output writer")
@@ -231,62 +343,109 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 				return nil
 			}
 
-			inputBytes, err := ioutil.ReadAll(os.Stdin)
+			inputBytes, err := ioutil.ReadAll(limits.NewReader(inputReader, inputLimits.MaxTotalBytes))
 			if err != nil {
-				return errors.Wrap(err, "error reading from stdin")
+				return errors.Wrap(err, "error reading input")
 			}
 
 			var inputType reflect.Type
 			if inputFormat == "gob" {
 				inputType = sliceMapStringInterfaceType
-			} else if inputFormat == "csv" || inputFormat == "tsv" {
+			} else if inputFormat == "csv" || inputFormat == "tsv" || inputFormat == "xlsx" {
 				inputType = sliceMapStringStringType
 			}
 
 			outputBytes, err := gss.Convert(&gss.ConvertInput{
-				InputBytes:              inputBytes,
-				InputFormat:             inputFormat,
-				InputHeader:             inputHeader,
-				InputComment:            v.GetString(cli.FlagInputComment),
-				InputLazyQuotes:         v.GetBool(cli.FlagInputLazyQuotes),
-				InputScannerBufferSize:  v.GetInt(cli.FlagInputScannerBufferSize),
-				InputSkipLines:          v.GetInt(cli.FlagInputSkipLines),
-				InputLimit:              v.GetInt(cli.FlagInputLimit),
-				InputLineSeparator:      inputLineSeparator,
-				InputDropCR:             v.GetBool(cli.FlagInputDropCR),
-				InputEscapePrefix:       v.GetString(cli.FlagInputEscapePrefix),
-				InputUnescapeSpace:      v.GetBool(cli.FlagInputUnescapeSpace),
-				InputUnescapeNewLine:    v.GetBool(cli.FlagInputUnescapeNewLine),
-				InputUnescapeEqual:      v.GetBool(cli.FlagInputUnescapeEqual),
-				InputTrim:               v.GetBool(cli.FlagInputTrim),
-				InputType:               inputType,
-				OutputFormat:            outputFormat,
-				OutputFormatSpecifier:   v.GetString(cli.FlagOutputFormatSpecifier),
-				OutputFit:               outputFit,
-				OutputHeader:            outputHeader,
-				OutputLimit:             outputLimit,
-				OutputPretty:            v.GetBool(cli.FlagOutputPretty),
-				OutputSorted:            outputSorted,
-				OutputReversed:          outputReversed,
-				OutputKeySerializer:     outputKeySerializer,
-				OutputValueSerializer:   outputValueSerializer,
-				OutputLineSeparator:     outputLineSeparator,
-				OutputKeyValueSeparator: outputKeyValueSeparator,
-				OutputEscapePrefix:      v.GetString(cli.FlagOutputEscapePrefix),
-				OutputEscapeSpace:       v.GetBool(cli.FlagOutputEscapeSpace),
-				OutputEscapeNewLine:     v.GetBool(cli.FlagOutputEscapeNewLine),
-				OutputEscapeEqual:       v.GetBool(cli.FlagOutputEscapeEqual),
+				InputBytes:               inputBytes,
+				InputFormat:              inputFormat,
+				InputHeader:              inputHeader,
+				InputComment:             v.GetString(cli.FlagInputComment),
+				InputLazyQuotes:          v.GetBool(cli.FlagInputLazyQuotes),
+				InputScannerBufferSize:   v.GetInt(cli.FlagInputScannerBufferSize),
+				InputSkipLines:           v.GetInt(cli.FlagInputSkipLines),
+				InputLimit:               v.GetInt(cli.FlagInputLimit),
+				InputLineSeparator:       inputLineSeparator,
+				InputLineSeparatorRegexp: inputLineSeparatorRegexp,
+				InputDropCR:              v.GetBool(cli.FlagInputDropCR),
+				InputEscapePrefix:        v.GetString(cli.FlagInputEscapePrefix),
+				InputUnescapeSpace:       v.GetBool(cli.FlagInputUnescapeSpace),
+				InputUnescapeNewLine:     v.GetBool(cli.FlagInputUnescapeNewLine),
+				InputUnescapeEqual:       v.GetBool(cli.FlagInputUnescapeEqual),
+				InputTrim:                v.GetBool(cli.FlagInputTrim),
+				InputType:                inputType,
+				InputNumberMode:          v.GetString(cli.FlagInputNumber),
+				InputStrict:              v.GetBool(cli.FlagInputStrict),
+				InputLimits:              inputLimits,
+				InputXMLPath:             v.GetString(cli.FlagInputXMLPath),
+				InputPattern:             v.GetString(cli.FlagInputPattern),
+				InputNoMatch:             v.GetString(cli.FlagInputNoMatch),
+				InputColumns:             inputColumns,
+				InputSheet:               v.GetString(cli.FlagInputSheet),
+				InputDescriptorSet:       inputDescriptorSet,
+				InputMessage:             v.GetString(cli.FlagInputMessage),
+				InputFlatten:             v.GetBool(cli.FlagInputFlatten),
+				InputLongitude:           v.GetString(cli.FlagInputLongitude),
+				InputLatitude:            v.GetString(cli.FlagInputLatitude),
+				InputWKT:                 v.GetString(cli.FlagInputWKT),
+				InputEncoding:            v.GetString(cli.FlagInputEncoding),
+				OutputFormat:             outputFormat,
+				OutputFormatSpecifier:    v.GetString(cli.FlagOutputFormatSpecifier),
+				OutputFit:                outputFit,
+				OutputHeader:             outputHeader,
+				OutputLimit:              outputLimit,
+				OutputPretty:             v.GetBool(cli.FlagOutputPretty),
+				OutputSorted:             outputSorted,
+				OutputReversed:           outputReversed,
+				OutputKeySerializer:      outputKeySerializer,
+				OutputValueSerializer:    outputValueSerializer,
+				OutputLineSeparator:      outputLineSeparator,
+				OutputKeyValueSeparator:  outputKeyValueSeparator,
+				OutputEscapePrefix:       v.GetString(cli.FlagOutputEscapePrefix),
+				OutputEscapeSpace:        v.GetBool(cli.FlagOutputEscapeSpace),
+				OutputEscapeNewLine:      v.GetBool(cli.FlagOutputEscapeNewLine),
+				OutputEscapeEqual:        v.GetBool(cli.FlagOutputEscapeEqual),
+				OutputBorders:            v.GetString(cli.FlagOutputBorders),
+				OutputAlign:              v.GetString(cli.FlagOutputAlign),
+				OutputMaxWidth:           v.GetInt(cli.FlagOutputMaxWidth),
+				OutputWrap:               v.GetBool(cli.FlagOutputWrap),
+				OutputSchema:             outputSchema,
+				OutputCodec:              v.GetString(cli.FlagOutputCodec),
+				OutputSampleSize:         v.GetInt(cli.FlagOutputSchemaSample),
+				OutputSheet:              v.GetString(cli.FlagOutputSheet),
+				OutputSheetKey:           v.GetString(cli.FlagOutputSheetKey),
+				OutputAutoFilter:         v.GetBool(cli.FlagOutputAutoFilter),
+				OutputTable:              v.GetString(cli.FlagOutputTable),
+				OutputDialect:            v.GetString(cli.FlagOutputDialect),
+				OutputStatement:          v.GetString(cli.FlagOutputStatement),
+				OutputBatchSize:          v.GetInt(cli.FlagOutputBatchSize),
+				OutputCreateTable:        v.GetBool(cli.FlagOutputCreateTable),
+				OutputDescriptorSet:      outputDescriptorSet,
+				OutputMessage:            v.GetString(cli.FlagOutputMessage),
+				OutputPlistFormat:        v.GetString(cli.FlagOutputPlistFormat),
+				OutputLongitude:          v.GetString(cli.FlagOutputLongitude),
+				OutputLatitude:           v.GetString(cli.FlagOutputLatitude),
+				OutputWKT:                v.GetString(cli.FlagOutputWKT),
+				OutputExtendedJSON:       v.GetString(cli.FlagOutputExtendedJSON),
+				OutputEncoding:           v.GetString(cli.FlagOutputEncoding),
+				OutputBOM:                v.GetBool(cli.FlagOutputBOM),
 			})
 			if err != nil {
 				return errors.Wrap(err, "error converting")
 			}
 			switch outputFormat {
-			case serializer.FormatCSV, serializer.FormatJSONL, serializer.FormatProperties, serializer.FormatTags, serializer.FormatTOML, serializer.FormatTSV, serializer.FormatYAML:
+			case serializer.FormatCSV, serializer.FormatDotEnv, serializer.FormatEnv, serializer.FormatFixedWidth, serializer.FormatGeoJSON, serializer.FormatGeoJSONL, serializer.FormatHTML, serializer.FormatINI, serializer.FormatJSONL, serializer.FormatJSONSeq, serializer.FormatLogfmt, serializer.FormatMarkdown, serializer.FormatPlist, serializer.FormatProperties, serializer.FormatSQL, serializer.FormatTable, serializer.FormatTags, serializer.FormatTOML, serializer.FormatTSV, serializer.FormatYAML:
 				// do not include trailing new line, since it comes with the output
 				fmt.Print(string(outputBytes))
+			case serializer.FormatAvro, serializer.FormatBSON, serializer.FormatCBOR, serializer.FormatMsgPack, serializer.FormatParquet, serializer.FormatProtobuf, serializer.FormatXLSX:
+				// do not include trailing new line, since it would be read as another message
+				fmt.Print(string(outputBytes))
 			default:
-				// print trailing new line for all others
-				fmt.Println(string(outputBytes))
+				// print trailing new line for all others, using the encoding of the output
+				newLine, err := encoding.Encode([]byte("\n"), v.GetString(cli.FlagOutputEncoding), false)
+				if err != nil {
+					return errors.Wrap(err, "error encoding new line")
+				}
+				fmt.Print(string(outputBytes) + string(newLine))
 			}
 			return nil
 		},
@@ -316,6 +475,10 @@ Supports the following file formats: ` + strings.Join(gss.Formats, ", "),
 
 	rootCommand.AddCommand(formats.NewCommand())
 
+	rootCommand.AddCommand(get.NewCommand())
+
+	rootCommand.AddCommand(set.NewCommand())
+
 	rootCommand.AddCommand(version.NewCommand(&version.NewCommandInput{
 		GitBranch: gitBranch,
 		GitCommit: gitCommit,
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCodec is used when the compression codec is not one of the supported codecs.
+type ErrInvalidCodec struct {
+	Value string // the invalid codec
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidCodec) Error() string {
+	return fmt.Sprintf("invalid codec %q, expecting one of %s", e.Value, strings.Join(Codecs, ", "))
+}
@@ -0,0 +1,202 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// inferred is the union of types observed for a value.
+type inferred struct {
+	null    bool
+	boolean bool
+	long    bool
+	double  bool
+	str     bool
+	bytes   bool
+	fields  []string             // the names of the fields of the record in the order first observed
+	record  map[string]*inferred // the fields of the record, if any objects were observed with valid names
+	array   *inferred            // the items of the array, if any arrays were observed
+	values  *inferred            // the values of the map, if any objects were observed with invalid names
+}
+
+// observe adds the type of the given value to the inferred type.
+func (t *inferred) observe(value interface{}) {
+	value = indirect(value)
+	switch v := value.(type) {
+	case nil:
+		t.null = true
+		return
+	case bool:
+		t.boolean = true
+		return
+	case string:
+		t.str = true
+		return
+	case []byte:
+		t.bytes = true
+		return
+	case time.Time:
+		t.str = true
+		return
+	case stdjson.Number:
+		if _, err := v.Int64(); err == nil {
+			t.long = true
+		} else {
+			t.double = true
+		}
+		return
+	case float32:
+		t.observeFloat(float64(v))
+		return
+	case float64:
+		t.observeFloat(v)
+		return
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		t.long = true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		t.long = true
+	case reflect.Array, reflect.Slice:
+		if t.array == nil {
+			t.array = &inferred{}
+		}
+		for i := 0; i < rv.Len(); i++ {
+			t.array.observe(rv.Index(i).Interface())
+		}
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		values := make(map[string]interface{}, rv.Len())
+		valid := rv.Len() > 0
+		it := rv.MapRange()
+		for it.Next() {
+			k := fmt.Sprint(it.Key().Interface())
+			if !name.MatchString(k) {
+				valid = false
+			}
+			keys = append(keys, k)
+			values[k] = it.Value().Interface()
+		}
+		sort.Strings(keys)
+		if !valid {
+			if t.values == nil {
+				t.values = &inferred{}
+			}
+			for _, k := range keys {
+				t.values.observe(values[k])
+			}
+			return
+		}
+		if t.record == nil {
+			t.record = map[string]*inferred{}
+		}
+		for _, k := range keys {
+			field, ok := t.record[k]
+			if !ok {
+				field = &inferred{}
+				t.record[k] = field
+				t.fields = append(t.fields, k)
+			}
+			field.observe(values[k])
+		}
+	default:
+		t.str = true
+	}
+}
+
+func (t *inferred) observeFloat(f float64) {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+		t.long = true
+	} else {
+		t.double = true
+	}
+}
+
+// schema returns the Avro schema for the inferred type.
+// If nullable is true, then the schema is a union that includes null, e.g., for a field of a record.
+func (t *inferred) schema(recordName string, nullable bool) interface{} {
+	branches := make([]interface{}, 0)
+	if nullable || t.null {
+		branches = append(branches, "null")
+	}
+	if t.boolean {
+		branches = append(branches, "boolean")
+	}
+	if t.double {
+		branches = append(branches, "double")
+	} else if t.long {
+		branches = append(branches, "long")
+	}
+	if t.str {
+		branches = append(branches, "string")
+	}
+	if t.bytes {
+		branches = append(branches, "bytes")
+	}
+	if t.record != nil {
+		fields := make([]interface{}, 0, len(t.fields))
+		for _, f := range t.fields {
+			fields = append(fields, map[string]interface{}{
+				"name":    f,
+				"type":    t.record[f].schema(recordName+"_"+f, true),
+				"default": nil,
+			})
+		}
+		branches = append(branches, map[string]interface{}{
+			"type":   "record",
+			"name":   recordName,
+			"fields": fields,
+		})
+	}
+	if t.array != nil {
+		branches = append(branches, map[string]interface{}{
+			"type":  "array",
+			"items": t.array.schema(recordName+"_item", false),
+		})
+	}
+	if t.values != nil {
+		branches = append(branches, map[string]interface{}{
+			"type":   "map",
+			"values": t.values.schema(recordName+"_value", false),
+		})
+	}
+	switch len(branches) {
+	case 0:
+		// No values were observed, e.g., the items of an empty array.
+		return "null"
+	case 1:
+		return branches[0]
+	}
+	return branches
+}
+
+// InferSchema returns an Avro schema, formatted as JSON, that can be used to write the given objects.
+// Objects become records named "Record", with every field a union with null that defaults to null.
+// Nested objects become nested records named after the path to the field, e.g., "Record_address",
+// unless their keys are not valid Avro names, in which case they become maps.
+// Integers become longs, other numbers become doubles, and times become strings.
+func InferSchema(objects []interface{}) (string, error) {
+	t := &inferred{}
+	for _, obj := range objects {
+		t.observe(obj)
+	}
+	b, err := stdjson.Marshal(t.schema(DefaultRecordName, false))
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling schema")
+	}
+	return string(b), nil
+}
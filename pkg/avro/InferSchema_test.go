@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferSchema(t *testing.T) {
+	schema, err := InferSchema([]interface{}{
+		map[string]interface{}{"a": "x", "b": 1, "c": map[string]interface{}{"d": true}},
+		map[string]interface{}{"a": nil, "b": 1.5, "e": []interface{}{"y"}, "f": map[string]interface{}{"not a name": 2}},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "record",
+		"name": "Record",
+		"fields": [
+			{"name": "a", "type": ["null", "string"], "default": null},
+			{"name": "b", "type": ["null", "double"], "default": null},
+			{"name": "c", "type": ["null", {"type": "record", "name": "Record_c", "fields": [
+				{"name": "d", "type": ["null", "boolean"], "default": null}
+			]}], "default": null},
+			{"name": "e", "type": ["null", {"type": "array", "items": "string"}], "default": null},
+			{"name": "f", "type": ["null", {"type": "map", "values": "long"}], "default": null}
+		]
+	}`, schema)
+}
+
+func TestInferSchemaEmpty(t *testing.T) {
+	schema, err := InferSchema([]interface{}{})
+	require.NoError(t, err)
+	require.Equal(t, `"null"`, schema)
+}
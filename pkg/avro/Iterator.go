@@ -0,0 +1,103 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates trough the records of an Avro object container file
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type   reflect.Type      // the type of each object.  If nil, then returns the values as decoded.
+	Reader *goavro.OCFReader // the reader that decodes the blocks of the underlying stream
+	Limit  int               // Limit the number of objects to read and return from the underlying stream.
+	Count  int               // The current count of the number of objects read.
+	Limits limits.Limits     // The maximum depth and number of keys of each object.
+	schema *schema           // the schema embedded in the file
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader io.Reader
+	Type   reflect.Type  // the type of each object, e.g., map[string]interface{}.  If nil, then returns the values as decoded.
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Limits limits.Limits // The maximum depth and number of keys of each object.  Only MaxDepth and MaxKeys are used.
+}
+
+// NewIterator returns a new Avro object container file iterator base on the given input.
+// NewIterator reads the header of the file and returns an error if the header is invalid.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+	r, err := goavro.NewOCFReader(input.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading header of object container file")
+	}
+	s, err := parseSchema(r.Codec().Schema())
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing schema of object container file")
+	}
+	return &Iterator{
+		Type:   input.Type,
+		Reader: r,
+		Limit:  input.Limit,
+		Count:  0,
+		Limits: input.Limits,
+		schema: s,
+	}, nil
+}
+
+// Schema returns the schema embedded in the object container file formatted as JSON.
+func (it *Iterator) Schema() string {
+	return it.Reader.Codec().Schema()
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	if !it.Reader.Scan() {
+		if err := it.Reader.Err(); err != nil {
+			return nil, errors.Wrapf(err, "error reading record %d", it.Count+1)
+		}
+		return nil, io.EOF
+	}
+
+	// Increment Counter
+	it.Count++
+
+	datum, err := it.Reader.Read()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding record %d", it.Count)
+	}
+
+	obj := it.schema.fromNative(it.schema.root, "", datum)
+
+	if it.Type != nil && obj != nil {
+		if t := reflect.TypeOf(obj); !t.AssignableTo(it.Type) {
+			return nil, errors.Errorf("error decoding record %d: cannot decode %s into %s", it.Count, t, it.Type)
+		}
+	}
+
+	if err := it.Limits.Check(obj); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of record %d", it.Count)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,89 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func testFile(t *testing.T) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	ocf, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W: buf,
+		Schema: `{
+			"type": "record",
+			"name": "A",
+			"fields": [
+				{"name": "a", "type": ["null", "string"]},
+				{"name": "b", "type": {"type": "array", "items": ["null", {"type": "record", "name": "B", "fields": [{"name": "c", "type": "int"}]}]}}
+			]
+		}`,
+		CompressionName: CodecSnappy,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ocf.Append([]interface{}{
+		map[string]interface{}{"a": goavro.Union("string", "x"), "b": []interface{}{goavro.Union("B", map[string]interface{}{"c": 1})}},
+		map[string]interface{}{"a": nil, "b": []interface{}{nil}},
+		map[string]interface{}{"a": goavro.Union("string", "z"), "b": []interface{}{}},
+	}))
+	return buf
+}
+
+func TestIterator(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]interface{}{}),
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "x", "b": []interface{}{map[string]interface{}{"c": int32(1)}}}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": nil, "b": []interface{}{nil}}, obj)
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Limits: limits.Limits{MaxKeys: 1},
+	})
+	require.NoError(t, err)
+	_, err = it.Next()
+	require.Error(t, err)
+}
+
+func TestIteratorType(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]string{}),
+	})
+	require.NoError(t, err)
+	_, err = it.Next()
+	require.Error(t, err)
+}
+
+func TestIteratorInvalid(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader("{\"a\": 1}\n"),
+	})
+	require.Error(t, err)
+}
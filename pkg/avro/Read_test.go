@@ -0,0 +1,29 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Type:   reflect.TypeOf([]map[string]interface{}{}),
+		Reader: testFile(t),
+		Limit:  -1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"a": "x", "b": []interface{}{map[string]interface{}{"c": int32(1)}}},
+		{"a": nil, "b": []interface{}{nil}},
+		{"a": "z", "b": []interface{}{}},
+	}, out)
+}
@@ -0,0 +1,65 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input parameters for the Write function.
+type WriteInput struct {
+	Writer        io.Writer          // the underlying writer
+	Schema        string             // the schema formatted as JSON.  If empty, the schema is inferred.
+	Codec         string             // the compression codec for blocks: null, deflate, or snappy.
+	SampleSize    int                // the number of objects used to infer the schema.
+	KeySerializer stringify.Stringer // serializer for object keys
+	Object        interface{}        // the object or slice of objects to write
+	Limit         int                // the maximum number of objects to write
+}
+
+// Write writes the given object(s) as an Avro object container file.
+// If the type of the input object is of kind Array or Slice, then writes each object as a record.
+// Otherwise, writes the object as a single record.
+func Write(input *WriteInput) error {
+	w, err := NewWriter(input.Writer, input.Schema, input.Codec, input.SampleSize, input.KeySerializer)
+	if err != nil {
+		return errors.Wrap(err, "error creating writer")
+	}
+	value := reflect.ValueOf(input.Object)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		if _, ok := input.Object.([]byte); !ok {
+			for i := 0; i < value.Len(); i++ {
+				if input.Limit > 0 && i >= input.Limit {
+					break
+				}
+				if err := w.WriteObject(value.Index(i).Interface()); err != nil {
+					return errors.Wrapf(err, "error writing object %d", i)
+				}
+			}
+			break
+		}
+		fallthrough
+	default:
+		if err := w.WriteObject(input.Object); err != nil {
+			return errors.Wrap(err, "error writing object")
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "error flushing writer")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Codec:  CodecDeflate,
+		Object: []map[string]interface{}{
+			{"a": "x", "b": map[string]interface{}{"c": 1.5}},
+			{"a": "y", "b": map[string]interface{}{"c": 2.0}},
+			{"a": "z"},
+		},
+		Limit: 2,
+	})
+	require.NoError(t, err)
+
+	out, err := Read(&ReadInput{Reader: buf})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"a": "x", "b": map[string]interface{}{"c": 1.5}},
+		map[string]interface{}{"a": "y", "b": map[string]interface{}{"c": 2.0}},
+	}, out)
+}
@@ -0,0 +1,190 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as an Avro object container file.
+// If no schema is given, then the writer buffers the first objects to infer the schema.
+type Writer struct {
+	writer        io.Writer // writer for the underlying stream
+	schema        string    // the schema formatted as JSON.  If empty, the schema is inferred.
+	codec         string    // the compression codec for blocks
+	sampleSize    int       // the number of objects used to infer the schema
+	keySerializer stringify.Stringer
+	parsed        *schema           // the parsed schema
+	ocf           *goavro.OCFWriter // the writer for the object container file, created once the schema is known
+	objects       []interface{}     // the objects not yet written to the underlying writer
+}
+
+// NewWriter returns a writer for formatting and writing objects to the underlying writer as an Avro object container file.
+// If the schema is blank, then the schema is inferred from the first objects written, up to sampleSize.
+// If sampleSize is less than 1, then uses DefaultSampleSize.
+// If the codec is blank, then blocks are not compressed.
+func NewWriter(w io.Writer, schema string, codec string, sampleSize int, keySerializer stringify.Stringer) (*Writer, error) {
+	if len(codec) == 0 {
+		codec = CodecNull
+	}
+	if !stringSliceContains(Codecs, codec) {
+		return nil, &ErrInvalidCodec{Value: codec}
+	}
+	if sampleSize < 1 {
+		sampleSize = DefaultSampleSize
+	}
+	aw := &Writer{
+		writer:        w,
+		schema:        schema,
+		codec:         codec,
+		sampleSize:    sampleSize,
+		keySerializer: keySerializer,
+		objects:       make([]interface{}, 0),
+	}
+	if len(schema) > 0 {
+		if _, err := goavro.NewCodec(schema); err != nil {
+			return nil, errors.Wrap(err, "invalid schema")
+		}
+		parsed, err := parseSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+		aw.parsed = parsed
+	}
+	return aw, nil
+}
+
+// open infers the schema, if not given, and writes the header of the object container file.
+func (w *Writer) open() error {
+	if w.parsed == nil {
+		schema, err := InferSchema(w.objects)
+		if err != nil {
+			return errors.Wrap(err, "error inferring schema")
+		}
+		parsed, err := parseSchema(schema)
+		if err != nil {
+			return err
+		}
+		w.schema = schema
+		w.parsed = parsed
+	}
+	ocf, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		// Hide the type of the underlying writer, so goavro does not try to append to an existing file.
+		W:               struct{ io.Writer }{Writer: w.writer},
+		Schema:          w.schema,
+		CompressionName: w.codec,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error creating object container file writer")
+	}
+	w.ocf = ocf
+	return nil
+}
+
+// writeBlock converts the buffered objects using the schema and writes them as a block.
+func (w *Writer) writeBlock() error {
+	if len(w.objects) == 0 {
+		return nil
+	}
+	data := make([]interface{}, 0, len(w.objects))
+	for _, obj := range w.objects {
+		datum, err := w.parsed.toNative(w.parsed.root, "", obj)
+		if err != nil {
+			return errors.Wrapf(err, "error converting object %#v", obj)
+		}
+		data = append(data, datum)
+	}
+	if err := w.ocf.Append(data); err != nil {
+		return errors.Wrap(err, "error writing block")
+	}
+	w.objects = w.objects[:0]
+	return nil
+}
+
+// WriteObject buffers the object and writes a block to the underlying writer once enough objects are buffered.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.keySerializer != nil {
+		o, err := stringify.StringifyMapKeys(obj, w.keySerializer)
+		if err != nil {
+			return errors.Wrap(err, "error stringify map keys")
+		}
+		obj = o
+	}
+	w.objects = append(w.objects, obj)
+	if w.ocf == nil {
+		if w.parsed == nil && len(w.objects) < w.sampleSize {
+			return nil
+		}
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	if len(w.objects) >= DefaultBlockLength {
+		return w.writeBlock()
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the buffered objects as a block and flushes the underlying writer, if it has a Flush method.
+// If the schema was not given, then the schema is inferred from the buffered objects.
+// If the schema was not given and no objects were written, then nothing is written.
+func (w *Writer) Flush() error {
+	if w.ocf == nil && (w.parsed != nil || len(w.objects) > 0) {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	if w.ocf != nil {
+		if err := w.writeBlock(); err != nil {
+			return err
+		}
+	}
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriter(t *testing.T) {
+	for _, codec := range Codecs {
+		buf := new(bytes.Buffer)
+		w, err := NewWriter(buf, "", codec, 2, stringify.NewStringer("", false, false, false))
+		require.NoError(t, err)
+		require.NoError(t, w.WriteObject(map[string]interface{}{"a": "x", "b": 1}))
+		require.NoError(t, w.WriteObject(map[string]interface{}{"a": "y", "c": []interface{}{true}}))
+		require.NoError(t, w.WriteObject(map[string]interface{}{"b": 3}))
+		require.NoError(t, w.Flush())
+
+		it, err := NewIterator(&NewIteratorInput{Reader: buf})
+		require.NoError(t, err)
+		require.Equal(t, codec, it.Reader.CompressionName())
+		expected := []interface{}{
+			map[string]interface{}{"a": "x", "b": int64(1), "c": nil},
+			map[string]interface{}{"a": "y", "b": nil, "c": []interface{}{true}},
+			map[string]interface{}{"a": nil, "b": int64(3), "c": nil},
+		}
+		for _, e := range expected {
+			obj, err := it.Next()
+			require.NoError(t, err)
+			require.Equal(t, e, obj)
+		}
+		_, err = it.Next()
+		require.Equal(t, io.EOF, err)
+	}
+}
+
+func TestWriterSchema(t *testing.T) {
+	schema := `{
+		"type": "record",
+		"name": "Place",
+		"namespace": "example",
+		"fields": [
+			{"name": "name", "type": "string"},
+			{"name": "population", "type": ["null", "long"], "default": null},
+			{"name": "area", "type": ["null", "double"], "default": null},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["city", "town"]}},
+			{"name": "parent", "type": ["null", "Kind"], "default": null}
+		]
+	}`
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, schema, CodecDeflate, 0, nil)
+	require.NoError(t, err)
+	// Strings are converted into the types given by the schema.
+	require.NoError(t, w.WriteObject(map[string]string{"name": "a", "population": "30", "area": "1.5", "kind": "city", "parent": "town"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"name": "b", "kind": "town"}))
+	require.NoError(t, w.Flush())
+
+	out, err := Read(&ReadInput{Reader: buf})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"name": "a", "population": int64(30), "area": 1.5, "kind": "city", "parent": "town"},
+		map[string]interface{}{"name": "b", "population": nil, "area": nil, "kind": "town", "parent": nil},
+	}, out)
+}
+
+func TestWriterInvalid(t *testing.T) {
+	_, err := NewWriter(new(bytes.Buffer), "", "gzip", 0, nil)
+	require.Equal(t, &ErrInvalidCodec{Value: "gzip"}, err)
+
+	_, err = NewWriter(new(bytes.Buffer), `{"type": "unknown"}`, "", 0, nil)
+	require.Error(t, err)
+
+	w, err := NewWriter(new(bytes.Buffer), `{"type": "record", "name": "A", "fields": [{"name": "a", "type": "long"}]}`, "", 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteObject(map[string]interface{}{"a": "x"}))
+	require.Error(t, w.Flush())
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "", "", 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	require.Equal(t, 0, buf.Len())
+}
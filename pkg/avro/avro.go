@@ -0,0 +1,64 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package avro provides a simple API for reading and writing Apache Avro object container files (OCF).
+// avro also supports iterators and writers for efficiently processing a stream.
+// This package wraps the linkedin goavro package.
+//
+// When reading, the schema embedded in the file is used to decode each record into a map.
+// Values of union types are returned as plain values, rather than wrapped in a map keyed by the type name.
+// Blocks compressed with the deflate and snappy codecs are decompressed automatically.
+//
+// When writing, the schema can be given as JSON.
+// Otherwise, the schema is inferred from the first records written, where each object becomes a record,
+// every field is nullable, and nested objects become nested records (or maps if their keys are not valid Avro names).
+// Values are converted to the types in the schema where possible, e.g., the string "30" can be written to a long field.
+//
+// References:
+//	- https://avro.apache.org/docs/current/spec.html#Object+Container+Files
+//	- https://godoc.org/github.com/linkedin/goavro
+package avro
+
+import (
+	"regexp"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+const (
+	CodecNull    = goavro.CompressionNullLabel    // blocks are not compressed
+	CodecDeflate = goavro.CompressionDeflateLabel // blocks are compressed with deflate (RFC 1951)
+	CodecSnappy  = goavro.CompressionSnappyLabel  // blocks are compressed with snappy
+)
+
+const (
+	DefaultSampleSize  = 100  // the default number of records used to infer the schema
+	DefaultBlockLength = 1000 // the number of records written in each block
+	DefaultRecordName  = "Record"
+)
+
+var (
+	Codecs = []string{
+		CodecNull,
+		CodecDeflate,
+		CodecSnappy,
+	}
+)
+
+var (
+	// name matches valid Avro names for records, fields, enums, and fixed types.
+	name = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
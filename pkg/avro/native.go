@@ -0,0 +1,411 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+)
+
+// fromNative converts a datum decoded by goavro into plain values using the given type.
+// Unions are unwrapped, so a value of {"long": 1} is returned as 1.
+func (s *schema) fromNative(t interface{}, namespace string, datum interface{}) interface{} {
+	if datum == nil {
+		return nil
+	}
+	def, ns := s.resolve(t, namespace)
+	switch def := def.(type) {
+	case []interface{}:
+		if m, ok := datum.(map[string]interface{}); ok && len(m) == 1 {
+			for k, v := range m {
+				for _, branch := range def {
+					if s.typeName(branch, ns) == k {
+						return s.fromNative(branch, ns, v)
+					}
+				}
+				return v
+			}
+		}
+	case map[string]interface{}:
+		switch s.kind(def, ns) {
+		case "record":
+			if m, ok := datum.(map[string]interface{}); ok {
+				fields, _ := def["fields"].([]interface{})
+				obj := make(map[string]interface{}, len(m))
+				for _, f := range fields {
+					if field, ok := f.(map[string]interface{}); ok {
+						if n, ok := field["name"].(string); ok {
+							if v, ok := m[n]; ok {
+								obj[n] = s.fromNative(field["type"], ns, v)
+							}
+						}
+					}
+				}
+				return obj
+			}
+		case "array":
+			if slc, ok := datum.([]interface{}); ok {
+				out := make([]interface{}, 0, len(slc))
+				for _, v := range slc {
+					out = append(out, s.fromNative(def["items"], ns, v))
+				}
+				return out
+			}
+		case "map":
+			if m, ok := datum.(map[string]interface{}); ok {
+				out := make(map[string]interface{}, len(m))
+				for k, v := range m {
+					out[k] = s.fromNative(def["values"], ns, v)
+				}
+				return out
+			}
+		}
+	}
+	return datum
+}
+
+// toNative converts the value into the datum expected by goavro for the given type.
+// Members of unions are wrapped with goavro.Union.
+func (s *schema) toNative(t interface{}, namespace string, value interface{}) (interface{}, error) {
+	value = indirect(value)
+	def, ns := s.resolve(t, namespace)
+	switch def := def.(type) {
+	case []interface{}:
+		if value == nil {
+			for _, branch := range def {
+				if s.kind(branch, ns) == "null" {
+					return nil, nil
+				}
+			}
+			return nil, errors.New("union does not include null")
+		}
+		// First, look for a member of the union that matches the value without conversion.
+		for _, branch := range def {
+			if s.matches(branch, ns, value) {
+				datum, err := s.toNative(branch, ns, value)
+				if err == nil {
+					return goavro.Union(s.typeName(branch, ns), datum), nil
+				}
+			}
+		}
+		// Second, look for a member of the union the value can be converted into.
+		for _, branch := range def {
+			if s.kind(branch, ns) == "null" {
+				continue
+			}
+			datum, err := s.toNative(branch, ns, value)
+			if err == nil {
+				return goavro.Union(s.typeName(branch, ns), datum), nil
+			}
+		}
+		return nil, errors.Errorf("value %#v does not match any member of union", value)
+	case map[string]interface{}:
+		switch s.kind(def, ns) {
+		case "record":
+			return s.toRecord(def, ns, value)
+		case "enum":
+			return toString(value)
+		case "fixed":
+			return toBytes(value)
+		case "array":
+			v := reflect.ValueOf(value)
+			if value == nil || (v.Kind() != reflect.Array && v.Kind() != reflect.Slice) {
+				return nil, errors.Errorf("value %#v is not an array", value)
+			}
+			out := make([]interface{}, 0, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				item, err := s.toNative(def["items"], ns, v.Index(i).Interface())
+				if err != nil {
+					return nil, errors.Wrapf(err, "error converting item %d", i)
+				}
+				out = append(out, item)
+			}
+			return out, nil
+		case "map":
+			v := reflect.ValueOf(value)
+			if value == nil || v.Kind() != reflect.Map {
+				return nil, errors.Errorf("value %#v is not a map", value)
+			}
+			out := make(map[string]interface{}, v.Len())
+			it := v.MapRange()
+			for it.Next() {
+				k := fmt.Sprint(it.Key().Interface())
+				item, err := s.toNative(def["values"], ns, it.Value().Interface())
+				if err != nil {
+					return nil, errors.Wrapf(err, "error converting value for key %q", k)
+				}
+				out[k] = item
+			}
+			return out, nil
+		}
+		if _, ok := def["logicalType"]; ok {
+			if _, ok := value.(time.Time); ok {
+				return value, nil
+			}
+		}
+		return toPrimitive(s.kind(def, ns), value)
+	case string:
+		return toPrimitive(def, value)
+	}
+	return nil, errors.Errorf("unknown type %#v", t)
+}
+
+// toRecord converts the value into a record.
+// Missing fields are omitted, so goavro uses the default value of the field.
+func (s *schema) toRecord(def map[string]interface{}, namespace string, value interface{}) (interface{}, error) {
+	v := reflect.ValueOf(value)
+	if value == nil || v.Kind() != reflect.Map {
+		return nil, errors.Errorf("value %#v is not a map", value)
+	}
+	values := make(map[string]interface{}, v.Len())
+	it := v.MapRange()
+	for it.Next() {
+		values[fmt.Sprint(it.Key().Interface())] = it.Value().Interface()
+	}
+	fields, _ := def["fields"].([]interface{})
+	out := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		n, _ := field["name"].(string)
+		fieldValue := values[n]
+		if fieldValue == nil {
+			if _, ok := field["default"]; ok {
+				continue
+			}
+		}
+		datum, err := s.toNative(field["type"], namespace, fieldValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error converting field %q", n)
+		}
+		out[n] = datum
+	}
+	return out, nil
+}
+
+// matches returns true if the value can be written as the given type without conversion.
+func (s *schema) matches(t interface{}, namespace string, value interface{}) bool {
+	switch s.kind(t, namespace) {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "int", "long":
+		if _, ok := value.(time.Time); ok {
+			_, logical := s.typeLogical(t, namespace)
+			return logical
+		}
+		switch v := value.(type) {
+		case stdjson.Number:
+			_, err := v.Int64()
+			return err == nil
+		case float32:
+			return float64(v) == math.Trunc(float64(v))
+		case float64:
+			return v == math.Trunc(v)
+		}
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		}
+	case "float", "double":
+		switch value.(type) {
+		case stdjson.Number, float32, float64:
+			return true
+		}
+	case "string", "enum":
+		_, ok := value.(string)
+		return ok
+	case "bytes", "fixed":
+		_, ok := value.([]byte)
+		return ok
+	case "record", "map":
+		return reflect.ValueOf(value).Kind() == reflect.Map
+	case "array":
+		if _, ok := value.([]byte); ok {
+			return false
+		}
+		k := reflect.ValueOf(value).Kind()
+		return k == reflect.Array || k == reflect.Slice
+	}
+	return false
+}
+
+// typeLogical returns the logical type of the given type, if any.
+func (s *schema) typeLogical(t interface{}, namespace string) (string, bool) {
+	def, _ := s.resolve(t, namespace)
+	if m, ok := def.(map[string]interface{}); ok {
+		lt, ok := m["logicalType"].(string)
+		return lt, ok
+	}
+	return "", false
+}
+
+// indirect dereferences pointers and interfaces.
+func indirect(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+// toPrimitive converts the value into the given primitive type.
+func toPrimitive(t string, value interface{}) (interface{}, error) {
+	switch t {
+	case "null":
+		if value != nil {
+			return nil, errors.Errorf("value %#v is not null", value)
+		}
+		return nil, nil
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing boolean from %q", v)
+			}
+			return b, nil
+		}
+		return nil, errors.Errorf("value %#v is not a boolean", value)
+	case "int":
+		i, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		if i < math.MinInt32 || i > math.MaxInt32 {
+			return nil, errors.Errorf("value %d overflows int", i)
+		}
+		return int32(i), nil
+	case "long":
+		return toInt64(value)
+	case "float":
+		f, err := toFloat64(value)
+		if err != nil {
+			return nil, err
+		}
+		return float32(f), nil
+	case "double":
+		return toFloat64(value)
+	case "string":
+		return toString(value)
+	case "bytes":
+		return toBytes(value)
+	}
+	return nil, errors.Errorf("unknown type %q", t)
+}
+
+func toInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case stdjson.Number:
+		return v.Int64()
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error parsing integer from %q", v)
+		}
+		return i, nil
+	case float32:
+		if float64(v) != math.Trunc(float64(v)) {
+			return 0, errors.Errorf("value %v is not an integer", v)
+		}
+		return int64(v), nil
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, errors.Errorf("value %v is not an integer", v)
+		}
+		return int64(v), nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := v.Uint()
+		if u > math.MaxInt64 {
+			return 0, errors.Errorf("value %d overflows long", u)
+		}
+		return int64(u), nil
+	}
+	return 0, errors.Errorf("value %#v is not an integer", value)
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case stdjson.Number:
+		return v.Float64()
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error parsing number from %q", v)
+		}
+		return f, nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	}
+	return 0, errors.Errorf("value %#v is not a number", value)
+}
+
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case stdjson.Number:
+		return v.String(), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	case nil:
+		return "", errors.New("value is null")
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		return "", errors.Errorf("value %#v is not a string", value)
+	}
+	return fmt.Sprint(value), nil
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	}
+	return nil, errors.Errorf("value %#v is not bytes", value)
+}
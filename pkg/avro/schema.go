@@ -0,0 +1,153 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package avro
+
+import (
+	stdjson "encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var primitives = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// schema is a parsed Avro schema with an index of its named types.
+type schema struct {
+	root  interface{}            // the parsed JSON of the schema
+	names map[string]interface{} // the named types (records, enums, and fixed types) by full name
+}
+
+// parseSchema parses the schema and indexes its named types.
+func parseSchema(text string) (*schema, error) {
+	var root interface{}
+	if err := stdjson.Unmarshal([]byte(text), &root); err != nil {
+		return nil, errors.Wrap(err, "error parsing schema")
+	}
+	s := &schema{root: root, names: map[string]interface{}{}}
+	s.index(root, "")
+	return s, nil
+}
+
+// fullName returns the full name of the named type and its namespace.
+func fullName(m map[string]interface{}, namespace string) (string, string) {
+	n, _ := m["name"].(string)
+	if i := strings.LastIndex(n, "."); i >= 0 {
+		return n, n[0:i]
+	}
+	if ns, ok := m["namespace"].(string); ok {
+		namespace = ns
+	}
+	if len(namespace) > 0 {
+		return namespace + "." + n, namespace
+	}
+	return n, namespace
+}
+
+// index adds the named types in the given schema to the index.
+func (s *schema) index(t interface{}, namespace string) {
+	switch t := t.(type) {
+	case []interface{}:
+		for _, branch := range t {
+			s.index(branch, namespace)
+		}
+	case map[string]interface{}:
+		switch t["type"] {
+		case "record", "error", "enum", "fixed":
+			full, ns := fullName(t, namespace)
+			s.names[full] = t
+			if fields, ok := t["fields"].([]interface{}); ok {
+				for _, f := range fields {
+					if field, ok := f.(map[string]interface{}); ok {
+						s.index(field["type"], ns)
+					}
+				}
+			}
+		case "array":
+			s.index(t["items"], namespace)
+		case "map":
+			s.index(t["values"], namespace)
+		default:
+			s.index(t["type"], namespace)
+		}
+	}
+}
+
+// resolve returns the definition of the given type, looking up references to named types,
+// and the namespace for the types nested within the definition.
+func (s *schema) resolve(t interface{}, namespace string) (interface{}, string) {
+	switch t := t.(type) {
+	case string:
+		if stringSliceContains(primitives, t) {
+			return t, namespace
+		}
+		if def, ok := s.names[namespace+"."+t]; ok {
+			_, ns := fullName(def.(map[string]interface{}), namespace)
+			return def, ns
+		}
+		if def, ok := s.names[t]; ok {
+			_, ns := fullName(def.(map[string]interface{}), namespace)
+			return def, ns
+		}
+	case map[string]interface{}:
+		switch t["type"] {
+		case "record", "error", "enum", "fixed":
+			_, ns := fullName(t, namespace)
+			return t, ns
+		case "array", "map":
+			return t, namespace
+		}
+		if _, ok := t["logicalType"]; !ok {
+			// A primitive type written as an object, e.g., {"type": "string"}.
+			return s.resolve(t["type"], namespace)
+		}
+	}
+	return t, namespace
+}
+
+// typeName returns the name used by goavro to identify the given member of a union.
+func (s *schema) typeName(t interface{}, namespace string) string {
+	def, ns := s.resolve(t, namespace)
+	switch def := def.(type) {
+	case string:
+		return def
+	case map[string]interface{}:
+		switch def["type"] {
+		case "record", "error", "enum", "fixed":
+			full, _ := fullName(def, ns)
+			return full
+		}
+		if lt, ok := def["logicalType"].(string); ok {
+			if p, ok := def["type"].(string); ok {
+				return p + "." + lt
+			}
+		}
+		if p, ok := def["type"].(string); ok {
+			return p
+		}
+	}
+	return ""
+}
+
+// kind returns the kind of the given type, e.g., "record", "array", or a primitive type.
+func (s *schema) kind(t interface{}, namespace string) string {
+	def, _ := s.resolve(t, namespace)
+	switch def := def.(type) {
+	case string:
+		return def
+	case []interface{}:
+		return "union"
+	case map[string]interface{}:
+		if p, ok := def["type"].(string); ok {
+			if p == "error" {
+				return "record"
+			}
+			return p
+		}
+	}
+	return ""
+}
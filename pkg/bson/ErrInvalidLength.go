@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"fmt"
+)
+
+// ErrInvalidLength is used when the length prefix of a document is less than the minimum length of a document.
+type ErrInvalidLength struct {
+	Value int // the invalid length
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidLength) Error() string {
+	return fmt.Sprintf("invalid document length %d, expecting at least 5", e.Value)
+}
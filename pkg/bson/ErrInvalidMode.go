@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"fmt"
+)
+
+// ErrInvalidMode is used when the mode of MongoDB Extended JSON is not one of the supported modes.
+type ErrInvalidMode struct {
+	Value string // the invalid mode
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidMode) Error() string {
+	return fmt.Sprintf("invalid Extended JSON mode %q, expecting one of %q", e.Value, ExtendedJSONModes)
+}
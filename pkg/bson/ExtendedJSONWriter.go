@@ -0,0 +1,69 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+)
+
+// ExtendedJSONWriter converts each object into MongoDB Extended JSON before writing it to the underlying pipe.Writer,
+// e.g., a JSON Lines writer.
+type ExtendedJSONWriter struct {
+	writer pipe.Writer // the underlying writer
+	mode   string      // the mode of Extended JSON, either canonical or relaxed
+}
+
+// NewExtendedJSONWriter returns a writer that converts each object into MongoDB Extended JSON using the given mode before writing it to the underlying writer.
+func NewExtendedJSONWriter(w pipe.Writer, mode string) *ExtendedJSONWriter {
+	return &ExtendedJSONWriter{
+		writer: w,
+		mode:   mode,
+	}
+}
+
+// WriteObject converts a single object into Extended JSON and writes it to the underlying writer.
+func (w *ExtendedJSONWriter) WriteObject(obj interface{}) error {
+	o, err := ToExtendedJSON(obj, w.mode)
+	if err != nil {
+		return errors.Wrap(err, "error converting object to Extended JSON")
+	}
+	return w.writer.WriteObject(o)
+}
+
+// WriteObjects converts the given objects into Extended JSON and writes them to the underlying writer.
+func (w *ExtendedJSONWriter) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush flushes the underlying writer.
+func (w *ExtendedJSONWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+// Close closes the underlying writer.
+func (w *ExtendedJSONWriter) Close() error {
+	return w.writer.Close()
+}
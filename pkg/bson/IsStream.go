@@ -0,0 +1,30 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"encoding/binary"
+)
+
+// IsStream returns true if the given bytes contain more than one length-prefixed BSON document, such as a file created by mongodump.
+// A single document, or bytes that are not a valid sequence of document lengths, return false.
+func IsStream(b []byte) bool {
+	count := 0
+	for i := 0; i < len(b); {
+		if len(b)-i < 5 {
+			return false
+		}
+		length := int(int32(binary.LittleEndian.Uint32(b[i : i+4])))
+		if length < 5 || length > len(b)-i {
+			return false
+		}
+		i += length
+		count++
+	}
+	return count > 1
+}
@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsStream(t *testing.T) {
+	a, err := Marshal(map[string]interface{}{"a": "x"})
+	require.NoError(t, err)
+	b, err := Marshal(map[string]interface{}{"b": "y"})
+	require.NoError(t, err)
+
+	assert.False(t, IsStream([]byte{}))
+	assert.False(t, IsStream(a))
+	assert.True(t, IsStream(append(append([]byte{}, a...), b...)))
+	assert.False(t, IsStream(append(append([]byte{}, a...), b[:len(b)-1]...)))
+}
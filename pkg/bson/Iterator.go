@@ -0,0 +1,107 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"encoding/binary"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates through a stream of concatenated BSON documents
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type   reflect.Type  // the type to unmarshal for each document
+	Reader io.Reader     // the underlying reader
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Count  int           // The current count of the number of objects read.
+	Limits limits.Limits // The resource limits for each document.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader io.Reader
+	Type   reflect.Type  // the type to unmarshal for each document.  If nil or an interface, then decodes into a map[string]interface{}.
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Limits limits.Limits // The resource limits for each document.  MaxTotalBytes and MaxAliases are not used.
+}
+
+// NewIterator returns a new BSON iterator based on the given input.
+func NewIterator(input *NewIteratorInput) *Iterator {
+	return &Iterator{
+		Type:   input.Type,
+		Reader: input.Reader,
+		Limit:  input.Limit,
+		Count:  0,
+		Limits: input.Limits,
+	}
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// The length prefix of each document is checked against MaxRecordBytes before the rest of the document is read.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	prefix := make([]byte, 4)
+	if _, err := io.ReadFull(it.Reader, prefix); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.Wrapf(ErrTruncatedDocument, "error reading length of document %d", it.Count+1)
+		}
+		return nil, errors.Wrapf(err, "error reading length of document %d", it.Count+1)
+	}
+
+	length := int(int32(binary.LittleEndian.Uint32(prefix)))
+	if length < 5 {
+		return nil, &ErrInvalidLength{Value: length}
+	}
+	if err := it.Limits.CheckRecordBytes(length); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of document %d", it.Count+1)
+	}
+
+	b := make([]byte, length)
+	copy(b, prefix)
+	if _, err := io.ReadFull(it.Reader, b[4:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errors.Wrapf(ErrTruncatedDocument, "error reading document %d", it.Count+1)
+		}
+		return nil, errors.Wrapf(err, "error reading document %d", it.Count+1)
+	}
+
+	var obj interface{}
+	var err error
+	if it.Type != nil && it.Type.Kind() != reflect.Interface {
+		obj, err = UnmarshalType(b, it.Type)
+	} else {
+		obj, err = Unmarshal(b)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding document %d", it.Count+1)
+	}
+
+	if err := it.Limits.Check(obj); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of document %d", it.Count+1)
+	}
+
+	// Increment Counter
+	it.Count++
+
+	return obj, nil
+}
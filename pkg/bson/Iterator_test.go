@@ -0,0 +1,158 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestIterator(t *testing.T) {
+	objects := []interface{}{
+		map[string]string{"a": "x"},
+		map[string]string{"b": "y"},
+		map[string]string{"c": "z"},
+	}
+
+	buf := new(bytes.Buffer)
+	for _, obj := range objects {
+		b, err := Marshal(obj)
+		require.NoError(t, err)
+		buf.Write(b)
+	}
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: buf,
+		Type:   reflect.TypeOf(map[string]string{}),
+		Limit:  -1,
+	})
+
+	for _, expected := range objects {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err := it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+
+	// Should still return io.EOF to indicate the reader is finished
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorEmpty(t *testing.T) {
+	it := NewIterator(&NewIteratorInput{
+		Reader: new(bytes.Buffer),
+		Limit:  -1,
+	})
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err := it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorLimit(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for i := 0; i < 3; i++ {
+		b, err := Marshal(map[string]interface{}{"i": i})
+		require.NoError(t, err)
+		buf.Write(b)
+	}
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: buf,
+		Limit:  2,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"i": 0}, obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"i": 1}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorTruncated(t *testing.T) {
+	b, err := Marshal(map[string]interface{}{"a": "x"})
+	require.NoError(t, err)
+
+	// A complete document followed by a truncated document
+	in := append(append([]byte{}, b...), b[:len(b)-2]...)
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader(in),
+		Limit:  -1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "x"}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, ErrTruncatedDocument, errors.Cause(err))
+	require.Nil(t, obj)
+}
+
+func TestIteratorInvalidLength(t *testing.T) {
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader([]byte{0x04, 0x00, 0x00, 0x00}),
+		Limit:  -1,
+	})
+
+	obj, err := it.Next()
+	require.Equal(t, &ErrInvalidLength{Value: 4}, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	b, err := Marshal(map[string]interface{}{"a": map[string]interface{}{"b": 1}})
+	require.NoError(t, err)
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader(b),
+		Limit:  -1,
+		Limits: limits.Limits{MaxDepth: 1},
+	})
+
+	obj, err := it.Next()
+	require.Equal(t, &limits.ErrMaxDepth{Max: 1}, errors.Cause(err))
+	require.Nil(t, obj)
+}
+
+func TestIteratorMaxRecordBytes(t *testing.T) {
+	b, err := Marshal(map[string]interface{}{"a": "xyz"})
+	require.NoError(t, err)
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader(b),
+		Limit:  -1,
+		Limits: limits.Limits{MaxRecordBytes: 8},
+	})
+
+	obj, err := it.Next()
+	require.Error(t, err)
+	require.Nil(t, obj)
+}
@@ -8,11 +8,34 @@
 package bson
 
 import (
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+
 	// import the mgo bson library
 	mgobson "gopkg.in/mgo.v2/bson"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 )
 
 // Marshal formats an object into a slice of bytes of BSON.
+// Numbers decoded as json.Number, *big.Int, or *big.Float are written as int64 or float64 values,
+// or as Decimal128 values if they cannot be represented exactly.
+// BSON has no unsigned integers, so uint64 values that do not fit in an int64 are also written as Decimal128 values.
 func Marshal(obj interface{}) ([]byte, error) {
+	obj, err := number.ToNative(obj, func(str string) (interface{}, error) {
+		return mgobson.ParseDecimal128(str)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting numbers")
+	}
+	obj = number.Replace(obj, func(value interface{}) (interface{}, bool) {
+		if u, ok := value.(uint64); ok && u > math.MaxInt64 {
+			d, err := mgobson.ParseDecimal128(strconv.FormatUint(u, 10))
+			return d, err == nil
+		}
+		return nil, false
+	})
 	return mgobson.Marshal(obj)
 }
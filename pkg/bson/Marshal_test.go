@@ -8,10 +8,13 @@
 package bson
 
 import (
+	"encoding/json"
+	"math/big"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	mgobson "gopkg.in/mgo.v2/bson"
 )
 
 func TestMarshalMap(t *testing.T) {
@@ -36,3 +39,23 @@ func TestMarshalStruct(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, in, returned) // check roundtrip
 }
+
+func TestMarshalNumbers(t *testing.T) {
+	i, _ := new(big.Int).SetString("12345678901234567890123", 10)
+	in := map[string]interface{}{
+		"a": big.NewInt(1),
+		"b": big.NewFloat(1.5),
+		"c": json.Number("2"),
+		"d": i,
+		"e": uint64(18446744073709551615),
+	}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	returned, err := Unmarshal(b)
+	assert.NoError(t, err)
+	d, err := mgobson.ParseDecimal128("12345678901234567890123")
+	assert.NoError(t, err)
+	e, err := mgobson.ParseDecimal128("18446744073709551615")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": int64(1), "b": 1.5, "c": int64(2), "d": d, "e": e}, returned)
+}
@@ -0,0 +1,33 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, s := range []string{"x", "y", "z"} {
+		b, err := Marshal(map[string]interface{}{"a": s})
+		require.NoError(t, err)
+		buf.Write(b)
+	}
+	out, err := Read(&ReadInput{
+		Type:   reflect.TypeOf([]map[string]string{}),
+		Reader: buf,
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"a": "x"}, {"a": "y"}, {"a": "z"}}, out)
+}
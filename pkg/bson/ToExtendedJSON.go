@@ -0,0 +1,197 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	mgobson "gopkg.in/mgo.v2/bson"
+)
+
+// ToExtendedJSON converts the given object into its MongoDB Extended JSON representation using the given mode, either canonical or relaxed.
+// Maps, slices, and ordered documents are converted recursively.
+// Values without a native JSON type, e.g., ObjectId, Date, and Binary, are converted into their Extended JSON wrapper objects, e.g., {"$oid": "..."}.
+// In canonical mode, numbers are also converted into wrapper objects, e.g., {"$numberLong": "..."}, so that their BSON types are preserved.
+// If the mode is not valid, then returns ErrInvalidMode.
+func ToExtendedJSON(obj interface{}, mode string) (interface{}, error) {
+	if mode != ExtendedJSONCanonical && mode != ExtendedJSONRelaxed {
+		return nil, &ErrInvalidMode{Value: mode}
+	}
+	return toExtendedJSON(obj, mode == ExtendedJSONCanonical), nil
+}
+
+func toExtendedJSON(obj interface{}, canonical bool) interface{} {
+	switch value := obj.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = toExtendedJSON(v, canonical)
+		}
+		return m
+	case mgobson.M:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = toExtendedJSON(v, canonical)
+		}
+		return m
+	case mgobson.D:
+		m := make(map[string]interface{}, len(value))
+		for _, e := range value {
+			m[e.Name] = toExtendedJSON(e.Value, canonical)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, 0, len(value))
+		for _, v := range value {
+			s = append(s, toExtendedJSON(v, canonical))
+		}
+		return s
+	case mgobson.ObjectId:
+		return map[string]interface{}{"$oid": value.Hex()}
+	case time.Time:
+		return formatDate(value, canonical)
+	case int:
+		if canonical {
+			if value >= math.MinInt32 && value <= math.MaxInt32 {
+				return map[string]interface{}{"$numberInt": strconv.Itoa(value)}
+			}
+			return map[string]interface{}{"$numberLong": strconv.Itoa(value)}
+		}
+		return value
+	case int32:
+		if canonical {
+			return map[string]interface{}{"$numberInt": strconv.FormatInt(int64(value), 10)}
+		}
+		return value
+	case int64:
+		if canonical {
+			return map[string]interface{}{"$numberLong": strconv.FormatInt(value, 10)}
+		}
+		return value
+	case float32:
+		return formatDouble(float64(value), canonical)
+	case float64:
+		return formatDouble(value, canonical)
+	case []byte:
+		return formatBinary(0x00, value)
+	case mgobson.Binary:
+		return formatBinary(value.Kind, value.Data)
+	case mgobson.RegEx:
+		return map[string]interface{}{
+			"$regularExpression": map[string]interface{}{
+				"pattern": value.Pattern,
+				"options": value.Options,
+			},
+		}
+	case mgobson.MongoTimestamp:
+		return map[string]interface{}{
+			"$timestamp": map[string]interface{}{
+				"t": uint32(value >> 32),
+				"i": uint32(value),
+			},
+		}
+	case mgobson.Decimal128:
+		return map[string]interface{}{"$numberDecimal": value.String()}
+	case mgobson.JavaScript:
+		if value.Scope == nil {
+			return map[string]interface{}{"$code": value.Code}
+		}
+		return map[string]interface{}{
+			"$code":  value.Code,
+			"$scope": toExtendedJSON(value.Scope, canonical),
+		}
+	case mgobson.Symbol:
+		return map[string]interface{}{"$symbol": string(value)}
+	case mgobson.DBPointer:
+		return map[string]interface{}{
+			"$dbPointer": map[string]interface{}{
+				"$ref": value.Namespace,
+				"$id":  map[string]interface{}{"$oid": value.Id.Hex()},
+			},
+		}
+	}
+	switch obj {
+	case nil:
+		return nil
+	case mgobson.MinKey:
+		return map[string]interface{}{"$minKey": 1}
+	case mgobson.MaxKey:
+		return map[string]interface{}{"$maxKey": 1}
+	case mgobson.Undefined:
+		return map[string]interface{}{"$undefined": true}
+	}
+	// Convert other slices and maps with string keys, e.g., []map[string]interface{}.
+	value := reflect.ValueOf(obj)
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		s := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			s = append(s, toExtendedJSON(value.Index(i).Interface(), canonical))
+		}
+		return s
+	case reflect.Map:
+		if value.Type().Key().Kind() == reflect.String {
+			m := make(map[string]interface{}, value.Len())
+			for _, k := range value.MapKeys() {
+				m[k.String()] = toExtendedJSON(value.MapIndex(k).Interface(), canonical)
+			}
+			return m
+		}
+	}
+	return obj
+}
+
+// formatDate returns the Extended JSON representation of a datetime.
+// In relaxed mode, dates between the years 1970 and 9999 are formatted as ISO-8601 strings with millisecond precision.
+func formatDate(t time.Time, canonical bool) interface{} {
+	t = t.UTC()
+	if !canonical && t.Year() >= 1970 && t.Year() <= 9999 {
+		return map[string]interface{}{"$date": t.Format("2006-01-02T15:04:05.999Z")}
+	}
+	ms := t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+	return map[string]interface{}{
+		"$date": map[string]interface{}{"$numberLong": strconv.FormatInt(ms, 10)},
+	}
+}
+
+// formatDouble returns the Extended JSON representation of a double.
+// In relaxed mode, finite numbers are returned as is.
+func formatDouble(f float64, canonical bool) interface{} {
+	switch {
+	case math.IsInf(f, 1):
+		return map[string]interface{}{"$numberDouble": "Infinity"}
+	case math.IsInf(f, -1):
+		return map[string]interface{}{"$numberDouble": "-Infinity"}
+	case math.IsNaN(f):
+		return map[string]interface{}{"$numberDouble": "NaN"}
+	}
+	if !canonical {
+		return f
+	}
+	str := strconv.FormatFloat(f, 'G', -1, 64)
+	if !strings.ContainsAny(str, ".E") {
+		str += ".0"
+	}
+	return map[string]interface{}{"$numberDouble": str}
+}
+
+// formatBinary returns the Extended JSON representation of binary data with the given subtype.
+func formatBinary(kind byte, data []byte) interface{} {
+	return map[string]interface{}{
+		"$binary": map[string]interface{}{
+			"base64":  base64.StdEncoding.EncodeToString(data),
+			"subType": fmt.Sprintf("%02x", kind),
+		},
+	}
+}
@@ -0,0 +1,72 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mgobson "gopkg.in/mgo.v2/bson"
+)
+
+func TestToExtendedJSONCanonical(t *testing.T) {
+	in := map[string]interface{}{
+		"_id":     mgobson.ObjectIdHex("5d2d2f2ba7a7b0f6e5d4c3b2"),
+		"created": time.Date(2019, time.July, 16, 2, 3, 4, 5000000, time.UTC),
+		"count":   1,
+		"total":   int64(2),
+		"score":   1.0,
+		"ratio":   math.Inf(1),
+		"data":    []byte("abc"),
+		"tags":    []interface{}{"a", 2},
+	}
+	out, err := ToExtendedJSON(in, ExtendedJSONCanonical)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"_id":     map[string]interface{}{"$oid": "5d2d2f2ba7a7b0f6e5d4c3b2"},
+		"created": map[string]interface{}{"$date": map[string]interface{}{"$numberLong": "1563242584005"}},
+		"count":   map[string]interface{}{"$numberInt": "1"},
+		"total":   map[string]interface{}{"$numberLong": "2"},
+		"score":   map[string]interface{}{"$numberDouble": "1.0"},
+		"ratio":   map[string]interface{}{"$numberDouble": "Infinity"},
+		"data":    map[string]interface{}{"$binary": map[string]interface{}{"base64": "YWJj", "subType": "00"}},
+		"tags":    []interface{}{"a", map[string]interface{}{"$numberInt": "2"}},
+	}, out)
+}
+
+func TestToExtendedJSONRelaxed(t *testing.T) {
+	in := map[string]interface{}{
+		"_id":     mgobson.ObjectIdHex("5d2d2f2ba7a7b0f6e5d4c3b2"),
+		"created": time.Date(2019, time.July, 16, 2, 3, 4, 5000000, time.UTC),
+		"ancient": time.Date(1969, time.December, 31, 23, 59, 59, 0, time.UTC),
+		"count":   1,
+		"score":   1.5,
+		"ts":      mgobson.MongoTimestamp(1<<32 | 2),
+		"min":     mgobson.MinKey,
+	}
+	out, err := ToExtendedJSON(in, ExtendedJSONRelaxed)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"_id":     map[string]interface{}{"$oid": "5d2d2f2ba7a7b0f6e5d4c3b2"},
+		"created": map[string]interface{}{"$date": "2019-07-16T02:03:04.005Z"},
+		"ancient": map[string]interface{}{"$date": map[string]interface{}{"$numberLong": "-1000"}},
+		"count":   1,
+		"score":   1.5,
+		"ts":      map[string]interface{}{"$timestamp": map[string]interface{}{"t": uint32(1), "i": uint32(2)}},
+		"min":     map[string]interface{}{"$minKey": 1},
+	}, out)
+}
+
+func TestToExtendedJSONInvalidMode(t *testing.T) {
+	out, err := ToExtendedJSON(map[string]interface{}{}, "strict")
+	assert.Equal(t, &ErrInvalidMode{Value: "strict"}, err)
+	assert.Nil(t, out)
+}
@@ -0,0 +1,56 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	mgobson "gopkg.in/mgo.v2/bson"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// UnmarshalNumber parses a slice of bytes into a map like Unmarshal,
+// but converts numbers into the types for the given number mode.
+// Decimal128 values are parsed from their text with number.Parse, so that they do not lose precision.
+// NaN and infinite Decimal128 values are converted into float64 values.
+// If the mode is empty, then simply uses Unmarshal.
+// See the number package for the supported modes.
+func UnmarshalNumber(b []byte, mode string) (interface{}, error) {
+	obj, err := Unmarshal(b)
+	if err != nil || len(mode) == 0 {
+		return obj, err
+	}
+	var parseErr error
+	obj = number.Replace(obj, func(value interface{}) (interface{}, bool) {
+		d, ok := value.(mgobson.Decimal128)
+		if !ok || parseErr != nil {
+			return nil, false
+		}
+		str := d.String()
+		switch str {
+		case "NaN":
+			return math.NaN(), true
+		case "Inf", "-Inf":
+			f, _ := strconv.ParseFloat(str, 64)
+			return f, true
+		}
+		n, err := number.Parse(str, mode)
+		if err != nil {
+			parseErr = err
+			return nil, false
+		}
+		return n, true
+	})
+	if parseErr != nil {
+		return nil, errors.Wrap(parseErr, "error parsing Decimal128")
+	}
+	return number.Convert(obj, mode)
+}
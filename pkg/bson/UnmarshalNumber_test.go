@@ -0,0 +1,48 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mgobson "gopkg.in/mgo.v2/bson"
+)
+
+func TestUnmarshalNumber(t *testing.T) {
+	d, err := mgobson.ParseDecimal128("12345678901234567890123")
+	assert.NoError(t, err)
+	b, err := Marshal(map[string]interface{}{"a": int64(1), "b": 1.5, "d": d})
+	assert.NoError(t, err)
+
+	obj, err := UnmarshalNumber(b, "big")
+	assert.NoError(t, err)
+	i, _ := new(big.Int).SetString("12345678901234567890123", 10)
+	assert.Equal(t, map[string]interface{}{"a": big.NewInt(1), "b": big.NewFloat(1.5), "d": i}, obj)
+
+	obj, err = UnmarshalNumber(b, "number")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": json.Number("1"), "b": json.Number("1.5"), "d": json.Number("12345678901234567890123")}, obj)
+}
+
+func TestUnmarshalNumberNaN(t *testing.T) {
+	nan, err := mgobson.ParseDecimal128("NaN")
+	assert.NoError(t, err)
+	inf, err := mgobson.ParseDecimal128("-Inf")
+	assert.NoError(t, err)
+	b, err := Marshal(map[string]interface{}{"a": nan, "b": inf})
+	assert.NoError(t, err)
+	obj, err := UnmarshalNumber(b, "big")
+	assert.NoError(t, err)
+	m := obj.(map[string]interface{})
+	assert.True(t, math.IsNaN(m["a"].(float64)))
+	assert.Equal(t, math.Inf(-1), m["b"])
+}
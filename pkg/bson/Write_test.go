@@ -0,0 +1,42 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWrite(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"a": "x"},
+		map[string]interface{}{"a": "y"},
+	}
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		Object:        in,
+		KeySerializer: stringify.NewStringer("", false, false, false),
+		Limit:         -1,
+	})
+	require.NoError(t, err)
+	assert.True(t, IsStream(buf.Bytes()))
+	out, err := Read(&ReadInput{
+		Type:   reflect.TypeOf([]interface{}{}),
+		Reader: buf,
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
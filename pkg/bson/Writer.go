@@ -0,0 +1,94 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package bson
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as a stream of concatenated BSON documents.
+type Writer struct {
+	writer        io.Writer // writer for the underlying stream
+	keySerializer stringify.Stringer
+}
+
+// NewWriter returns a writer for formating and writing objects to the underlying writer as concatenated BSON documents.
+// If keySerializer is not nil, then map keys are converted to strings using the key serializer before writing.
+func NewWriter(w io.Writer, keySerializer stringify.Stringer) *Writer {
+	return &Writer{
+		writer:        w,
+		keySerializer: keySerializer,
+	}
+}
+
+// WriteObject formats and writes a single object to the underlying writer as a BSON document.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.keySerializer != nil {
+		o, err := stringify.StringifyMapKeys(obj, w.keySerializer)
+		if err != nil {
+			return errors.Wrap(err, "error stringifying map keys")
+		}
+		obj = o
+	}
+	b, err := Marshal(obj)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling object")
+	}
+	_, err = w.writer.Write(b)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer as BSON documents.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush flushes the underlying writer, if it has a Flush method.
+// This writer itself does no buffering.
+func (w *Writer) Flush() error {
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
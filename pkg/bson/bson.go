@@ -6,7 +6,13 @@
 // =================================================================
 
 // Package bson provides an API for BSON serialization.  This package wraps the mgo bson package.
+// bson also supports iterators and writers for efficiently processing a stream of concatenated documents,
+// such as the .bson files created by mongodump.
+// Values can be converted into MongoDB Extended JSON, in either the canonical or relaxed mode,
+// so that types without a JSON equivalent, e.g., ObjectId and Date, survive conversion to JSON.
 //	- https://godoc.org/gopkg.in/mgo.v2/bson
+//	- http://bsonspec.org/spec.html
+//	- https://github.com/mongodb/specifications/blob/master/source/extended-json.rst
 package bson
 
 import (
@@ -15,11 +21,21 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	ExtendedJSONCanonical = "canonical" // preserves the type of every value, including numbers
+	ExtendedJSONRelaxed   = "relaxed"   // uses native JSON numbers and ISO-8601 dates where possible
+)
+
 var (
-	DefaultType = reflect.TypeOf(map[string]interface{}{})
+	DefaultType       = reflect.TypeOf(map[string]interface{}{})
+	ExtendedJSONModes = []string{
+		ExtendedJSONCanonical,
+		ExtendedJSONRelaxed,
+	}
 )
 
 var (
-	ErrEmptyInput  = errors.New("empty input")
-	ErrInvalidRune = errors.New("invalid rune")
+	ErrEmptyInput        = errors.New("empty input")
+	ErrInvalidRune       = errors.New("invalid rune")
+	ErrTruncatedDocument = errors.New("truncated document")
 )
@@ -0,0 +1,87 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"io"
+	"reflect"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates trough a CBOR Sequence (RFC 8742)
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type    reflect.Type    // the type to unmarshal for each data item
+	Decoder *fxcbor.Decoder // the decoder that reads data items from the underlying reader
+	Limit   int             // Limit the number of objects to read and return from the underlying stream.
+	Count   int             // The current count of the number of objects read.
+	Limits  limits.Limits   // The maximum depth and number of keys of each object.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader io.Reader
+	Type   reflect.Type  // the type to unmarshal for each data item.  If nil, then decodes into an empty interface.
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Limits limits.Limits // The maximum depth and number of keys of each object.  Only MaxDepth and MaxKeys are used.
+}
+
+// NewIterator returns a new CBOR Sequence iterator base on the given input.
+func NewIterator(input *NewIteratorInput) *Iterator {
+	return &Iterator{
+		Type:    input.Type,
+		Decoder: decMode.NewDecoder(input.Reader),
+		Limit:   input.Limit,
+		Count:   0,
+		Limits:  input.Limits,
+	}
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// When the input stream is exhausted, returns (nil, io.EOF).
+// If the stream ends in the middle of a data item, then returns an error.
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	// Increment Counter
+	it.Count++
+
+	var obj interface{}
+	var err error
+	if it.Type != nil {
+		ptr := reflect.New(it.Type)
+		if it.Type.Kind() == reflect.Map {
+			ptr.Elem().Set(reflect.MakeMap(it.Type))
+		}
+		err = it.Decoder.Decode(ptr.Interface())
+		obj = ptr.Elem().Interface()
+	} else {
+		err = it.Decoder.Decode(&obj)
+	}
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrapf(err, "error decoding CBOR data item %d", it.Count)
+	}
+
+	if err := it.Limits.Check(obj); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of CBOR data item %d", it.Count)
+	}
+
+	return obj, nil
+}
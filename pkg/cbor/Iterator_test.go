@@ -0,0 +1,131 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestIterator(t *testing.T) {
+	objects := []interface{}{
+		map[string]string{"a": "x"},
+		map[string]string{"b": "y"},
+		map[string]string{"c": "z"},
+	}
+
+	buf := new(bytes.Buffer)
+	for _, obj := range objects {
+		b, err := Marshal(obj)
+		require.NoError(t, err)
+		buf.Write(b)
+	}
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: buf,
+		Type:   reflect.TypeOf(map[string]string{}),
+		Limit:  -1,
+	})
+
+	for _, expected := range objects {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err := it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+
+	// Should still return io.EOF to indicate the reader is finished
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorEmpty(t *testing.T) {
+	it := NewIterator(&NewIteratorInput{
+		Reader: new(bytes.Buffer),
+		Limit:  -1,
+	})
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err := it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorNoType(t *testing.T) {
+	// "a", 1, 1(1363896240)
+	in := []byte{0x61, 0x61, 0x01, 0xc1, 0x1a, 0x51, 0x4b, 0x67, 0xb0}
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader(in),
+		Limit:  -1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, "a", obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.IsType(t, time.Time{}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorTruncated(t *testing.T) {
+	// {"a": 1}, followed by a truncated map
+	in := []byte{0xa1, 0x61, 0x61, 0x01, 0xa1, 0x61}
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader(in),
+		Limit:  -1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[interface{}]interface{}{"a": int64(1)}, obj)
+
+	obj, err = it.Next()
+	require.Error(t, err)
+	require.NotEqual(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	// {"a": {"b": 1}}
+	in := []byte{0xa1, 0x61, 0x61, 0xa1, 0x61, 0x62, 0x01}
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: bytes.NewReader(in),
+		Limit:  -1,
+		Limits: limits.Limits{MaxDepth: 1},
+	})
+
+	obj, err := it.Next()
+	require.Equal(t, &limits.ErrMaxDepth{Max: 1}, errors.Cause(err))
+	require.Nil(t, obj)
+}
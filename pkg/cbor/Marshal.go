@@ -0,0 +1,21 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Marshal formats an object into a slice of bytes of CBOR.
+func Marshal(obj interface{}) ([]byte, error) {
+	b, err := encMode.Marshal(obj)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, "error marshaling CBOR")
+	}
+	return b, nil
+}
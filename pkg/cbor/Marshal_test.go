@@ -0,0 +1,63 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalMap(t *testing.T) {
+	in := map[string]interface{}{"a": 1.5, "b": "x", "c": []byte("y")}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	assert.NotNil(t, b)
+	returned, err := UnmarshalType(b, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, in, returned) // check roundtrip
+}
+
+func TestMarshalStruct(t *testing.T) {
+	in := struct {
+		A string
+		B string
+		C string
+	}{A: "1", B: "2", C: "3"}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	returned, err := UnmarshalType(b, reflect.TypeOf(in))
+	assert.NoError(t, err)
+	assert.Equal(t, in, returned) // check roundtrip
+}
+
+func TestMarshalTime(t *testing.T) {
+	in := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0xc0, 0x74}, []byte("2020-01-02T03:04:05Z")...), b)
+}
+
+func TestMarshalBigInt(t *testing.T) {
+	in := new(big.Int).Lsh(big.NewInt(1), 64)
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xc2, 0x49, 0x01, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, b)
+}
+
+func TestMarshalTag(t *testing.T) {
+	in := map[string]interface{}{"a": Tag{Number: 37, Content: []byte{0x01, 0x02}}}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	returned, err := UnmarshalType(b, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, in, returned) // check roundtrip
+}
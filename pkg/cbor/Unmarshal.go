@@ -0,0 +1,30 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Unmarshal parses a slice of bytes into an object.
+// Maps are returned as map[interface{}]interface{}, since CBOR map keys can be of any type.
+// If no input is given, then returns ErrEmptyInput.
+func Unmarshal(b []byte) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	var obj interface{}
+	err := decMode.Unmarshal(b, &obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling CBOR")
+	}
+
+	return obj, nil
+}
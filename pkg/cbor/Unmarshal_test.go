@@ -0,0 +1,93 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalEmpty(t *testing.T) {
+	obj, err := Unmarshal([]byte{})
+	assert.Equal(t, err, ErrEmptyInput)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	// {"a": 1, 2: "b"}
+	in := []byte{0xa2, 0x61, 0x61, 0x01, 0x02, 0x61, 0x62}
+	expected := map[interface{}]interface{}{"a": int64(1), int64(2): "b"}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalArray(t *testing.T) {
+	// ["a", true, null, 1.5, -1]
+	in := []byte{0x85, 0x61, 0x61, 0xf5, 0xf6, 0xf9, 0x3e, 0x00, 0x20}
+	expected := []interface{}{"a", true, nil, 1.5, int64(-1)}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalDateTime(t *testing.T) {
+	// 0("2013-03-21T20:04:00Z")
+	in := append([]byte{0xc0, 0x74}, []byte("2013-03-21T20:04:00Z")...)
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC), obj)
+}
+
+func TestUnmarshalEpochDateTime(t *testing.T) {
+	// 1(1363896240)
+	in := []byte{0xc1, 0x1a, 0x51, 0x4b, 0x67, 0xb0}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.IsType(t, time.Time{}, obj)
+	assert.True(t, time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC).Equal(obj.(time.Time)))
+}
+
+func TestUnmarshalBignum(t *testing.T) {
+	// 2(h'010000000000000000'), 3(h'010000000000000000')
+	in := []byte{0x82, 0xc2, 0x49, 0x01, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xc3, 0x49, 0x01, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}
+	positive, _ := new(big.Int).SetString("18446744073709551616", 10)
+	negative, _ := new(big.Int).SetString("-18446744073709551617", 10)
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{positive, negative}, obj)
+}
+
+func TestUnmarshalOverflow(t *testing.T) {
+	// 18446744073709551615
+	in := []byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	expected, _ := new(big.Int).SetString("18446744073709551615", 10)
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalTag(t *testing.T) {
+	// 37(h'0102')
+	in := []byte{0xd8, 0x25, 0x42, 0x01, 0x02}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, Tag{Number: 37, Content: []byte{0x01, 0x02}}, obj)
+	b, err := Marshal(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, in, b) // check roundtrip
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	obj, err := Unmarshal([]byte{0xa2, 0x61, 0x61})
+	assert.Error(t, err)
+	assert.Nil(t, obj)
+}
@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer        io.Writer          // the underlying writer
+	Object        interface{}        // the object to write
+	KeySerializer stringify.Stringer // if not nil, then converts map keys to strings
+	Limit         int
+}
+
+// Write writes the given object(s) as a CBOR Sequence (RFC 8742).
+// As a CBOR Sequence is a stream of data items, if provded an object of array or slice, then each contained element is written as its own data item.  If not provided an array or slice, then the provided object is written as a single data item.
+func Write(input *WriteInput) error {
+	inputObject := input.Object
+	inputObjectValue := reflect.ValueOf(inputObject)
+	for reflect.TypeOf(inputObjectValue.Interface()).Kind() == reflect.Ptr {
+		inputObjectValue = inputObjectValue.Elem()
+	}
+	inputObjectValue = reflect.ValueOf(inputObjectValue.Interface()) // sets value to concerete type
+	inputObjectKind := inputObjectValue.Type().Kind()
+
+	p := pipe.NewBuilder().OutputLimit(input.Limit)
+	if inputObjectKind == reflect.Array || inputObjectKind == reflect.Slice {
+		it, errorIterator := pipe.NewSliceIterator(inputObject)
+		if errorIterator != nil {
+			return errors.Wrap(errorIterator, "error creating slice iterator")
+		}
+		p = p.Input(it)
+	} else {
+		it, errorIterator := pipe.NewSliceIterator([]interface{}{inputObject})
+		if errorIterator != nil {
+			return errors.Wrap(errorIterator, "error creating slice iterator")
+		}
+		p = p.Input(it)
+	}
+	w := NewWriter(input.Writer, input.KeySerializer)
+	errorRun := p.Output(w).Run()
+	if errorRun != nil {
+		return errors.Wrap(errorRun, "error serializing CBOR")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package cbor
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteSlice(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: []interface{}{"a", 1},
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x61, 0x61, 0x01}, buf.Bytes())
+}
+
+func TestWriteObject(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: map[string]string{"a": "x"},
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xa1, 0x61, 0x61, 0x61, 0x78}, buf.Bytes())
+}
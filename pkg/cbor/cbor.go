@@ -0,0 +1,70 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package cbor provides an API for CBOR serialization, including CBOR Sequences (RFC 8742).  This package wraps the fxamacker cbor package.
+//	- https://cbor.io/
+//	- https://tools.ietf.org/html/rfc8742
+//	- https://godoc.org/github.com/fxamacker/cbor
+//
+// Tagged values are decoded into Go types as follows.
+//	- 0 (date/time string) and 1 (epoch date/time) are decoded as time.Time.
+//	- 2 (unsigned bignum) and 3 (negative bignum) are decoded as *big.Int.
+//	- All other tags are decoded as Tag values, which preserve the tag number and content, so that they are written back unchanged.
+//
+// Integers are decoded as int64, unless they overflow, in which case they are decoded as *big.Int.
+// When writing, time.Time values are written as tag 0 and *big.Int values that overflow 64 bits are written as tag 2 or 3.
+package cbor
+
+import (
+	"reflect"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"github.com/pkg/errors"
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]interface{}{})
+)
+
+var (
+	ErrEmptyInput = errors.New("empty input")
+)
+
+// Tag is a tagged value with a tag number that is not decoded into a native Go type.
+type Tag = fxcbor.Tag
+
+var (
+	decMode = mustDecMode(fxcbor.DecOptions{
+		MaxNestedLevels:  65535,
+		MaxArrayElements: 2147483647,
+		MaxMapPairs:      2147483647,
+		IntDec:           fxcbor.IntDecConvertSignedOrBigInt,
+		BigIntDec:        fxcbor.BigIntDecodePointer,
+		DefaultMapType:   reflect.TypeOf(map[interface{}]interface{}{}),
+	})
+	encMode = mustEncMode(fxcbor.EncOptions{
+		Time:          fxcbor.TimeRFC3339Nano,
+		TimeTag:       fxcbor.EncTagRequired,
+		BigIntConvert: fxcbor.BigIntConvertShortest,
+	})
+)
+
+func mustDecMode(opts fxcbor.DecOptions) fxcbor.DecMode {
+	dm, err := opts.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return dm
+}
+
+func mustEncMode(opts fxcbor.EncOptions) fxcbor.EncMode {
+	em, err := opts.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return em
+}
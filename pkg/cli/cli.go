@@ -19,26 +19,46 @@ const (
 )
 
 const (
-	FlagInputURI               = input.FlagInputURI
-	FlagInputCompression       = input.FlagInputCompression
-	FlagInputFormat            = input.FlagInputFormat
-	FlagInputHeader            = input.FlagInputHeader
-	FlagInputLimit             = input.FlagInputLimit
-	FlagInputComment           = input.FlagInputComment
-	FlagInputLazyQuotes        = input.FlagInputLazyQuotes
-	FlagInputTrim              = input.FlagInputTrim
-	FlagInputReaderBufferSize  = input.FlagInputReaderBufferSize
-	FlagInputScannerBufferSize = input.FlagInputScannerBufferSize
-	FlagInputSkipLines         = input.FlagInputSkipLines
-	FlagInputLineSeparator     = input.FlagInputLineSeparator
-	FlagInputKeyValueSeparator = input.FlagInputKeyValueSeparator
-	FlagInputDropCR            = input.FlagInputDropCR
-	FlagInputEscapePrefix      = input.FlagInputEscapePrefix
-	FlagInputUnescapeColon     = input.FlagInputUnescapeColon
-	FlagInputUnescapeEqual     = input.FlagInputUnescapeEqual
-	FlagInputUnescapeSpace     = input.FlagInputUnescapeSpace
-	FlagInputUnescapeNewLine   = input.FlagInputUnescapeNewLine
-	FlagInputType              = input.FlagInputType
+	FlagInputURI                 = input.FlagInputURI
+	FlagInputCompression         = input.FlagInputCompression
+	FlagInputFormat              = input.FlagInputFormat
+	FlagInputHeader              = input.FlagInputHeader
+	FlagInputLimit               = input.FlagInputLimit
+	FlagInputComment             = input.FlagInputComment
+	FlagInputLazyQuotes          = input.FlagInputLazyQuotes
+	FlagInputTrim                = input.FlagInputTrim
+	FlagInputReaderBufferSize    = input.FlagInputReaderBufferSize
+	FlagInputScannerBufferSize   = input.FlagInputScannerBufferSize
+	FlagInputSkipLines           = input.FlagInputSkipLines
+	FlagInputLineSeparator       = input.FlagInputLineSeparator
+	FlagInputLineSeparatorRegexp = input.FlagInputLineSeparatorRegexp
+	FlagInputKeyValueSeparator   = input.FlagInputKeyValueSeparator
+	FlagInputDropCR              = input.FlagInputDropCR
+	FlagInputEscapePrefix        = input.FlagInputEscapePrefix
+	FlagInputUnescapeColon       = input.FlagInputUnescapeColon
+	FlagInputUnescapeEqual       = input.FlagInputUnescapeEqual
+	FlagInputUnescapeSpace       = input.FlagInputUnescapeSpace
+	FlagInputUnescapeNewLine     = input.FlagInputUnescapeNewLine
+	FlagInputType                = input.FlagInputType
+	FlagInputNumber              = input.FlagInputNumber
+	FlagInputStrict              = input.FlagInputStrict
+	FlagInputMaxRecordBytes      = input.FlagInputMaxRecordBytes
+	FlagInputMaxDepth            = input.FlagInputMaxDepth
+	FlagInputMaxKeys             = input.FlagInputMaxKeys
+	FlagInputMaxTotalBytes       = input.FlagInputMaxTotalBytes
+	FlagInputMaxAliases          = input.FlagInputMaxAliases
+	FlagInputXMLPath             = input.FlagInputXMLPath
+	FlagInputPattern             = input.FlagInputPattern
+	FlagInputNoMatch             = input.FlagInputNoMatch
+	FlagInputColumns             = input.FlagInputColumns
+	FlagInputSheet               = input.FlagInputSheet
+	FlagInputDescriptorSet       = input.FlagInputDescriptorSet
+	FlagInputMessage             = input.FlagInputMessage
+	FlagInputFlatten             = input.FlagInputFlatten
+	FlagInputLongitude           = input.FlagInputLongitude
+	FlagInputLatitude            = input.FlagInputLatitude
+	FlagInputWKT                 = input.FlagInputWKT
+	FlagInputEncoding            = input.FlagInputEncoding
 )
 
 const (
@@ -73,4 +93,28 @@ const (
 	FlagOutputSorted            = output.FlagOutputSorted
 	FlagOutputReversed          = output.FlagOutputReversed
 	FlagOutputType              = output.FlagOutputType
+	FlagOutputBorders           = output.FlagOutputBorders
+	FlagOutputAlign             = output.FlagOutputAlign
+	FlagOutputMaxWidth          = output.FlagOutputMaxWidth
+	FlagOutputWrap              = output.FlagOutputWrap
+	FlagOutputSchema            = output.FlagOutputSchema
+	FlagOutputSchemaSample      = output.FlagOutputSchemaSample
+	FlagOutputCodec             = output.FlagOutputCodec
+	FlagOutputSheet             = output.FlagOutputSheet
+	FlagOutputSheetKey          = output.FlagOutputSheetKey
+	FlagOutputAutoFilter        = output.FlagOutputAutoFilter
+	FlagOutputTable             = output.FlagOutputTable
+	FlagOutputDialect           = output.FlagOutputDialect
+	FlagOutputStatement         = output.FlagOutputStatement
+	FlagOutputBatchSize         = output.FlagOutputBatchSize
+	FlagOutputCreateTable       = output.FlagOutputCreateTable
+	FlagOutputDescriptorSet     = output.FlagOutputDescriptorSet
+	FlagOutputMessage           = output.FlagOutputMessage
+	FlagOutputPlistFormat       = output.FlagOutputPlistFormat
+	FlagOutputLongitude         = output.FlagOutputLongitude
+	FlagOutputLatitude          = output.FlagOutputLatitude
+	FlagOutputWKT               = output.FlagOutputWKT
+	FlagOutputExtendedJSON      = output.FlagOutputExtendedJSON
+	FlagOutputEncoding          = output.FlagOutputEncoding
+	FlagOutputBOM               = output.FlagOutputBOM
 )
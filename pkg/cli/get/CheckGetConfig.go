@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package get
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/cli/output"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/document"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
+)
+
+// CheckGetConfig checks the configuration for the get command.
+func CheckGetConfig(v *viper.Viper) error {
+	if f := v.GetString(FlagFormat); len(f) > 0 && !stringSliceContains(document.Formats, f) {
+		return &document.ErrInvalidFormat{Format: f}
+	}
+	if f := v.GetString(FlagOutputFormat); len(f) > 0 && !stringSliceContains(serializer.Formats, f) {
+		return &output.ErrInvalidOutputFormat{Value: f, Expected: serializer.Formats}
+	}
+	return nil
+}
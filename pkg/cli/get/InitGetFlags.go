@@ -0,0 +1,18 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package get
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// InitGetFlags initializes the flags for the get command.
+func InitGetFlags(flag *pflag.FlagSet) {
+	flag.StringP(FlagFormat, "f", DefaultFormat, "format of the file, inferred from the file extension if not given")
+	flag.StringP(FlagOutputFormat, "o", DefaultOutputFormat, "output format for non-scalar values, defaults to the format of the file")
+}
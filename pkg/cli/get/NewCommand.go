@@ -0,0 +1,110 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package get
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/document"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
+)
+
+// NewCommand returns a new instance of the get command.
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   CliUse,
+		Short: CliShort,
+		Long:  CliLong,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := viper.New()
+
+			err := v.BindPFlags(cmd.Flags())
+			if err != nil {
+				return errors.Wrap(err, "error binding flags")
+			}
+			v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+			v.AutomaticEnv() // set environment variables to overwrite config
+
+			err = CheckGetConfig(v)
+			if err != nil {
+				return errors.Wrap(err, "error with configuration")
+			}
+
+			path := args[0]
+
+			format := v.GetString(FlagFormat)
+			if len(format) == 0 {
+				format, err = document.InferFormat(path)
+				if err != nil {
+					return err
+				}
+			}
+
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return errors.Wrapf(err, "error reading file %q", path)
+			}
+
+			d, err := document.New(format, b)
+			if err != nil {
+				return errors.Wrapf(err, "error parsing file %q", path)
+			}
+
+			obj, err := d.Get(document.ParsePath(args[1]))
+			if err != nil {
+				return err
+			}
+
+			switch value := obj.(type) {
+			case nil:
+				fmt.Println("null")
+				return nil
+			case time.Time:
+				fmt.Println(value.Format(time.RFC3339Nano))
+				return nil
+			}
+
+			if k := reflect.ValueOf(obj).Kind(); k != reflect.Map && k != reflect.Slice && k != reflect.Array {
+				fmt.Println(fmt.Sprint(obj))
+				return nil
+			}
+
+			outputFormat := v.GetString(FlagOutputFormat)
+			if len(outputFormat) == 0 {
+				outputFormat = format
+				// TOML can only serialize tables at the top level.
+				if format == "toml" && reflect.ValueOf(obj).Kind() != reflect.Map {
+					outputFormat = "json"
+				}
+			}
+
+			output, err := serializer.New(outputFormat).LineSeparator("\n").Serialize(obj)
+			if err != nil {
+				return errors.Wrapf(err, "error serializing value with format %q", outputFormat)
+			}
+
+			fmt.Print(string(output))
+			if len(output) > 0 && output[len(output)-1] != '\n' {
+				fmt.Println()
+			}
+
+			return nil
+		},
+	}
+	InitGetFlags(cmd.Flags())
+	return cmd
+}
@@ -0,0 +1,33 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package get provides the command for printing a value from a YAML or TOML file by key path.
+package get
+
+const (
+	CliUse   = "get FILE PATH"
+	CliShort = "print a value from a YAML or TOML file"
+	CliLong  = `print a value from a YAML or TOML file by key path.
+Keys in the path are separated by dots, and integer keys index into arrays.
+Scalar values are printed as is, and other values are serialized using the output format,
+which defaults to the format of the file.`
+
+	FlagFormat       = "format"
+	FlagOutputFormat = "output-format"
+
+	DefaultFormat       = ""
+	DefaultOutputFormat = ""
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
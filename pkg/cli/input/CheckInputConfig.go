@@ -8,8 +8,14 @@
 package input
 
 import (
+	"regexp"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/regex"
 )
 
 // CheckInputConfig checks the output configuration.
@@ -21,12 +27,13 @@ func CheckInputConfig(v *viper.Viper, formats []string) error {
 	if !stringSliceContains(formats, inputFormat) {
 		return &ErrInvalidInputFormat{Value: inputFormat, Expected: formats}
 	}
-	if ls := v.GetString(FlagInputLineSeparator); len(ls) != 1 {
-		if len(ls) == 0 {
-			return ErrMissingInputLineSeparator
-		} else {
-			return &ErrInvalidInputLineSeparator{Value: ls}
+	if str := v.GetString(FlagInputLineSeparatorRegexp); len(str) > 0 {
+		re, err := regexp.Compile(str)
+		if err != nil || re.MatchString("") {
+			return &ErrInvalidInputLineSeparatorRegexp{Value: str}
 		}
+	} else if len(v.GetString(FlagInputLineSeparator)) == 0 {
+		return ErrMissingInputLineSeparator
 	}
 	if kvs := v.GetString(FlagInputKeyValueSeparator); len(kvs) != 1 {
 		if len(kvs) == 0 {
@@ -49,6 +56,40 @@ func CheckInputConfig(v *viper.Viper, formats []string) error {
 			return errors.Wrap(ErrMissingInputEscapePrefix, "unescaping new line requires an escape prefix")
 		}
 	}
+	if mode := v.GetString(FlagInputNumber); len(mode) > 0 && !stringSliceContains(number.Modes, mode) {
+		return &number.ErrInvalidMode{Mode: mode}
+	}
+	for _, name := range []string{FlagInputMaxRecordBytes, FlagInputMaxDepth, FlagInputMaxKeys, FlagInputMaxTotalBytes, FlagInputMaxAliases} {
+		if value := v.GetInt(name); value < 0 {
+			return &ErrInvalidInputLimit{Name: name, Value: value}
+		}
+	}
+	if inputFormat == "regex" {
+		pattern := v.GetString(FlagInputPattern)
+		if len(pattern) == 0 {
+			return ErrMissingInputPattern
+		}
+		if _, err := regex.Compile(pattern); err != nil {
+			return errors.Wrap(err, "invalid input pattern")
+		}
+	}
+	if inputFormat == "parquet" && len(v.GetString(FlagInputURI)) == 0 {
+		return errors.Wrap(ErrMissingInputURI, "parquet requires a seekable input file")
+	}
+	if inputFormat == "protobuf" {
+		if len(v.GetString(FlagInputDescriptorSet)) == 0 {
+			return errors.Wrap(ErrMissingInputDescriptorSet, "protobuf requires a descriptor set")
+		}
+		if len(v.GetString(FlagInputMessage)) == 0 {
+			return errors.Wrap(ErrMissingInputMessage, "protobuf requires a message type")
+		}
+	}
+	if noMatch := v.GetString(FlagInputNoMatch); len(noMatch) > 0 && !stringSliceContains(regex.NoMatchPolicies, noMatch) {
+		return &ErrInvalidInputNoMatch{Value: noMatch, Expected: regex.NoMatchPolicies}
+	}
+	if e := v.GetString(FlagInputEncoding); len(e) > 0 && !stringSliceContains(encoding.InputEncodings, e) {
+		return &ErrInvalidInputEncoding{Value: e, Expected: encoding.InputEncodings}
+	}
 	inputComment := v.GetString(FlagInputComment)
 	if (inputFormat == "csv" || inputFormat == "tsv") && len(inputComment) > 1 {
 		return &ErrInvalidInputComment{Value: inputComment}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package input
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ErrInvalidInputEncoding struct {
+	Value    string
+	Expected []string
+}
+
+func (e *ErrInvalidInputEncoding) Error() string {
+	return fmt.Sprintf("invalid input encoding %q, expecting one of %s", e.Value, strings.Join(e.Expected, ", "))
+}
@@ -11,10 +11,11 @@ import (
 	"fmt"
 )
 
-type ErrInvalidInputLineSeparator struct {
-	Value string
+type ErrInvalidInputLimit struct {
+	Name  string
+	Value int
 }
 
-func (e *ErrInvalidInputLineSeparator) Error() string {
-	return fmt.Sprintf("invalid input line separator %q", e.Value)
+func (e *ErrInvalidInputLimit) Error() string {
+	return fmt.Sprintf("invalid value for %s %d, expecting a value greater than or equal to 0", e.Name, e.Value)
 }
@@ -0,0 +1,20 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package input
+
+import (
+	"fmt"
+)
+
+type ErrInvalidInputLineSeparatorRegexp struct {
+	Value string
+}
+
+func (e *ErrInvalidInputLineSeparatorRegexp) Error() string {
+	return fmt.Sprintf("invalid input line separator regular expression %q, expecting a valid expression that does not match an empty string", e.Value)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package input
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ErrInvalidInputNoMatch struct {
+	Value    string
+	Expected []string
+}
+
+func (e *ErrInvalidInputNoMatch) Error() string {
+	return fmt.Sprintf("invalid input no-match policy %q, expecting one of %s", e.Value, strings.Join(e.Expected, ", "))
+}
@@ -8,13 +8,21 @@
 package input
 
 import (
+	"strings"
+
 	"github.com/spf13/pflag"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/geojson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/regex"
 )
 
 // InitInputFlags initializes the flags for processing the input data from the gss command.
 func InitInputFlags(flag *pflag.FlagSet) {
+	flag.String(FlagInputURI, "", "read the input from a file rather than stdin.  Required for parquet format, since the file must be seekable.")
 	flag.StringP(FlagInputFormat, "i", "", "The input format")
-	flag.StringSlice(FlagInputHeader, DefaultInputHeader, "The input header if the stdin input has no header.")
+	flag.StringSlice(FlagInputHeader, DefaultInputHeader, "The input header if the stdin input has no header.  For fixedwidth, the column specs formatted as name:start:width.")
 	flag.StringP(FlagInputComment, "c", "", "The input comment character, e.g., #.  Commented lines are not sent to output.")
 	flag.Bool(FlagInputLazyQuotes, false, "allows lazy quotes for CSV and TSV")
 	flag.Int(FlagInputReaderBufferSize, 4096, "the buffer size of the file reader")
@@ -22,13 +30,33 @@ func InitInputFlags(flag *pflag.FlagSet) {
 	flag.Int(FlagInputSkipLines, DefaultSkipLines, "The number of lines to skip before processing")
 	flag.IntP(FlagInputLimit, "l", DefaultInputLimit, "The input limit")
 	flag.BoolP(FlagInputTrim, "t", false, "trim input lines")
-	flag.String(FlagInputLineSeparator, "\n", "override line separator.  Used with properties and JSONL formats.")
+	flag.String(FlagInputLineSeparator, "\n", "override line separator, which can be any sequence of bytes, e.g., \\r\\n or ||.  Used with dotenv, env, fixedwidth, ini, properties, JSONL, logfmt, regex, and tags formats.")
+	flag.String(FlagInputLineSeparatorRegexp, "", "split lines on matches of the regular expression rather than the line separator.  Used with dotenv, env, fixedwidth, ini, properties, JSONL, logfmt, regex, and tags formats.")
 	flag.String(FlagInputKeyValueSeparator, "=", "override key-value separator.  not used.")
 	flag.Bool(FlagInputDropCR, false, "drop carriage return characters that immediately precede new line characters")
-	flag.String(FlagInputEscapePrefix, "", "override escape prefix.  Used with properties format.")
-	flag.Bool(FlagInputUnescapeColon, false, "Unescape colon characters in input.  Used with properties format.")
-	flag.Bool(FlagInputUnescapeEqual, false, "Unescape equal characters in input.  Used with properties format.")
-	flag.Bool(FlagInputUnescapeSpace, false, "Unescape space characters in input.  Used with properties format.")
-	flag.Bool(FlagInputUnescapeNewLine, false, "Unescape new line characters in input.  Used with properties format.")
+	flag.String(FlagInputEscapePrefix, "", "override escape prefix.  Used with ini and properties formats.")
+	flag.Bool(FlagInputUnescapeColon, false, "Unescape colon characters in input.  Used with ini and properties formats.")
+	flag.Bool(FlagInputUnescapeEqual, false, "Unescape equal characters in input.  Used with ini and properties formats.")
+	flag.Bool(FlagInputUnescapeSpace, false, "Unescape space characters in input.  Used with ini and properties formats.")
+	flag.Bool(FlagInputUnescapeNewLine, false, "Unescape new line characters in input.  Used with ini and properties formats.")
 	flag.String(FlagInputType, "", "if using GOB format, input type, default map[string]interface {}")
+	flag.String(FlagInputNumber, "", "mode for decoding numbers: "+strings.Join(number.Modes, ", ")+".  Used with bson, json, jsonl, jsonseq, toml, and yaml formats.")
+	flag.Bool(FlagInputStrict, false, "reject duplicate keys, unknown fields, trailing data, and invalid UTF-8.  Used with dotenv, env, ini, json, jsonl, jsonseq, logfmt, properties, tags, and yaml formats.  For jsonseq, also rejects truncated records.")
+	flag.Int(FlagInputMaxRecordBytes, 0, "the maximum size in bytes of each line, or of the document for formats that are not line-based.  If 0, then no limit.")
+	flag.Int(FlagInputMaxDepth, 0, "the maximum nesting depth of objects and arrays.  If 0, then no limit.")
+	flag.Int(FlagInputMaxKeys, 0, "the maximum number of keys in a single object.  If 0, then no limit.")
+	flag.Int(FlagInputMaxTotalBytes, 0, "the maximum size in bytes of the input.  If 0, then no limit.")
+	flag.Int(FlagInputMaxAliases, 0, "the maximum number of alias expansions in a YAML document.  If 0, then no limit.")
+	flag.String(FlagInputXMLPath, "", "the path to the elements to read from XML, e.g., /catalog/book.  Elements are matched by their qualified names, including namespace prefixes.  If not set, then reads the whole document.  Used with xml format.")
+	flag.String(FlagInputPattern, "", "the regular expression with named capture groups used to parse each line, e.g., %{COMBINEDLOG} or ^(?P<level>\\w+): (?P<message>.*)$.  Used with regex format.")
+	flag.String(FlagInputNoMatch, DefaultInputNoMatch, "the policy for lines that do not match the input pattern: "+strings.Join(regex.NoMatchPolicies, ", ")+".  Used with regex format.")
+	flag.StringSlice(FlagInputColumns, []string{}, "the columns to read, skipping the data of all other columns.  If not set, then reads all columns.  Used with parquet format.")
+	flag.String(FlagInputSheet, "", "the name or zero-based index of the sheet to read.  If not set, then reads the first sheet.  Used with xlsx format.")
+	flag.String(FlagInputDescriptorSet, "", "the path to a FileDescriptorSet that includes the input message type, e.g., created with protoc --include_imports -o.  Used with protobuf format.")
+	flag.String(FlagInputMessage, "", "the full name of the input message type, e.g., example.v1.Place.  Used with protobuf format.")
+	flag.Bool(FlagInputFlatten, false, "flatten each feature into a record with the properties and the geometry as columns.  Points are written to the longitude and latitude columns and all other geometries to the WKT column.  Used with geojson and geojsonl formats.")
+	flag.String(FlagInputLongitude, geojson.DefaultLongitude, "the name of the longitude column of points when flattening features.  Used with geojson and geojsonl formats.")
+	flag.String(FlagInputLatitude, geojson.DefaultLatitude, "the name of the latitude column of points when flattening features.  Used with geojson and geojsonl formats.")
+	flag.String(FlagInputWKT, geojson.DefaultWKT, "the name of the column with the geometry as Well-Known Text when flattening features.  Used with geojson and geojsonl formats.")
+	flag.String(FlagInputEncoding, "", "the text encoding of the input: "+strings.Join(encoding.InputEncodings, ", ")+".  If not set, then the input is read as is.  If auto, then detects the encoding from the byte order mark or the content.  Byte order marks are removed.  Bytes that are not valid UTF-8 are kept as is, or rejected with --input-strict.  Not used with binary formats.")
 }
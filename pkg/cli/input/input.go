@@ -13,35 +13,60 @@ import (
 )
 
 const (
-	FlagInputURI               string = "input-uri"
-	FlagInputCompression       string = "input-compression"
-	FlagInputFormat            string = "input-format"
-	FlagInputHeader            string = "input-header"
-	FlagInputLimit             string = "input-limit"
-	FlagInputComment           string = "input-comment"
-	FlagInputLazyQuotes        string = "input-lazy-quotes"
-	FlagInputTrim              string = "input-trim"
-	FlagInputReaderBufferSize  string = "input-reader-buffer-size"
-	FlagInputScannerBufferSize string = "input-scanner-buffer-size"
-	FlagInputSkipLines         string = "input-skip-lines"
-	FlagInputLineSeparator     string = "input-line-separator"
-	FlagInputKeyValueSeparator string = "input-key-value-separator"
-	FlagInputDropCR            string = "input-drop-cr"
-	FlagInputEscapePrefix      string = "input-escape-prefix"
-	FlagInputUnescapeColon     string = "input-unescape-colon"
-	FlagInputUnescapeEqual     string = "input-unescape-equal"
-	FlagInputUnescapeSpace     string = "input-unescape-space"
-	FlagInputUnescapeNewLine   string = "input-unescape-new-line"
-	FlagInputType              string = "input-type"
+	FlagInputURI                 string = "input-uri"
+	FlagInputCompression         string = "input-compression"
+	FlagInputFormat              string = "input-format"
+	FlagInputHeader              string = "input-header"
+	FlagInputLimit               string = "input-limit"
+	FlagInputComment             string = "input-comment"
+	FlagInputLazyQuotes          string = "input-lazy-quotes"
+	FlagInputTrim                string = "input-trim"
+	FlagInputReaderBufferSize    string = "input-reader-buffer-size"
+	FlagInputScannerBufferSize   string = "input-scanner-buffer-size"
+	FlagInputSkipLines           string = "input-skip-lines"
+	FlagInputLineSeparator       string = "input-line-separator"
+	FlagInputLineSeparatorRegexp string = "input-line-separator-regexp"
+	FlagInputKeyValueSeparator   string = "input-key-value-separator"
+	FlagInputDropCR              string = "input-drop-cr"
+	FlagInputEscapePrefix        string = "input-escape-prefix"
+	FlagInputUnescapeColon       string = "input-unescape-colon"
+	FlagInputUnescapeEqual       string = "input-unescape-equal"
+	FlagInputUnescapeSpace       string = "input-unescape-space"
+	FlagInputUnescapeNewLine     string = "input-unescape-new-line"
+	FlagInputType                string = "input-type"
+	FlagInputNumber              string = "input-number"
+	FlagInputStrict              string = "input-strict"
+	FlagInputMaxRecordBytes      string = "input-max-record-bytes"
+	FlagInputMaxDepth            string = "input-max-depth"
+	FlagInputMaxKeys             string = "input-max-keys"
+	FlagInputMaxTotalBytes       string = "input-max-total-bytes"
+	FlagInputMaxAliases          string = "input-max-aliases"
+	FlagInputXMLPath             string = "input-xml-path"
+	FlagInputPattern             string = "input-pattern"
+	FlagInputNoMatch             string = "input-no-match"
+	FlagInputColumns             string = "input-columns"
+	FlagInputSheet               string = "input-sheet"
+	FlagInputDescriptorSet       string = "input-descriptor-set"
+	FlagInputMessage             string = "input-message"
+	FlagInputFlatten             string = "input-flatten"
+	FlagInputLongitude           string = "input-longitude"
+	FlagInputLatitude            string = "input-latitude"
+	FlagInputWKT                 string = "input-wkt"
+	FlagInputEncoding            string = "input-encoding"
 
-	DefaultSkipLines  int = 0
-	DefaultInputLimit int = -1
+	DefaultSkipLines    int    = 0
+	DefaultInputLimit   int    = -1
+	DefaultInputNoMatch string = "skip"
 )
 
 var (
 	ErrMissingInputKeyValueSeparator = errors.New("missing input key-value separator")
 	ErrMissingInputLineSeparator     = errors.New("missing input line separator")
 	ErrMissingInputEscapePrefix      = errors.New("missing input escape prefix")
+	ErrMissingInputPattern           = errors.New("missing input pattern")
+	ErrMissingInputURI               = errors.New("missing input uri")
+	ErrMissingInputDescriptorSet     = errors.New("missing input descriptor set")
+	ErrMissingInputMessage           = errors.New("missing input message")
 )
 
 var (
@@ -10,6 +10,14 @@ package output
 import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/avro"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/bson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/parquet"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/plist"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sql"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/table"
 )
 
 // CheckOutputConfig checks the output configuration.
@@ -21,12 +29,8 @@ func CheckOutputConfig(v *viper.Viper, formats []string) error {
 	if !stringSliceContains(formats, outputFormat) {
 		return &ErrInvalidOutputFormat{Value: outputFormat, Expected: formats}
 	}
-	if ls := v.GetString(FlagOutputLineSeparator); len(ls) != 1 {
-		if len(ls) == 0 {
-			return ErrMissingOutputLineSeparator
-		} else {
-			return &ErrInvalidOutputLineSeparator{Value: ls}
-		}
+	if len(v.GetString(FlagOutputLineSeparator)) == 0 {
+		return ErrMissingOutputLineSeparator
 	}
 	if kvs := v.GetString(FlagOutputKeyValueSeparator); len(kvs) != 1 {
 		if len(kvs) == 0 {
@@ -49,6 +53,65 @@ func CheckOutputConfig(v *viper.Viper, formats []string) error {
 			return errors.Wrap(ErrMissingOutputEscapePrefix, "escaping new line requires an escape prefix")
 		}
 	}
+	if b := v.GetString(FlagOutputBorders); len(b) > 0 && !stringSliceContains(table.Borders, b) {
+		return &ErrInvalidOutputBorders{Value: b, Expected: table.Borders}
+	}
+	if a := v.GetString(FlagOutputAlign); len(a) > 0 && !stringSliceContains(table.Alignments, a) {
+		return &ErrInvalidOutputAlign{Value: a, Expected: table.Alignments}
+	}
+	if c := v.GetString(FlagOutputCodec); len(c) > 0 {
+		switch outputFormat {
+		case "avro":
+			if !stringSliceContains(avro.Codecs, c) {
+				return &ErrInvalidOutputCodec{Value: c, Expected: avro.Codecs}
+			}
+		case "parquet":
+			if !stringSliceContains(parquet.Codecs, c) {
+				return &ErrInvalidOutputCodec{Value: c, Expected: parquet.Codecs}
+			}
+		}
+	}
+	if outputFormat == "sql" {
+		if len(v.GetString(FlagOutputTable)) == 0 {
+			return errors.Wrap(ErrMissingOutputTable, "sql requires a table")
+		}
+		d := v.GetString(FlagOutputDialect)
+		if len(d) > 0 && !stringSliceContains(sql.Dialects, d) {
+			return &ErrInvalidOutputDialect{Value: d, Expected: sql.Dialects}
+		}
+		if s := v.GetString(FlagOutputStatement); len(s) > 0 {
+			if !stringSliceContains(sql.Statements, s) {
+				return &ErrInvalidOutputStatement{Value: s, Expected: sql.Statements}
+			}
+			if s == sql.StatementCopy && len(d) > 0 && d != sql.DialectPostgres {
+				return &ErrInvalidOutputStatement{Value: s, Expected: []string{sql.StatementInsert}}
+			}
+		}
+	}
+	if outputFormat == "protobuf" {
+		if len(v.GetString(FlagOutputDescriptorSet)) == 0 {
+			return errors.Wrap(ErrMissingOutputDescriptorSet, "protobuf requires a descriptor set")
+		}
+		if len(v.GetString(FlagOutputMessage)) == 0 {
+			return errors.Wrap(ErrMissingOutputMessage, "protobuf requires a message type")
+		}
+	}
+	if outputFormat == "plist" {
+		if f := v.GetString(FlagOutputPlistFormat); len(f) > 0 && !stringSliceContains(plist.Formats, f) {
+			return &ErrInvalidOutputPlistFormat{Value: f, Expected: plist.Formats}
+		}
+	}
+	if m := v.GetString(FlagOutputExtendedJSON); len(m) > 0 && !stringSliceContains(bson.ExtendedJSONModes, m) {
+		return &ErrInvalidOutputExtendedJSON{Value: m, Expected: bson.ExtendedJSONModes}
+	}
+	if e := v.GetString(FlagOutputEncoding); len(e) > 0 {
+		if !stringSliceContains(encoding.OutputEncodings, e) {
+			return &ErrInvalidOutputEncoding{Value: e, Expected: encoding.OutputEncodings}
+		}
+		if v.GetBool(FlagOutputBOM) && (e == encoding.EncodingLatin1 || e == encoding.EncodingWindows1252) {
+			return errors.Wrapf(encoding.ErrNoBOM, "cannot write byte order mark with %s", e)
+		}
+	}
 	if v.GetBool(FlagOutputKeyLower) && v.GetBool(FlagOutputKeyUpper) {
 		return errors.New("cannot lower case and upper case keys at the same time")
 	}
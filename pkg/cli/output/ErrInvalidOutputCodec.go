@@ -9,12 +9,14 @@ package output
 
 import (
 	"fmt"
+	"strings"
 )
 
-type ErrInvalidOutputLineSeparator struct {
-	Value string
+type ErrInvalidOutputCodec struct {
+	Value    string
+	Expected []string
 }
 
-func (e *ErrInvalidOutputLineSeparator) Error() string {
-	return fmt.Sprintf("invalid output line separator %q", e.Value)
+func (e *ErrInvalidOutputCodec) Error() string {
+	return fmt.Sprintf("invalid output codec %q, expecting one of %s", e.Value, strings.Join(e.Expected, ", "))
 }
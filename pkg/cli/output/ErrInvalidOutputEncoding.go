@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ErrInvalidOutputEncoding struct {
+	Value    string
+	Expected []string
+}
+
+func (e *ErrInvalidOutputEncoding) Error() string {
+	return fmt.Sprintf("invalid output encoding %q, expecting one of %s", e.Value, strings.Join(e.Expected, ", "))
+}
@@ -8,7 +8,18 @@
 package output
 
 import (
+	"strings"
+
 	"github.com/spf13/pflag"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/avro"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/bson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/geojson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/parquet"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/plist"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sql"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/table"
 )
 
 // InitOutputFlags initializes the flags for processing the output data from the gss command.
@@ -16,9 +27,9 @@ func InitOutputFlags(flag *pflag.FlagSet) {
 	flag.StringP(FlagOutputFormat, "o", "", "The output format")
 	flag.String(FlagOutputFormatSpecifier, "", "The output format specifier")
 	flag.Bool(FlagOutputFit, false, "Fit output")
-	flag.StringSlice(FlagOutputHeader, DefaultOutputHeader, "The output header if the stdout output has no header.")
+	flag.StringSlice(FlagOutputHeader, DefaultOutputHeader, "The output header if the stdout output has no header.  For fixedwidth, the column specs formatted as name:start:width.")
 	flag.IntP(FlagOutputLimit, "n", DefaultOutputLimit, "the output limit")
-	flag.BoolP(FlagOutputPretty, "p", false, "print pretty output")
+	flag.BoolP(FlagOutputPretty, "p", false, "print pretty output.  Used with go, json, jsonl, jsonseq, plist, and xml formats.")
 	flag.BoolP(FlagOutputSorted, "s", false, "sort output")
 	flag.BoolP(FlagOutputReversed, "r", false, "if output is sorted, sort in reverse alphabetical order.")
 	flag.BoolP(FlagOutputDecimal, "d", false, "when converting floats to strings use decimals rather than scientific notation")
@@ -27,13 +38,37 @@ func InitOutputFlags(flag *pflag.FlagSet) {
 	flag.Bool(FlagOutputValueLower, false, "lower case output values, including tag values, and property values")
 	flag.Bool(FlagOutputValueUpper, false, "upper case output values, including tag values, and property values")
 	flag.StringP(FlagOutputNoDataValue, "0", "", "no data value, e.g., used for missing values when converting JSON to CSV")
-	flag.String(FlagOutputLineSeparator, "\n", "override line separator.  Used with properties and JSONL formats.")
-	flag.String(FlagOutputKeyValueSeparator, "=", "override key value separator.  Used with properties format.")
+	flag.String(FlagOutputLineSeparator, "\n", "override line separator.  Used with dotenv, env, fixedwidth, ini, properties, JSONL, and logfmt formats.")
+	flag.String(FlagOutputKeyValueSeparator, "=", "override key value separator.  Used with ini and properties formats.")
 	flag.Bool(FlagOutputExpandHeader, false, "expand output header.  Used with CSV and TSV formats.")
-	flag.String(FlagOutputEscapePrefix, "", "override escape prefix.  Used with properties format.")
-	flag.Bool(FlagOutputEscapeColon, false, "Escape colon characters in output.  Used with properties format.")
-	flag.Bool(FlagOutputEscapeEqual, false, "Escape equal characters in output.  Used with properties format.")
-	flag.Bool(FlagOutputEscapeSpace, false, "Escape space characters in output.  Used with properties format.")
-	flag.Bool(FlagOutputEscapeNewLine, false, "Escape new line characters in output.  Used with properties format.")
+	flag.String(FlagOutputEscapePrefix, "", "override escape prefix.  Used with ini and properties formats.")
+	flag.Bool(FlagOutputEscapeColon, false, "Escape colon characters in output.  Used with ini and properties formats.")
+	flag.Bool(FlagOutputEscapeEqual, false, "Escape equal characters in output.  Used with ini and properties formats.")
+	flag.Bool(FlagOutputEscapeSpace, false, "Escape space characters in output.  Used with ini and properties formats.")
+	flag.Bool(FlagOutputEscapeNewLine, false, "Escape new line characters in output.  Used with ini and properties formats.")
 	flag.String(FlagOutputType, "", "if using GOB format, the output type, default map[string]interface {}")
+	flag.String(FlagOutputBorders, DefaultOutputBorders, "the borders of the table: "+strings.Join(table.Borders, ", ")+".  Used with table format.")
+	flag.String(FlagOutputAlign, DefaultOutputAlign, "the alignment of columns: "+strings.Join(table.Alignments, ", ")+".  Auto right-aligns numeric columns.  Used with html, markdown, and table formats.")
+	flag.Int(FlagOutputMaxWidth, 0, "the maximum width of columns, truncating longer values.  If less than 1, then unlimited.  Used with html, markdown, and table formats.")
+	flag.Bool(FlagOutputWrap, false, "wrap values longer than the maximum width onto multiple lines rather than truncating them.  Used with table format.")
+	flag.String(FlagOutputSchema, "", "the output schema as JSON, or the path to a file containing the schema.  If not set, the schema is inferred.  Used with avro and parquet formats.")
+	flag.Int(FlagOutputSchemaSample, avro.DefaultSampleSize, "the number of records used to infer the output schema.  Used with avro and parquet formats.")
+	flag.String(FlagOutputCodec, "", "the compression codec.  For avro, one of "+strings.Join(avro.Codecs, ", ")+", defaulting to "+avro.CodecNull+".  For parquet, one of "+strings.Join(parquet.Codecs, ", ")+", defaulting to "+parquet.DefaultCodec+".")
+	flag.String(FlagOutputSheet, "", "the name of the output sheet.  If not set, then uses Sheet1.  Used with xlsx format.")
+	flag.String(FlagOutputSheetKey, "", "split the output into multiple sheets named by the value of this key.  Used with xlsx format.")
+	flag.Bool(FlagOutputAutoFilter, false, "add an autofilter to the header row of each sheet.  Used with xlsx format.")
+	flag.String(FlagOutputTable, "", "the name of the output table, optionally qualified by a schema.  Required by sql format.")
+	flag.String(FlagOutputDialect, sql.DefaultDialect, "the SQL dialect: "+strings.Join(sql.Dialects, ", ")+".  Used with sql format.")
+	flag.String(FlagOutputStatement, sql.DefaultStatement, "the statement used to write rows: "+strings.Join(sql.Statements, ", ")+".  The copy statement requires the postgres dialect.  Used with sql format.")
+	flag.Int(FlagOutputBatchSize, sql.DefaultBatchSize, "the number of rows in each INSERT statement, also used to infer the column types.  Used with sql format.")
+	flag.Bool(FlagOutputCreateTable, false, "write a CREATE TABLE statement with inferred column types before the rows.  Used with sql format.")
+	flag.String(FlagOutputDescriptorSet, "", "the path to a FileDescriptorSet that includes the output message type, e.g., created with protoc --include_imports -o.  Used with protobuf format.")
+	flag.String(FlagOutputMessage, "", "the full name of the output message type, e.g., example.v1.Place.  Used with protobuf format.")
+	flag.String(FlagOutputPlistFormat, plist.DefaultFormat, "the format of the output property list: "+strings.Join(plist.Formats, ", ")+".  Used with plist format.")
+	flag.String(FlagOutputLongitude, geojson.DefaultLongitude, "the name of the longitude column used to create points.  Used with geojson and geojsonl formats.")
+	flag.String(FlagOutputLatitude, geojson.DefaultLatitude, "the name of the latitude column used to create points.  Used with geojson and geojsonl formats.")
+	flag.String(FlagOutputWKT, geojson.DefaultWKT, "the name of the column with the geometry as Well-Known Text, which is used before the longitude and latitude columns.  Used with geojson and geojsonl formats.")
+	flag.String(FlagOutputExtendedJSON, "", "write MongoDB Extended JSON, so that types such as ObjectId and Date are preserved: "+strings.Join(bson.ExtendedJSONModes, ", ")+".  Used with json, jsonl, and jsonseq formats.")
+	flag.String(FlagOutputEncoding, encoding.EncodingUTF8, "the text encoding of the output: "+strings.Join(encoding.OutputEncodings, ", ")+".  Not used with binary formats.")
+	flag.Bool(FlagOutputBOM, false, "write a byte order mark before the output, e.g., for CSV files opened with Microsoft Excel.  Requires utf-8, utf-16le, or utf-16be encoding.  Not used with binary formats.")
 }
@@ -44,14 +44,43 @@ const (
 	FlagOutputSorted            string = "output-sorted"
 	FlagOutputReversed          string = "output-reversed"
 	FlagOutputType              string = "output-type"
+	FlagOutputBorders           string = "output-borders"
+	FlagOutputAlign             string = "output-align"
+	FlagOutputMaxWidth          string = "output-max-width"
+	FlagOutputWrap              string = "output-wrap"
+	FlagOutputSchema            string = "output-schema"
+	FlagOutputSchemaSample      string = "output-schema-sample"
+	FlagOutputCodec             string = "output-codec"
+	FlagOutputSheet             string = "output-sheet"
+	FlagOutputSheetKey          string = "output-sheet-key"
+	FlagOutputAutoFilter        string = "output-autofilter"
+	FlagOutputTable             string = "output-table"
+	FlagOutputDialect           string = "output-dialect"
+	FlagOutputStatement         string = "output-statement"
+	FlagOutputBatchSize         string = "output-batch-size"
+	FlagOutputCreateTable       string = "output-create-table"
+	FlagOutputDescriptorSet     string = "output-descriptor-set"
+	FlagOutputMessage           string = "output-message"
+	FlagOutputPlistFormat       string = "output-plist-format"
+	FlagOutputLongitude         string = "output-longitude"
+	FlagOutputLatitude          string = "output-latitude"
+	FlagOutputWKT               string = "output-wkt"
+	FlagOutputExtendedJSON      string = "output-extended-json"
+	FlagOutputEncoding          string = "output-encoding"
+	FlagOutputBOM               string = "output-bom"
 
-	DefaultOutputLimit = -1
+	DefaultOutputLimit   = -1
+	DefaultOutputBorders = "plain"
+	DefaultOutputAlign   = "auto"
 )
 
 var (
 	ErrMissingOutputKeyValueSeparator = errors.New("missing output key-value separator")
 	ErrMissingOutputLineSeparator     = errors.New("missing output line separator")
 	ErrMissingOutputEscapePrefix      = errors.New("missing output escape prefix")
+	ErrMissingOutputTable             = errors.New("missing output table")
+	ErrMissingOutputDescriptorSet     = errors.New("missing output descriptor set")
+	ErrMissingOutputMessage           = errors.New("missing output message")
 )
 
 var (
@@ -0,0 +1,25 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package set
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/document"
+)
+
+// CheckSetConfig checks the configuration for the set command.
+func CheckSetConfig(v *viper.Viper) error {
+	if f := v.GetString(FlagFormat); len(f) > 0 && !stringSliceContains(document.Formats, f) {
+		return &document.ErrInvalidFormat{Format: f}
+	}
+	if f := v.GetString(FlagValueFormat); !stringSliceContains(ValueFormats, f) {
+		return &ErrInvalidValueFormat{Value: f, Expected: ValueFormats}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package set
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ErrInvalidValueFormat struct {
+	Value    string
+	Expected []string
+}
+
+func (e *ErrInvalidValueFormat) Error() string {
+	return fmt.Sprintf("invalid value format %q, expecting one of %s", e.Value, strings.Join(e.Expected, ", "))
+}
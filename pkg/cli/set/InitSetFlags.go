@@ -0,0 +1,21 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package set
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// InitSetFlags initializes the flags for the set command.
+func InitSetFlags(flag *pflag.FlagSet) {
+	flag.StringP(FlagFormat, "f", DefaultFormat, "format of the file, inferred from the file extension if not given")
+	flag.String(FlagValueFormat, DefaultValueFormat, "format of the value, either "+strings.Join(ValueFormats, " or "))
+	flag.BoolP(FlagDelete, "d", false, "delete the value at the path")
+}
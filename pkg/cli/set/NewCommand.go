@@ -0,0 +1,100 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package set
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/document"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/yaml"
+)
+
+// NewCommand returns a new instance of the set command.
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   CliUse,
+		Short: CliShort,
+		Long:  CliLong,
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := viper.New()
+
+			err := v.BindPFlags(cmd.Flags())
+			if err != nil {
+				return errors.Wrap(err, "error binding flags")
+			}
+			v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+			v.AutomaticEnv() // set environment variables to overwrite config
+
+			err = CheckSetConfig(v)
+			if err != nil {
+				return errors.Wrap(err, "error with configuration")
+			}
+
+			del := v.GetBool(FlagDelete)
+			if del && len(args) != 2 {
+				return errors.New("expecting FILE and PATH arguments when deleting a value")
+			}
+			if !del && len(args) != 3 {
+				return errors.New("expecting FILE, PATH, and VALUE arguments")
+			}
+
+			path := args[0]
+
+			format := v.GetString(FlagFormat)
+			if len(format) == 0 {
+				format, err = document.InferFormat(path)
+				if err != nil {
+					return err
+				}
+			}
+
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return errors.Wrapf(err, "error reading file %q", path)
+			}
+
+			d, err := document.New(format, b)
+			if err != nil {
+				return errors.Wrapf(err, "error parsing file %q", path)
+			}
+
+			keys := document.ParsePath(args[1])
+
+			if del {
+				err = d.Delete(keys)
+				if err != nil {
+					return errors.Wrapf(err, "error deleting value from file %q", path)
+				}
+				return document.WriteFile(path, d)
+			}
+
+			var value interface{} = args[2]
+			if v.GetString(FlagValueFormat) == "yaml" && len(args[2]) > 0 {
+				value, err = yaml.Unmarshal([]byte(args[2]))
+				if err != nil {
+					return errors.Wrapf(err, "error parsing value %q", args[2])
+				}
+			}
+
+			err = d.Set(keys, value)
+			if err != nil {
+				return errors.Wrapf(err, "error setting value in file %q", path)
+			}
+
+			return document.WriteFile(path, d)
+		},
+	}
+	InitSetFlags(cmd.Flags())
+	return cmd
+}
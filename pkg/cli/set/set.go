@@ -0,0 +1,39 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package set provides the command for editing a value in a YAML or TOML file in place by key path.
+package set
+
+const (
+	CliUse   = "set FILE PATH [VALUE]"
+	CliShort = "edit a value in a YAML or TOML file in place"
+	CliLong  = `edit a value in a YAML or TOML file in place by key path.
+Keys in the path are separated by dots, and integer keys index into arrays.
+Comments, key order, and formatting of the rest of the file are preserved.
+The value is parsed as YAML, unless the value format is string.
+If the delete flag is given, then the value at the path is removed instead.`
+
+	FlagFormat      = "format"
+	FlagValueFormat = "value-format"
+	FlagDelete      = "delete"
+
+	DefaultFormat      = ""
+	DefaultValueFormat = "yaml"
+)
+
+var (
+	ValueFormats = []string{"string", "yaml"}
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
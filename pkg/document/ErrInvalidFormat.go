@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"fmt"
+)
+
+// ErrInvalidFormat is used when a document format is not supported.
+type ErrInvalidFormat struct {
+	Format string // the name of the invalid format
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidFormat) Error() string {
+	return fmt.Sprintf("invalid document format %q, expecting toml or yaml", e.Format)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"fmt"
+)
+
+// ErrUnknownExtension is used when the format of a document cannot be inferred from the file extension.
+type ErrUnknownExtension struct {
+	Path string // the path of the file
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownExtension) Error() string {
+	return fmt.Sprintf("could not infer document format from extension of file %q, expecting .toml, .yaml, or .yml", e.Path)
+}
@@ -0,0 +1,25 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// InferFormat returns the format of the document from the extension of the file path.
+// If the extension is not known, then returns ErrUnknownExtension.
+func InferFormat(path string) (string, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		return "toml", nil
+	case ".yaml", ".yml":
+		return "yaml", nil
+	}
+	return "", &ErrUnknownExtension{Path: path}
+}
@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferFormat(t *testing.T) {
+	f, err := InferFormat("config.toml")
+	assert.NoError(t, err)
+	assert.Equal(t, "toml", f)
+
+	f, err = InferFormat("values.YML")
+	assert.NoError(t, err)
+	assert.Equal(t, "yaml", f)
+
+	f, err = InferFormat("data.json")
+	assert.IsType(t, &ErrUnknownExtension{}, err)
+	assert.Equal(t, "", f)
+}
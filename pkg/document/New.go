@@ -0,0 +1,25 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"github.com/spatialcurrent/go-simple-serializer/pkg/toml"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/yaml"
+)
+
+// New parses the bytes into a Document for the given format.
+// If the format is not supported, then returns ErrInvalidFormat.
+func New(format string, b []byte) (Document, error) {
+	switch format {
+	case "toml":
+		return toml.NewDocument(b)
+	case "yaml":
+		return yaml.NewDocument(b)
+	}
+	return nil, &ErrInvalidFormat{Format: format}
+}
@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"strings"
+)
+
+// ParsePath splits a dotted key path into a slice of keys.
+// An empty string or a single dot returns an empty path.
+//
+//	- "" => []
+//	- "a.b.0" => ["a", "b", "0"]
+func ParsePath(str string) []string {
+	if len(str) == 0 || str == "." {
+		return []string{}
+	}
+	return strings.Split(str, ".")
+}
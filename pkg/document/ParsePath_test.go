@@ -0,0 +1,21 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePath(t *testing.T) {
+	assert.Equal(t, []string{}, ParsePath(""))
+	assert.Equal(t, []string{}, ParsePath("."))
+	assert.Equal(t, []string{"a"}, ParsePath("a"))
+	assert.Equal(t, []string{"a", "b", "0"}, ParsePath("a.b.0"))
+}
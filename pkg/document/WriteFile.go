@@ -0,0 +1,64 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package document
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// WriteFile writes the document to the file at the given path in place.
+// The document is written to a temporary file in the same directory and then renamed,
+// so that the file is never left partially written.  The mode of the existing file is kept.
+func WriteFile(path string, d Document) error {
+	b, err := d.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "error formatting document")
+	}
+
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return errors.Wrapf(err, "error creating temporary file for %q", path)
+	}
+	tmp := f.Name()
+
+	_, err = f.Write(b)
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "error writing temporary file for %q", path)
+	}
+
+	err = f.Close()
+	if err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "error closing temporary file for %q", path)
+	}
+
+	err = os.Chmod(tmp, mode)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "error setting mode of temporary file for %q", path)
+	}
+
+	err = os.Rename(tmp, path)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "error replacing file %q", path)
+	}
+
+	return nil
+}
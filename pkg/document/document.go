@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package document provides a common interface for editing YAML and TOML documents by key path,
+// while preserving comments, key order, and formatting.
+package document
+
+// Formats is a list of the formats that can be edited as documents.
+var Formats = []string{
+	"toml",
+	"yaml",
+}
+
+// Document is a document that can be edited by key path.
+type Document interface {
+	Get(path []string) (interface{}, error)
+	Set(path []string, value interface{}) error
+	Delete(path []string) error
+	Bytes() ([]byte, error)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"fmt"
+)
+
+// ErrUndefinedVariable is used when a value references a variable that is not defined and has no default value.
+type ErrUndefinedVariable struct {
+	Name string // the name of the variable
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUndefinedVariable) Error() string {
+	return fmt.Sprintf("undefined variable %q", e.Name)
+}
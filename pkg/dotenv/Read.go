@@ -0,0 +1,225 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
+)
+
+// isNameByte returns true if the byte can be used in the name of a variable.
+func isNameByte(c byte, first bool) bool {
+	return c == '_' || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (!first && c >= '0' && c <= '9')
+}
+
+// expandVariable expands the variable reference at the beginning of the given string, which begins with "$".
+// Returns the expanded value and the number of bytes consumed.
+func expandVariable(s string, lookup func(name string) (string, bool), strict bool) (string, int, error) {
+	if len(s) > 1 && s[1] == '{' {
+		end := strings.IndexByte(s, '}')
+		if end == -1 {
+			return "", 0, ErrUnterminatedVariable
+		}
+		name := s[2:end]
+		if i := strings.Index(name, ":-"); i != -1 {
+			if value, ok := lookup(name[0:i]); ok && len(value) > 0 {
+				return value, end + 1, nil
+			}
+			return name[i+2:], end + 1, nil
+		}
+		if value, ok := lookup(name); ok {
+			return value, end + 1, nil
+		}
+		if strict {
+			return "", 0, &ErrUndefinedVariable{Name: name}
+		}
+		return "", end + 1, nil
+	}
+	n := 1
+	for n < len(s) && isNameByte(s[n], n == 1) {
+		n++
+	}
+	if n == 1 {
+		return "$", 1, nil
+	}
+	if value, ok := lookup(s[1:n]); ok {
+		return value, n, nil
+	}
+	if strict {
+		return "", 0, &ErrUndefinedVariable{Name: s[1:n]}
+	}
+	return "", n, nil
+}
+
+// expand expands the variable references in the given string.
+// If quoted is true, then also unescapes the escape sequences supported within double quotes.
+// Otherwise, only unescapes "\$".
+func expand(s string, lookup func(name string) (string, bool), strict bool, quoted bool) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) {
+			next := s[i+1]
+			if quoted {
+				switch next {
+				case 'n':
+					b.WriteByte('\n')
+				case 'r':
+					b.WriteByte('\r')
+				case 't':
+					b.WriteByte('\t')
+				case '"', '\\', '$':
+					b.WriteByte(next)
+				default:
+					b.WriteByte(c)
+					b.WriteByte(next)
+				}
+				i++
+				continue
+			}
+			if next == '$' {
+				b.WriteByte(next)
+				i++
+				continue
+			}
+		}
+		if c == '$' {
+			value, n, err := expandVariable(s[i:], lookup, strict)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(value)
+			i += n - 1
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), nil
+}
+
+// parseValue parses the raw value of a variable, which has already been trimmed of leading whitespace.
+func parseValue(raw string, lookup func(name string) (string, bool), strict bool) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	switch raw[0] {
+	case '\'':
+		var b strings.Builder
+		for {
+			end := strings.IndexByte(raw[1:], '\'')
+			if end == -1 {
+				return "", ErrUnterminatedQuote
+			}
+			b.WriteString(raw[1 : end+1])
+			raw = raw[end+2:]
+			// Support the '\'' idiom for including single quotes, as written when Export is true.
+			if !strings.HasPrefix(raw, "\\''") {
+				return b.String(), nil
+			}
+			b.WriteByte('\'')
+			raw = raw[2:]
+		}
+	case '"':
+		for i := 1; i < len(raw); i++ {
+			if raw[i] == '\\' {
+				i++
+				continue
+			}
+			if raw[i] == '"' {
+				return expand(raw[1:i], lookup, strict, true)
+			}
+		}
+		return "", ErrUnterminatedQuote
+	}
+	// Inline comments must be preceded by whitespace.
+	for i := 1; i < len(raw); i++ {
+		if raw[i] == '#' && (raw[i-1] == ' ' || raw[i-1] == '\t') {
+			raw = raw[0:i]
+			break
+		}
+	}
+	return expand(strings.TrimSpace(raw), lookup, strict, false)
+}
+
+// Read parses an environment file from the given reader and returns a map of the variables, and error if any.
+// References to variables are expanded using the variables defined earlier in the file, and then input.Lookup, if not nil.
+// If no type is given, returns a map of type map[string]string.
+func Read(input *ReadInput) (interface{}, error) {
+
+	inputType := reflect.TypeOf(map[string]string{})
+	if input.Type != nil {
+		inputType = input.Type
+	}
+
+	variables := map[string]string{}
+	lookup := func(name string) (string, bool) {
+		if value, ok := variables[name]; ok {
+			return value, true
+		}
+		if input.Lookup != nil {
+			return input.Lookup(name)
+		}
+		return "", false
+	}
+
+	m := reflect.MakeMap(inputType)
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
+	keys := map[string]int{} // the line where each key was first defined, if strict
+	lineNumber := 0
+	for s.Scan() {
+		lineNumber++
+		line := s.Text()
+		if input.Strict && !utf8.ValidString(line) {
+			return nil, errors.Wrapf(ErrInvalidUTF8, "invalid byte on line %d", lineNumber)
+		}
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		if strings.HasPrefix(line, "export ") || strings.HasPrefix(line, "export\t") {
+			line = strings.TrimSpace(line[len("export"):])
+		}
+		i := strings.IndexByte(line, '=')
+		if i == -1 {
+			return nil, errors.Wrapf(ErrMissingKeyValueSeparator, "error deserializing variable on line %d", lineNumber)
+		}
+		key := strings.TrimSpace(line[0:i])
+		if len(key) == 0 {
+			return nil, errors.Wrapf(ErrMissingKey, "error deserializing variable on line %d", lineNumber)
+		}
+		if input.Strict {
+			if previous, ok := keys[key]; ok {
+				return nil, &ErrDuplicateKey{Key: key, Line: lineNumber, Previous: previous}
+			}
+			keys[key] = lineNumber
+		}
+		value, err := parseValue(strings.TrimLeft(line[i+1:], " \t"), lookup, input.Strict)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error deserializing value of variable %q on line %d", key, lineNumber)
+		}
+		variables[key] = value
+		m.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value))
+		if err := input.Limits.CheckKeys(m.Len()); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of variable on line %d", lineNumber)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", lineNumber+1)
+	}
+	return m.Interface(), nil
+}
@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input for the Read function.
+type ReadInput struct {
+	Type                reflect.Type                     // the output type
+	Reader              io.Reader                        // the underlying reader
+	LineSeparator       string                           // the line separator, which can be any sequence of bytes
+	LineSeparatorRegexp *regexp.Regexp                   // if not nil, split lines on matches of the regular expression
+	DropCR              bool                             // drop carriage return
+	Lookup              func(name string) (string, bool) // if not nil, used to look up variables not defined in the file, e.g., os.LookupEnv
+	Strict              bool                             // reject duplicate keys, undefined variables, and invalid UTF-8
+	Limits              limits.Limits                    // the maximum size of each line and number of variables
+}
@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows you can read an environment file into a map, expanding references to variables defined earlier in the file.
+func ExampleRead_interpolation() {
+	in := "export HOST=localhost\nURL=\"http://${HOST}:8080\" # comment\n"
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: map[HOST:localhost URL:http://localhost:8080]
+}
@@ -0,0 +1,111 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestRead(t *testing.T) {
+	in := `
+# database
+export DB_HOST=localhost
+DB_PORT = 5432 # inline comment
+DB_URL="postgres://${DB_HOST}:$DB_PORT/db"
+DB_PASSWORD='p@ss $ecret # not a comment'
+GREETING="hello\tworld\n\"quoted\" \$HOME"
+EMPTY=
+DEFAULT=${MISSING:-fallback}
+HASH=a#b
+`
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"DB_HOST":     "localhost",
+		"DB_PORT":     "5432",
+		"DB_URL":      "postgres://localhost:5432/db",
+		"DB_PASSWORD": "p@ss $ecret # not a comment",
+		"GREETING":    "hello\tworld\n\"quoted\" $HOME",
+		"EMPTY":       "",
+		"DEFAULT":     "fallback",
+		"HASH":        "a#b",
+	}, out)
+}
+
+func TestReadLookup(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Type:          reflect.TypeOf(map[string]interface{}{}),
+		Reader:        strings.NewReader("A=${HOME}/a\nB=$UNDEFINED\n"),
+		LineSeparator: "\n",
+		Lookup: func(name string) (string, bool) {
+			if name == "HOME" {
+				return "/home/user", true
+			}
+			return "", false
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"A": "/home/user/a", "B": ""}, out)
+}
+
+func TestReadStrict(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader("A=1\n# comment\nexport A=2\n"),
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+	assert.Equal(t, &ErrDuplicateKey{Key: "A", Line: 3, Previous: 1}, err)
+	assert.Nil(t, out)
+
+	out, err = Read(&ReadInput{
+		Reader:        strings.NewReader("A=${B}\n"),
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+	assert.Equal(t, &ErrUndefinedVariable{Name: "B"}, errors.Cause(err))
+	assert.Nil(t, out)
+}
+
+func TestReadErrors(t *testing.T) {
+	testCases := []struct {
+		In  string
+		Err error
+	}{
+		{In: "A\n", Err: ErrMissingKeyValueSeparator},
+		{In: "=1\n", Err: ErrMissingKey},
+		{In: "A='1\n", Err: ErrUnterminatedQuote},
+		{In: "A=\"1\\\"\n", Err: ErrUnterminatedQuote},
+		{In: "A=${B\n", Err: ErrUnterminatedVariable},
+	}
+	for _, testCase := range testCases {
+		_, err := Read(&ReadInput{
+			Reader:        strings.NewReader(testCase.In),
+			LineSeparator: "\n",
+		})
+		assert.Equal(t, testCase.Err, errors.Cause(err), testCase.In)
+	}
+}
+
+func TestReadLimits(t *testing.T) {
+	_, err := Read(&ReadInput{
+		Reader:        strings.NewReader("A=1\nB=2\nC=3\n"),
+		LineSeparator: "\n",
+		Limits:        limits.Limits{MaxKeys: 2},
+	})
+	assert.Equal(t, &limits.ErrMaxKeys{Max: 2}, errors.Cause(err))
+}
@@ -0,0 +1,178 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/inspector"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer          io.Writer          // the underlying writer
+	LineSeparator   string             // the newline byte
+	Object          interface{}        // the object to write
+	KeySerializer   stringify.Stringer // serializer for object keys, applied to each part of a flattened key
+	ValueSerializer stringify.Stringer // serializer for object values
+	Sorted          bool               // sort output
+	Reversed        bool               // if sorted, sort in reverse alphabetical order
+	Export          bool               // write each variable as an "export KEY='value'" shell statement
+}
+
+// variable is a flattened key and its value.
+type variable struct {
+	Key   string
+	Value interface{}
+}
+
+// sanitizeKey replaces the characters that are not valid in the name of a shell variable with underscores.
+func sanitizeKey(key string) string {
+	b := []byte(key)
+	for i, c := range b {
+		if !isNameByte(c, false) {
+			b[i] = '_'
+		}
+	}
+	if len(b) > 0 && !isNameByte(b[0], true) {
+		return "_" + string(b)
+	}
+	return string(b)
+}
+
+// quoteValue quotes the value using double quotes if it contains any characters that are not read literally.
+func quoteValue(value string) string {
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if !(isNameByte(c, false) || strings.IndexByte("%+,-./:@", c) != -1) {
+			r := strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "$", "\\$", "\n", "\\n", "\r", "\\r", "\t", "\\t")
+			return "\"" + r.Replace(value) + "\""
+		}
+	}
+	return value
+}
+
+// flatten appends the flattened variables of the given value to the slice of variables.
+func flatten(prefix string, value reflect.Value, input *WriteInput, keySerializer stringify.Stringer, variables []variable) ([]variable, error) {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return append(variables, variable{Key: prefix, Value: nil}), nil
+		}
+		value = value.Elem()
+	}
+	join := func(key string) string {
+		if len(prefix) == 0 {
+			return key
+		}
+		return prefix + "_" + key
+	}
+	switch value.Kind() {
+	case reflect.Map:
+		for _, key := range inspector.GetKeysFromValue(value, input.Sorted, input.Reversed) {
+			keyString, err := keySerializer(key)
+			if err != nil {
+				return variables, errors.Wrap(err, "error serializing key")
+			}
+			variables, err = flatten(join(keyString), value.MapIndex(reflect.ValueOf(key)), input, keySerializer, variables)
+			if err != nil {
+				return variables, err
+			}
+		}
+		return variables, nil
+	case reflect.Struct:
+		for _, fieldName := range inspector.GetFieldNamesFromValue(value, input.Sorted, input.Reversed) {
+			fieldValue := value.FieldByName(fieldName)
+			if !fieldValue.CanInterface() {
+				continue
+			}
+			keyString, err := keySerializer(fieldName)
+			if err != nil {
+				return variables, errors.Wrap(err, "error serializing key")
+			}
+			variables, err = flatten(join(keyString), fieldValue, input, keySerializer, variables)
+			if err != nil {
+				return variables, err
+			}
+		}
+		return variables, nil
+	case reflect.Array, reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for i := 0; i < value.Len(); i++ {
+			var err error
+			variables, err = flatten(join(strconv.Itoa(i)), value.Index(i), input, keySerializer, variables)
+			if err != nil {
+				return variables, err
+			}
+		}
+		return variables, nil
+	}
+	return append(variables, variable{Key: prefix, Value: value.Interface()}), nil
+}
+
+// Write writes the given object as an environment file.
+// Nested maps, structs, and slices are flattened into PARENT_CHILD keys.
+func Write(input *WriteInput) error {
+
+	if len(input.LineSeparator) == 0 {
+		return ErrMissingLineSeparator
+	}
+
+	inputObjectValue := reflect.ValueOf(input.Object)
+	for inputObjectValue.Kind() == reflect.Interface || inputObjectValue.Kind() == reflect.Ptr {
+		inputObjectValue = inputObjectValue.Elem()
+	}
+	if k := inputObjectValue.Kind(); k != reflect.Map && k != reflect.Struct {
+		if !inputObjectValue.IsValid() {
+			return &ErrInvalidKind{Value: nil, Expected: []reflect.Kind{reflect.Map, reflect.Struct}}
+		}
+		return &ErrInvalidKind{Value: inputObjectValue.Type(), Expected: []reflect.Kind{reflect.Map, reflect.Struct}}
+	}
+
+	keySerializer := input.KeySerializer
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	valueSerializer := input.ValueSerializer
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	variables, err := flatten("", inputObjectValue, input, keySerializer, make([]variable, 0))
+	if err != nil {
+		return errors.Wrap(err, "error flattening object")
+	}
+
+	for _, v := range variables {
+		valueString, err := valueSerializer(v.Value)
+		if err != nil {
+			return errors.Wrap(err, "error serializing value")
+		}
+		line := ""
+		if input.Export {
+			line = "export " + sanitizeKey(v.Key) + "='" + strings.Replace(valueString, "'", "'\\''", -1) + "'" + input.LineSeparator
+		} else {
+			line = sanitizeKey(v.Key) + "=" + quoteValue(valueString) + input.LineSeparator
+		}
+		_, err = input.Writer.Write([]byte(line))
+		if err != nil {
+			return errors.Wrap(err, "error writing variable to output writer")
+		}
+	}
+
+	return nil
+}
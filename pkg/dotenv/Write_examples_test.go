@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// This example shows you can write a nested map as shell export statements with upper case keys.
+func ExampleWrite_export() {
+	obj := map[string]interface{}{
+		"db": map[string]interface{}{
+			"host": "localhost",
+			"port": 5432,
+		},
+	}
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		LineSeparator: "\n",
+		Object:        obj,
+		KeySerializer: stringify.NewStringer("", false, false, true),
+		Sorted:        true,
+		Export:        true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+	// Output: export DB_HOST='localhost'
+	// export DB_PORT='5432'
+}
@@ -0,0 +1,117 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package dotenv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+	"github.com/stretchr/testify/assert"
+)
+
+var testObject = map[string]interface{}{
+	"name": "example app",
+	"db": map[string]interface{}{
+		"host":     "localhost",
+		"port":     5432,
+		"password": "it's $ecret",
+	},
+	"hosts":     []interface{}{"a", "b"},
+	"log-level": "debug",
+}
+
+func TestWrite(t *testing.T) {
+	b := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:        b,
+		LineSeparator: "\n",
+		Object:        testObject,
+		KeySerializer: stringify.NewStringer("", false, false, true),
+		Sorted:        true,
+	})
+	assert.NoError(t, err)
+	expected := "DB_HOST=localhost\nDB_PASSWORD=\"it's \\$ecret\"\nDB_PORT=5432\nHOSTS_0=a\nHOSTS_1=b\nLOG_LEVEL=debug\nNAME=\"example app\"\n"
+	assert.Equal(t, expected, b.String())
+
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader(b.String()),
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"DB_HOST":     "localhost",
+		"DB_PASSWORD": "it's $ecret",
+		"DB_PORT":     "5432",
+		"HOSTS_0":     "a",
+		"HOSTS_1":     "b",
+		"LOG_LEVEL":   "debug",
+		"NAME":        "example app",
+	}, out)
+}
+
+func TestWriteExport(t *testing.T) {
+	b := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:        b,
+		LineSeparator: "\n",
+		Object:        testObject,
+		Sorted:        true,
+		Export:        true,
+	})
+	assert.NoError(t, err)
+	expected := "export db_host='localhost'\nexport db_password='it'\\''s $ecret'\nexport db_port='5432'\nexport hosts_0='a'\nexport hosts_1='b'\nexport log_level='debug'\nexport name='example app'\n"
+	assert.Equal(t, expected, b.String())
+
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader(b.String()),
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"db_host":     "localhost",
+		"db_password": "it's $ecret",
+		"db_port":     "5432",
+		"hosts_0":     "a",
+		"hosts_1":     "b",
+		"log_level":   "debug",
+		"name":        "example app",
+	}, out)
+}
+
+func TestWriteStruct(t *testing.T) {
+	obj := struct {
+		A string
+		B struct {
+			C int
+		}
+		d string
+	}{A: "x", d: "hidden"}
+	obj.B.C = 1
+
+	b := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:        b,
+		LineSeparator: "\n",
+		Object:        &obj,
+		Sorted:        true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "A=x\nB_C=1\n", b.String())
+}
+
+func TestWriteInvalidKind(t *testing.T) {
+	err := Write(&WriteInput{
+		Writer:        new(strings.Builder),
+		LineSeparator: "\n",
+		Object:        []string{"a"},
+	})
+	assert.IsType(t, &ErrInvalidKind{}, err)
+}
@@ -0,0 +1,39 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package dotenv includes functions for reading and writing environment files, aka ".env" files.
+//
+// Each line of an environment file is a KEY=value pair, optionally prefixed with "export".
+// Lines beginning with "#" are comments.  Values can be unquoted, single-quoted, or double-quoted.
+// Single-quoted values are read literally.
+// Unquoted and double-quoted values expand references to variables defined earlier in the file, e.g., ${VAR}, ${VAR:-default}, or $VAR.
+// Double-quoted values also support the escape sequences \n, \r, \t, \", \\, and \$.
+//
+// When writing, nested maps, structs, and slices are flattened into PARENT_CHILD keys,
+// and characters that are not valid in the name of a shell variable are replaced with underscores.
+// If Export is true, each line is written as a POSIX-shell-safe "export KEY='value'" statement.
+//
+// See the examples below for usage.
+//
+// Reference:
+//  - https://github.com/motdotla/dotenv
+//  - https://pubs.opengroup.org/onlinepubs/9699919799/utilities/V3_chap02.html
+//
+package dotenv
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrMissingLineSeparator     = errors.New("missing line separator")
+	ErrMissingKeyValueSeparator = errors.New("missing key-value separator")
+	ErrMissingKey               = errors.New("missing key")
+	ErrInvalidUTF8              = errors.New("invalid utf-8")
+	ErrUnterminatedQuote        = errors.New("unterminated quote")
+	ErrUnterminatedVariable     = errors.New("unterminated variable")
+)
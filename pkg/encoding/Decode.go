@@ -0,0 +1,39 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Decode transcodes the given bytes from the given encoding into UTF-8 and removes a byte order mark, if present.
+// If the encoding is "auto", then the encoding is detected from the first SampleSize bytes.
+// UTF-8 is not transcoded, so bytes that are not valid UTF-8 are kept as is,
+// unless strict is true, in which case invalid UTF-8 returns an error.
+// If the encoding is empty, then returns the bytes as is.
+func Decode(b []byte, encoding string, strict bool) ([]byte, error) {
+	if len(encoding) == 0 {
+		return b, nil
+	}
+	if encoding == EncodingAuto {
+		if len(b) > SampleSize {
+			encoding = detect(b[:SampleSize], true, strict)
+		} else {
+			encoding = detect(b, false, strict)
+		}
+	}
+	d, err := decoder(encoding, strict)
+	if err != nil {
+		return nil, err
+	}
+	out, err := d.Bytes(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s", encoding)
+	}
+	return out, nil
+}
@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       []byte
+		encoding string
+		expected string
+	}{
+		{name: "None", in: []byte("\xEF\xBB\xBFa"), encoding: "", expected: "\xEF\xBB\xBFa"},
+		{name: "UTF8BOM", in: []byte("\xEF\xBB\xBFa,b"), encoding: EncodingUTF8, expected: "a,b"},
+		{name: "UTF16LE", in: []byte{0xFF, 0xFE, 'a', 0x00, 0xE9, 0x00}, encoding: EncodingUTF16LE, expected: "aé"},
+		{name: "UTF16BE", in: []byte{0x00, 'a', 0x00, 0xE9}, encoding: EncodingUTF16BE, expected: "aé"},
+		{name: "Latin1", in: []byte("caf\xE9"), encoding: EncodingLatin1, expected: "café"},
+		{name: "Windows1252", in: []byte("\x93quoted\x94 \x80"), encoding: EncodingWindows1252, expected: "“quoted” €"},
+		{name: "AutoUTF16LE", in: []byte{0xFF, 0xFE, 'a', 0x00, ',', 0x00, 'b', 0x00}, encoding: EncodingAuto, expected: "a,b"},
+		{name: "AutoLatin1", in: []byte("caf\xE9"), encoding: EncodingAuto, expected: "café"},
+		{name: "UTF8Invalid", in: []byte("\xEF\xBB\xBFcafé\xFF"), encoding: EncodingUTF8, expected: "café\xFF"},
+		{name: "AutoUTF8StrayByte", in: []byte("café\xFF"), encoding: EncodingAuto, expected: "café\xFF"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Decode(tc.in, tc.encoding, false)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(out))
+		})
+	}
+}
+
+func TestDecodeStrict(t *testing.T) {
+	out, err := Decode([]byte("\xEF\xBB\xBFcafé"), EncodingUTF8, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "café", string(out))
+
+	_, err = Decode([]byte("caf\xE9"), EncodingUTF8, true)
+	assert.Error(t, err)
+
+	_, err = Decode([]byte("caf\xE9"), EncodingAuto, true)
+	assert.Error(t, err)
+}
+
+func TestDecodeUnknown(t *testing.T) {
+	out, err := Decode([]byte("a"), "ebcdic", false)
+	assert.Equal(t, &ErrUnknownEncoding{Value: "ebcdic"}, err)
+	assert.Nil(t, out)
+}
@@ -0,0 +1,83 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// Detect returns the encoding of the given text.
+// A byte order mark is used if present.  Otherwise, UTF-16 without a byte order mark is detected using the pattern of zero bytes in ASCII text.
+// Text that is not valid UTF-8 and does not include any multi-byte UTF-8 characters returns EncodingWindows1252.
+// Any other text returns EncodingUTF8, so that a few stray bytes do not change how the valid UTF-8 characters are decoded.
+func Detect(text []byte) string {
+	return detect(text, false, false)
+}
+
+// detect returns the encoding of the given sample of text.
+// If truncated is true, then the sample is the start of a longer text,
+// and a rune that is cut off at the end of the sample does not make the sample invalid UTF-8.
+// If strict is true, then text without a byte order mark that is not UTF-16 returns EncodingUTF8, so that invalid UTF-8 is rejected.
+func detect(sample []byte, truncated bool, strict bool) string {
+	switch {
+	case bytes.HasPrefix(sample, BOMUTF8):
+		return EncodingUTF8
+	case bytes.HasPrefix(sample, BOMUTF16LE):
+		return EncodingUTF16LE
+	case bytes.HasPrefix(sample, BOMUTF16BE):
+		return EncodingUTF16BE
+	}
+	if len(sample) >= 2 {
+		even, odd := 0, 0
+		for i, c := range sample {
+			if c == 0 {
+				if i%2 == 0 {
+					even++
+				} else {
+					odd++
+				}
+			}
+		}
+		pairs := len(sample) / 2
+		if odd > pairs/4 && even == 0 {
+			return EncodingUTF16LE
+		}
+		if even > pairs/4 && odd == 0 {
+			return EncodingUTF16BE
+		}
+	}
+	if strict {
+		return EncodingUTF8
+	}
+	if truncated {
+		// Ignore a rune that is cut off at the end of the sample.
+		for i := len(sample) - 1; i >= 0 && i >= len(sample)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(sample[i]) {
+				if !utf8.FullRune(sample[i:]) {
+					sample = sample[:i]
+				}
+				break
+			}
+		}
+	}
+	invalid, multibyte := false, false
+	for i := 0; i < len(sample); {
+		r, size := utf8.DecodeRune(sample[i:])
+		if r == utf8.RuneError && size == 1 {
+			invalid = true
+		} else if size > 1 {
+			multibyte = true
+		}
+		i += size
+	}
+	if invalid && !multibyte {
+		return EncodingWindows1252
+	}
+	return EncodingUTF8
+}
@@ -0,0 +1,48 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       []byte
+		expected string
+	}{
+		{name: "Empty", in: []byte{}, expected: EncodingUTF8},
+		{name: "ASCII", in: []byte("a,b\n1,2\n"), expected: EncodingUTF8},
+		{name: "UTF8", in: []byte("café"), expected: EncodingUTF8},
+		{name: "UTF8BOM", in: []byte("\xEF\xBB\xBFa,b"), expected: EncodingUTF8},
+		{name: "UTF16LEBOM", in: []byte{0xFF, 0xFE, 'a', 0x00}, expected: EncodingUTF16LE},
+		{name: "UTF16BEBOM", in: []byte{0xFE, 0xFF, 0x00, 'a'}, expected: EncodingUTF16BE},
+		{name: "UTF16LE", in: []byte{'a', 0x00, ',', 0x00, 'b', 0x00}, expected: EncodingUTF16LE},
+		{name: "UTF16BE", in: []byte{0x00, 'a', 0x00, ',', 0x00, 'b'}, expected: EncodingUTF16BE},
+		{name: "Latin1", in: []byte("caf\xE9,na\xEFve"), expected: EncodingWindows1252},
+		{name: "UTF8StrayByte", in: []byte("name\ncafé\n\xFF\n"), expected: EncodingUTF8},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Detect(tc.in))
+		})
+	}
+}
+
+func TestDetectTruncated(t *testing.T) {
+	assert.Equal(t, EncodingUTF8, detect([]byte("caf\xC3"), true, false))
+	assert.Equal(t, EncodingWindows1252, detect([]byte("caf\xC3"), false, false))
+}
+
+func TestDetectStrict(t *testing.T) {
+	assert.Equal(t, EncodingUTF8, detect([]byte("caf\xE9"), false, true))
+	assert.Equal(t, EncodingUTF16LE, detect([]byte{0xFF, 0xFE, 'a', 0x00}, false, true))
+}
@@ -0,0 +1,30 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Encode transcodes the given UTF-8 bytes into the given encoding.
+// If bom is true, then the output starts with a byte order mark.  Latin-1 and Windows-1252 do not have a byte order mark and return ErrNoBOM.
+// If the encoding is empty, then returns the bytes as is.
+func Encode(b []byte, encoding string, bom bool) ([]byte, error) {
+	if len(encoding) == 0 || (encoding == EncodingUTF8 && !bom) {
+		return b, nil
+	}
+	e, err := encoder(encoding, bom)
+	if err != nil {
+		return nil, err
+	}
+	out, err := e.Bytes(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error encoding %s", encoding)
+	}
+	return out, nil
+}
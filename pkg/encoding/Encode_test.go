@@ -0,0 +1,47 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       string
+		encoding string
+		bom      bool
+		expected []byte
+	}{
+		{name: "UTF8", in: "a", encoding: EncodingUTF8, bom: false, expected: []byte("a")},
+		{name: "UTF8BOM", in: "a", encoding: EncodingUTF8, bom: true, expected: []byte("\xEF\xBB\xBFa")},
+		{name: "UTF16LE", in: "aé", encoding: EncodingUTF16LE, bom: false, expected: []byte{'a', 0x00, 0xE9, 0x00}},
+		{name: "UTF16LEBOM", in: "a", encoding: EncodingUTF16LE, bom: true, expected: []byte{0xFF, 0xFE, 'a', 0x00}},
+		{name: "UTF16BEBOM", in: "a", encoding: EncodingUTF16BE, bom: true, expected: []byte{0xFE, 0xFF, 0x00, 'a'}},
+		{name: "Latin1", in: "café", encoding: EncodingLatin1, bom: false, expected: []byte("caf\xE9")},
+		{name: "Windows1252", in: "€", encoding: EncodingWindows1252, bom: false, expected: []byte{0x80}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Encode([]byte(tc.in), tc.encoding, tc.bom)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	_, err := Encode([]byte("a"), EncodingLatin1, true)
+	assert.Equal(t, ErrNoBOM, err)
+
+	_, err = Encode([]byte("€"), EncodingLatin1, false)
+	assert.Error(t, err)
+}
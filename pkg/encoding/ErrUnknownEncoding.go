@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"fmt"
+)
+
+// ErrUnknownEncoding is used when the encoding is not one of the supported encodings.
+type ErrUnknownEncoding struct {
+	Value string // the unknown encoding
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownEncoding) Error() string {
+	return fmt.Sprintf("unknown encoding %q", e.Value)
+}
@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/transform"
+)
+
+// NewReader returns a reader that transcodes the underlying reader from the given encoding into UTF-8 and removes a byte order mark, if present.
+// If the encoding is "auto", then the encoding is detected from the first SampleSize bytes.
+// UTF-8 is not transcoded, so bytes that are not valid UTF-8 are kept as is,
+// unless strict is true, in which case invalid UTF-8 returns an error when read.
+// If the encoding is empty, then returns the underlying reader as is.
+func NewReader(r io.Reader, encoding string, strict bool) (io.Reader, error) {
+	if len(encoding) == 0 {
+		return r, nil
+	}
+	if encoding == EncodingAuto {
+		br := bufio.NewReaderSize(r, SampleSize)
+		sample, err := br.Peek(SampleSize)
+		if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+			return nil, errors.Wrap(err, "error reading sample to detect encoding")
+		}
+		r = br
+		encoding = detect(sample, err == nil || err == bufio.ErrBufferFull, strict)
+	}
+	d, err := decoder(encoding, strict)
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewReader(r, d), nil
+}
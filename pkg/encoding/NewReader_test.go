@@ -0,0 +1,42 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReader(t *testing.T) {
+	r, err := NewReader(bytes.NewReader([]byte{0xFF, 0xFE, 'a', 0x00, '\n', 0x00, 0xE9, 0x00}), EncodingAuto, false)
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "a\né", string(out))
+}
+
+func TestNewReaderLarge(t *testing.T) {
+	// The first rune that is not valid UTF-8 is after the sample, so the input is decoded as UTF-8.
+	in := append(bytes.Repeat([]byte("a"), SampleSize*2), []byte("\xEF\xBB\xBF")...)
+	r, err := NewReader(bytes.NewReader(in), EncodingAuto, false)
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestNewReaderStrict(t *testing.T) {
+	r, err := NewReader(bytes.NewReader([]byte("\xEF\xBB\xBFa\xFF")), EncodingUTF8, true)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"io"
+
+	"golang.org/x/text/transform"
+)
+
+// nopCloser wraps a writer with a Close method that does nothing.
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// NewWriter returns a writer that transcodes UTF-8 into the given encoding before writing to the underlying writer.
+// If bom is true, then a byte order mark is written before the first bytes.  Latin-1 and Windows-1252 do not have a byte order mark and return ErrNoBOM.
+// If the encoding is empty, then returns the underlying writer as is.
+// Close flushes any incomplete rune, but does not close the underlying writer.
+func NewWriter(w io.Writer, encoding string, bom bool) (io.WriteCloser, error) {
+	if len(encoding) == 0 || (encoding == EncodingUTF8 && !bom) {
+		return nopCloser{Writer: w}, nil
+	}
+	e, err := encoder(encoding, bom)
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewWriter(w, e), nil
+}
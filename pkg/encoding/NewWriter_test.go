@@ -0,0 +1,38 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, EncodingUTF16LE, true)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("a,"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("é"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.Equal(t, []byte{0xFF, 0xFE, 'a', 0x00, ',', 0x00, 0xE9, 0x00}, buf.Bytes())
+}
+
+func TestNewWriterUTF8(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, EncodingUTF8, false)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("café"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.Equal(t, "café", buf.String())
+}
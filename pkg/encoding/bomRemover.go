@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	"bytes"
+
+	"golang.org/x/text/transform"
+)
+
+// bomRemover is a transformer that removes a UTF-8 byte order mark from the start of the input
+// and copies the rest of the input as is.
+type bomRemover struct {
+	started bool // true once the start of the input has been checked for a byte order mark
+}
+
+// Transform removes the byte order mark, if present, and copies the source to the destination.
+func (t *bomRemover) Transform(dst, src []byte, atEOF bool) (int, int, error) {
+	nSrc := 0
+	if !t.started {
+		if !atEOF && len(src) < len(BOMUTF8) && bytes.HasPrefix(BOMUTF8, src) {
+			return 0, 0, transform.ErrShortSrc
+		}
+		t.started = true
+		if bytes.HasPrefix(src, BOMUTF8) {
+			nSrc = len(BOMUTF8)
+		}
+	}
+	n := copy(dst, src[nSrc:])
+	if nSrc+n < len(src) {
+		return n, nSrc + n, transform.ErrShortDst
+	}
+	return n, nSrc + n, nil
+}
+
+// Reset resets the transformer, so that the next input is checked for a byte order mark.
+func (t *bomRemover) Reset() {
+	t.started = false
+}
@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package encoding provides functions for detecting and transcoding the text encoding of input and output.
+// Input is transcoded into UTF-8 and any byte order mark (BOM) is removed, so that the readers in go-simple-serializer only handle UTF-8.
+// Output is transcoded from UTF-8 and can start with a byte order mark, as Microsoft Excel expects for CSV files.
+// This package wraps the golang.org/x/text packages.
+//	- https://godoc.org/golang.org/x/text/encoding
+package encoding
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	EncodingAuto        = "auto"         // detect the encoding from the byte order mark or the content
+	EncodingUTF8        = "utf-8"        // UTF-8
+	EncodingUTF16LE     = "utf-16le"     // UTF-16 (little-endian)
+	EncodingUTF16BE     = "utf-16be"     // UTF-16 (big-endian)
+	EncodingLatin1      = "latin1"       // ISO-8859-1
+	EncodingWindows1252 = "windows-1252" // Windows-1252, a superset of the printable characters of ISO-8859-1
+
+	// SampleSize is the number of bytes used to detect the encoding.
+	SampleSize = 4096
+)
+
+var (
+	// InputEncodings is a list of the encodings supported when reading.
+	InputEncodings = []string{
+		EncodingAuto,
+		EncodingUTF8,
+		EncodingUTF16LE,
+		EncodingUTF16BE,
+		EncodingLatin1,
+		EncodingWindows1252,
+	}
+	// OutputEncodings is a list of the encodings supported when writing.
+	OutputEncodings = []string{
+		EncodingUTF8,
+		EncodingUTF16LE,
+		EncodingUTF16BE,
+		EncodingLatin1,
+		EncodingWindows1252,
+	}
+)
+
+var (
+	BOMUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	BOMUTF16LE = []byte{0xFF, 0xFE}
+	BOMUTF16BE = []byte{0xFE, 0xFF}
+)
+
+var (
+	ErrNoBOM = errors.New("encoding does not have a byte order mark")
+)
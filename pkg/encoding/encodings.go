@@ -0,0 +1,67 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package encoding
+
+import (
+	xencoding "golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// decoder returns a decoder that transcodes the given encoding into UTF-8 and removes a byte order mark, if present.
+// UTF-8 is not transcoded, so that bytes that are not valid UTF-8 are not replaced.
+// If strict is true, then UTF-8 that is not valid returns xencoding.ErrInvalidUTF8.
+func decoder(encoding string, strict bool) (*xencoding.Decoder, error) {
+	switch encoding {
+	case EncodingUTF8:
+		if strict {
+			return &xencoding.Decoder{Transformer: transform.Chain(&bomRemover{}, xencoding.UTF8Validator)}, nil
+		}
+		return &xencoding.Decoder{Transformer: &bomRemover{}}, nil
+	case EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder(), nil
+	case EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewDecoder(), nil
+	case EncodingLatin1:
+		return charmap.ISO8859_1.NewDecoder(), nil
+	case EncodingWindows1252:
+		return charmap.Windows1252.NewDecoder(), nil
+	}
+	return nil, &ErrUnknownEncoding{Value: encoding}
+}
+
+// encoder returns an encoder that transcodes UTF-8 into the given encoding.
+// If bom is true, then the output starts with a byte order mark.
+// Runes that cannot be represented in the encoding return an error.
+func encoder(encoding string, bom bool) (*xencoding.Encoder, error) {
+	policy := unicode.IgnoreBOM
+	if bom {
+		policy = unicode.UseBOM
+	}
+	switch encoding {
+	case EncodingUTF8:
+		if bom {
+			return unicode.UTF8BOM.NewEncoder(), nil
+		}
+		return unicode.UTF8.NewEncoder(), nil
+	case EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, policy).NewEncoder(), nil
+	case EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, policy).NewEncoder(), nil
+	case EncodingLatin1, EncodingWindows1252:
+		if bom {
+			return nil, ErrNoBOM
+		}
+		if encoding == EncodingLatin1 {
+			return charmap.ISO8859_1.NewEncoder(), nil
+		}
+		return charmap.Windows1252.NewEncoder(), nil
+	}
+	return nil, &ErrUnknownEncoding{Value: encoding}
+}
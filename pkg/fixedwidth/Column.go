@@ -0,0 +1,38 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Column describes the position of a column in fixed-width text.
+type Column struct {
+	Name  string // the name of the column
+	Start int    // the zero-based offset of the first character of the column
+	Width int    // the number of characters in the column.  If zero, then the column extends to the end of the line.
+}
+
+// String returns the column formatted as a spec, name:start:width.
+func (c Column) String() string {
+	return fmt.Sprintf("%s:%d:%d", c.Name, c.Start, c.Width)
+}
+
+// Extract returns the value of the column from the given line, with surrounding whitespace trimmed.
+// If the line ends before the column, then returns an empty string.
+func (c Column) Extract(line []rune) string {
+	if c.Start >= len(line) {
+		return ""
+	}
+	end := len(line)
+	if c.Width > 0 && c.Start+c.Width < end {
+		end = c.Start + c.Width
+	}
+	return strings.TrimSpace(string(line[c.Start:end]))
+}
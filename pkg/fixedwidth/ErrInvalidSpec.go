@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+)
+
+// ErrInvalidSpec is used when a column spec is not formatted as name:start:width.
+type ErrInvalidSpec struct {
+	Value  string // the invalid spec
+	Reason string // the reason the spec is invalid
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidSpec) Error() string {
+	return fmt.Sprintf("invalid column spec %q, expecting name:start:width: %s", e.Value, e.Reason)
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+)
+
+// ErrOverlappingColumns is used when writing columns that are out of order or overlap.
+type ErrOverlappingColumns struct {
+	Previous Column // the previous column
+	Next     Column // the column that starts before the previous column ends
+}
+
+// Error returns the error formatted as a string.
+func (e ErrOverlappingColumns) Error() string {
+	return fmt.Sprintf("column %q overlaps with previous column %q", e.Next.String(), e.Previous.String())
+}
@@ -0,0 +1,12 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+type Flusher interface {
+	Flush() error
+}
@@ -0,0 +1,65 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"github.com/pkg/errors"
+)
+
+// IsRuler returns true if the line is a ruler line, which only includes spaces and runs of dashes or equal signs.
+func IsRuler(line string) bool {
+	found := false
+	for _, r := range line {
+		switch r {
+		case '-', '=':
+			found = true
+		case ' ', '\t':
+		default:
+			return false
+		}
+	}
+	return found
+}
+
+// InferColumns returns the columns marked by the runs of dashes or equal signs in the ruler line
+// and named by the text of the header line above each run.
+// Each column extends to the start of the next column, and the last column extends to the end of the line.
+func InferColumns(header string, ruler string) ([]Column, error) {
+	if !IsRuler(ruler) {
+		return nil, ErrMissingRuler
+	}
+
+	// find the offset of the first character of each run of dashes or equal signs
+	starts := make([]int, 0)
+	inRun := false
+	for i, r := range []rune(ruler) {
+		if r == '-' || r == '=' {
+			if !inRun {
+				starts = append(starts, i)
+			}
+			inRun = true
+		} else {
+			inRun = false
+		}
+	}
+
+	h := []rune(header)
+	columns := make([]Column, 0, len(starts))
+	for i, start := range starts {
+		c := Column{Start: start, Width: 0}
+		if i+1 < len(starts) {
+			c.Width = starts[i+1] - start
+		}
+		c.Name = c.Extract(h)
+		if len(c.Name) == 0 {
+			return nil, errors.Errorf("missing name in header line for column starting at offset %d", start)
+		}
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
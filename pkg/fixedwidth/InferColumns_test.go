@@ -0,0 +1,43 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRuler(t *testing.T) {
+	assert.True(t, IsRuler("----- --- ====="))
+	assert.False(t, IsRuler("     "))
+	assert.False(t, IsRuler("--- abc"))
+}
+
+func TestInferColumns(t *testing.T) {
+	columns, err := InferColumns(
+		"name       age  city",
+		"---------- ---- ----------",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []Column{
+		{Name: "name", Start: 0, Width: 11},
+		{Name: "age", Start: 11, Width: 5},
+		{Name: "city", Start: 16, Width: 0},
+	}, columns)
+}
+
+func TestInferColumnsMissingRuler(t *testing.T) {
+	_, err := InferColumns("name age", "alice 30")
+	assert.Equal(t, ErrMissingRuler, err)
+}
+
+func TestInferColumnsMissingName(t *testing.T) {
+	_, err := InferColumns("name", "---- ---")
+	assert.Error(t, err)
+}
@@ -0,0 +1,191 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
+)
+
+// Iterator iterates trough a stream of fixed-width lines
+// returning a new map on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Scanner      scanner.Scanner // the scanner that splits the underlying stream of bytes
+	Type         reflect.Type    // the type of map to return for each line
+	Columns      []Column        // the columns, if empty, then inferred from the header and ruler lines.
+	Comment      string          // The comment line prefix.  Can be any string.
+	SkipBlanks   bool            // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments bool            // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Limit        int             // Limit the number of objects to read and return from the underlying stream.
+	Count        int             // The current count of the number of objects read.
+	Line         int             // The current line number.
+	Limits       limits.Limits   // The maximum number of columns.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader              io.Reader
+	Type                reflect.Type   // the type of map to return for each line, defaults to map[string]string.
+	Columns             []Column       // the columns, if empty, then inferred from the header and ruler lines.
+	SkipLines           int            // Skip a given number of lines at the beginning of the stream.
+	SkipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Comment             string         // The comment line prefix. Can be any string.
+	Limit               int            // Limit the number of objects to read and return from the underlying stream.
+	LineSeparator       string         // The line separator, which can be any sequence of bytes, e.g., "\n" or "\r\n".
+	LineSeparatorRegexp *regexp.Regexp // If not nil, split lines on matches of the regular expression rather than the line separator.
+	DropCR              bool           // Drop carriage returns at the end of lines.
+	Limits              limits.Limits  // The maximum size of each line and number of columns.
+}
+
+// NewIterator returns a new fixed-width Iterator based on the given input.
+// If no columns are given, then the columns are inferred from the first two lines
+// (after skipping lines) when Next is first called.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+
+	t := DefaultType
+	if input.Type != nil {
+		t = input.Type
+	}
+	if t.Kind() != reflect.Map {
+		return nil, &ErrInvalidKind{Value: t, Expected: []reflect.Kind{reflect.Map}}
+	}
+	if k := t.Key().Kind(); k != reflect.String && k != reflect.Interface {
+		return nil, errors.Errorf("type %q must have keys of kind string or interface", t)
+	}
+	if k := t.Elem().Kind(); k != reflect.String && k != reflect.Interface {
+		return nil, errors.Errorf("type %q must have values of kind string or interface", t)
+	}
+
+	if err := input.Limits.CheckKeys(len(input.Columns)); err != nil {
+		return nil, errors.Wrap(err, "error checking columns")
+	}
+
+	if len(input.LineSeparator) == 0 && input.LineSeparatorRegexp == nil {
+		return nil, ErrMissingLineSeparator
+	}
+
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
+	line := 0
+	for i := 0; i < input.SkipLines; i++ {
+		if !s.Scan() {
+			break
+		}
+		line++
+	}
+
+	return &Iterator{
+		Scanner:      s,
+		Type:         t,
+		Columns:      input.Columns,
+		Comment:      input.Comment,
+		SkipBlanks:   input.SkipBlanks,
+		SkipComments: input.SkipComments,
+		Limit:        input.Limit,
+		Count:        0,
+		Line:         line,
+		Limits:       input.Limits,
+	}, nil
+}
+
+// scan returns the next line and true, or an empty string and false if the stream is exhausted.
+func (it *Iterator) scan() (string, bool) {
+	if it.Scanner.Scan() {
+		it.Line++
+		return it.Scanner.Text(), true
+	}
+	return "", false
+}
+
+// inferColumns reads the header line and ruler line and infers the columns.
+func (it *Iterator) inferColumns() error {
+	header, ok := it.scan()
+	for ok && len(strings.TrimSpace(header)) == 0 {
+		header, ok = it.scan()
+	}
+	if !ok {
+		return io.EOF
+	}
+	ruler, ok := it.scan()
+	if !ok {
+		return ErrMissingRuler
+	}
+	columns, err := InferColumns(header, ruler)
+	if err != nil {
+		return errors.Wrapf(err, "error inferring columns from lines %d and %d", it.Line-1, it.Line)
+	}
+	if err := it.Limits.CheckKeys(len(columns)); err != nil {
+		return errors.Wrap(err, "error checking columns")
+	}
+	it.Columns = columns
+	return nil
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// If a blank line is found and SkipBlanks is false, then returns (nil, nil).
+// If a commented line is found and SkipComments is false, then returns (nil, nil).
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	if len(it.Columns) == 0 {
+		if err := it.inferColumns(); err != nil {
+			if err == io.EOF {
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "error reading header")
+		}
+	}
+
+	if line, ok := it.scan(); ok {
+		if len(strings.TrimSpace(line)) == 0 {
+			if it.SkipBlanks {
+				return it.Next()
+			}
+			return nil, nil
+		}
+		if len(it.Comment) > 0 && strings.HasPrefix(line, it.Comment) {
+			if it.SkipComments {
+				return it.Next()
+			}
+			return nil, nil
+		}
+		// Increment Counter
+		it.Count++
+		runes := []rune(line)
+		m := reflect.MakeMap(it.Type)
+		for _, c := range it.Columns {
+			m.SetMapIndex(
+				reflect.ValueOf(c.Name).Convert(it.Type.Key()),
+				reflect.ValueOf(c.Extract(runes)).Convert(it.Type.Elem()),
+			)
+		}
+		return m.Interface(), nil
+	}
+	if err := it.Scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", it.Line+1)
+	}
+	return nil, io.EOF
+}
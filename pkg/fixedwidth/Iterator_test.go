@@ -0,0 +1,104 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestIterator(t *testing.T) {
+	text := "alice 30paris\nbob    4\n\n# comment\ncarolyn\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		Columns:       []Column{{Name: "name", Start: 0, Width: 6}, {Name: "age", Start: 6, Width: 2}, {Name: "city", Start: 8, Width: 0}},
+		SkipBlanks:    true,
+		SkipComments:  true,
+		Comment:       "#",
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "alice", "age": "30", "city": "paris"}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "bob", "age": "4", "city": ""}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "caroly", "age": "n", "city": ""}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorRuler(t *testing.T) {
+	text := "name   age\n------ ---\nalice   30\nbob      4\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		Type:          reflect.TypeOf(map[string]interface{}{}),
+		LineSeparator: "\n",
+		Limit:         1,
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "alice", "age": "30"}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorEmpty(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(""),
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	text := "a b c\n- - -\n1 2 3\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		LineSeparator: "\n",
+		Limits:        limits.Limits{MaxKeys: 2},
+	})
+	assert.NoError(t, err)
+
+	_, err = it.Next()
+	assert.Error(t, err)
+}
+
+func TestIteratorInvalidType(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(""),
+		Type:          reflect.TypeOf(map[string]int{}),
+		LineSeparator: "\n",
+	})
+	assert.Error(t, err)
+}
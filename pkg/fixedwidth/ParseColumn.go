@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseColumn parses a column spec formatted as name:start:width, e.g., "name:0:10".
+// The name may include colons, since the start and width are parsed from the end of the spec.
+func ParseColumn(spec string) (Column, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 3 {
+		return Column{}, &ErrInvalidSpec{Value: spec, Reason: "missing start or width"}
+	}
+	name := strings.Join(parts[:len(parts)-2], ":")
+	if len(name) == 0 {
+		return Column{}, &ErrInvalidSpec{Value: spec, Reason: "missing name"}
+	}
+	start, err := strconv.Atoi(parts[len(parts)-2])
+	if err != nil || start < 0 {
+		return Column{}, &ErrInvalidSpec{Value: spec, Reason: "start must be a non-negative integer"}
+	}
+	width, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil || width < 0 {
+		return Column{}, &ErrInvalidSpec{Value: spec, Reason: "width must be a non-negative integer"}
+	}
+	return Column{Name: name, Start: start, Width: width}, nil
+}
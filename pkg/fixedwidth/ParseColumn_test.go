@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColumn(t *testing.T) {
+	c, err := ParseColumn("name:0:10")
+	assert.NoError(t, err)
+	assert.Equal(t, Column{Name: "name", Start: 0, Width: 10}, c)
+	assert.Equal(t, "name:0:10", c.String())
+}
+
+func TestParseColumnNameWithColon(t *testing.T) {
+	c, err := ParseColumn("a:b:4:0")
+	assert.NoError(t, err)
+	assert.Equal(t, Column{Name: "a:b", Start: 4, Width: 0}, c)
+}
+
+func TestParseColumnInvalid(t *testing.T) {
+	for _, spec := range []string{"name", "name:0", ":0:1", "name:x:1", "name:0:-1", "name:-1:1"} {
+		_, err := ParseColumn(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	columns, err := ParseColumns([]interface{}{"name:0:6", "age:6:3"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Column{{Name: "name", Start: 0, Width: 6}, {Name: "age", Start: 6, Width: 3}}, columns)
+}
@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ParseColumns parses a header of column specs, each formatted as name:start:width.
+// Each element of the header is converted to a string before parsing, so the header can be passed as is from the other gss packages.
+func ParseColumns(header []interface{}) ([]Column, error) {
+	columns := make([]Column, 0, len(header))
+	for _, spec := range header {
+		c, err := ParseColumn(fmt.Sprint(spec))
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing columns")
+		}
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
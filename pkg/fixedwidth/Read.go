@@ -0,0 +1,76 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input for the Read function.
+type ReadInput struct {
+	Type                reflect.Type // the output type
+	Reader              io.Reader    // the underlying reader
+	Columns             []Column     // the columns, if empty, then inferred from the header and ruler lines.
+	SkipLines           int
+	SkipBlanks          bool
+	SkipComments        bool
+	Comment             string         // the comment prefix
+	LineSeparator       string         // the line separator, which can be any sequence of bytes
+	LineSeparatorRegexp *regexp.Regexp // if not nil, split lines on matches of the regular expression
+	DropCR              bool           // drop carriage return
+	Limit               int
+	Limits              limits.Limits // the maximum size of each line and number of columns
+}
+
+// Read reads the fixed-width lines from the input Reader into a slice of maps.
+// If no type is given, returns a slice of type []map[string]string.
+func Read(input *ReadInput) (interface{}, error) {
+	inputType := reflect.TypeOf([]map[string]string{})
+	if input.Type != nil {
+		inputType = input.Type
+	}
+
+	// The iterator requires the type to return for each element,
+	// rather than the type of the array itself.
+	iteratorType := inputType.Elem()
+	if iteratorType.Kind() == reflect.Interface {
+		iteratorType = DefaultType
+	}
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:              input.Reader,
+		Type:                iteratorType,
+		Columns:             input.Columns,
+		SkipLines:           input.SkipLines,
+		SkipBlanks:          input.SkipBlanks,
+		SkipComments:        input.SkipComments,
+		Comment:             input.Comment,
+		Limit:               input.Limit,
+		LineSeparator:       input.LineSeparator,
+		LineSeparatorRegexp: input.LineSeparatorRegexp,
+		DropCR:              input.DropCR,
+		Limits:              input.Limits,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating iterator")
+	}
+	output := reflect.MakeSlice(inputType, 0, 0).Interface()
+	w := pipe.NewSliceWriterWithValues(output)
+	err = pipe.NewBuilder().Input(it).Output(w).Run()
+	if err != nil {
+		return w.Values(), errors.Wrap(err, "error reading fixed-width text")
+	}
+	return w.Values(), nil
+}
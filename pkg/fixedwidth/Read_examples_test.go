@@ -0,0 +1,48 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows you can read fixed-width text using column specs.
+func ExampleRead_columns() {
+	in := "0001alice     PARIS\n0002bob       LONDON\n"
+	columns, err := ParseColumns([]interface{}{"id:0:4", "name:4:10", "city:14:0"})
+	if err != nil {
+		panic(err)
+	}
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		Columns:       columns,
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: [map[city:PARIS id:0001 name:alice] map[city:LONDON id:0002 name:bob]]
+}
+
+// This example shows you can read fixed-width text with the columns inferred from a header line and ruler line.
+func ExampleRead_ruler() {
+	in := "id   name\n---- -------\n0001 alice\n0002 bob\n"
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: [map[id:0001 name:alice] map[id:0002 name:bob]]
+}
@@ -0,0 +1,32 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	text := "skip me\nname   age\n------ ---\nalice   30\nbob      4\n"
+
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(text),
+		SkipLines:     1,
+		SkipBlanks:    true,
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"name": "alice", "age": "30"},
+		{"name": "bob", "age": "4"},
+	}, obj)
+}
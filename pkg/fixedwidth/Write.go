@@ -0,0 +1,135 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer          io.Writer   // the underlying writer
+	Columns         []Column    // the columns, if empty, then inferred from the objects.
+	LineSeparator   string      // the line separator
+	Object          interface{} // the object to write
+	KeySerializer   stringify.Stringer
+	ValueSerializer stringify.Stringer
+	Sorted          bool // if inferring columns, sort columns
+	Reversed        bool // if sorted, sort in reverse alphabetical order.
+	Limit           int  // the maximum number of lines to write, if less than zero, then unlimited.
+	Ruler           bool // write a header line and ruler line before the objects
+}
+
+// Write writes the given object(s) as fixed-width lines.
+// If the type of the input object is of kind Array or Slice, then writes each object on its own line.
+// If the type of the input object is of kind Map or Struct, then writes a single line.
+// If no columns are given, then the columns are inferred from the keys of all the objects
+// and each column is as wide as its widest value plus a space.
+func Write(input *WriteInput) error {
+
+	if len(input.LineSeparator) == 0 {
+		return ErrMissingLineSeparator
+	}
+
+	// set the key serializer
+	keySerializer := input.KeySerializer
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	// set the value serializer
+	valueSerializer := input.ValueSerializer
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	inputObjectValue := reflect.ValueOf(input.Object)
+	for reflect.TypeOf(inputObjectValue.Interface()).Kind() == reflect.Ptr {
+		inputObjectValue = inputObjectValue.Elem()
+	}
+	inputObjectValue = reflect.ValueOf(inputObjectValue.Interface()) // sets value to concerete type
+
+	objects := make([]reflect.Value, 0)
+	switch inputObjectValue.Type().Kind() {
+	case reflect.Map, reflect.Struct:
+		objects = append(objects, inputObjectValue)
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < inputObjectValue.Len() && (input.Limit < 0 || i < input.Limit); i++ {
+			objects = append(objects, reflect.ValueOf(inputObjectValue.Index(i).Interface()))
+		}
+	}
+
+	if len(objects) == 0 {
+		// If there are no records then just write nothing
+		return nil
+	}
+
+	columns := input.Columns
+	keys := make([]interface{}, 0, len(columns)) // the keys of the objects for each column
+	for _, c := range columns {
+		keys = append(keys, c.Name)
+	}
+	if len(columns) == 0 {
+		header, knownKeys := sv.CreateHeaderAndKnownKeysFromValue(objects[0], input.Sorted, input.Reversed)
+		for _, object := range objects[1:] {
+			header, knownKeys = sv.ExpandHeader(header, knownKeys, object, input.Sorted, input.Reversed)
+		}
+		if len(header) == 0 {
+			return errors.New(fmt.Sprintf("could not infer the columns from the given value with type %T", input.Object))
+		}
+		widths := make([]int, len(header))
+		for _, object := range objects {
+			row, err := sv.ToRowFromValue(object, header, valueSerializer)
+			if err != nil {
+				return errors.Wrap(err, "error serializing object to row")
+			}
+			for j, value := range row {
+				if n := utf8.RuneCountInString(value); n > widths[j] {
+					widths[j] = n
+				}
+			}
+		}
+		columns = make([]Column, 0, len(header))
+		start := 0
+		for j, key := range header {
+			name, err := keySerializer(key)
+			if err != nil {
+				return errors.Wrapf(err, "error serializing column name %q", key)
+			}
+			width := widths[j]
+			if n := utf8.RuneCountInString(name); n > width {
+				width = n
+			}
+			if j < len(header)-1 {
+				width++ // separate from the next column with a space
+			}
+			columns = append(columns, Column{Name: name, Start: start, Width: width})
+			start += width
+		}
+		keys = header
+	}
+
+	w := NewWriter(input.Writer, columns, input.LineSeparator, valueSerializer, input.Ruler)
+	w.keys = keys // the column names may be serialized differently than the keys of the objects
+	for _, object := range objects {
+		err := w.WriteObject(object.Interface())
+		if err != nil {
+			return errors.Wrap(err, "error writing fixed-width text")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows you can write objects as fixed-width text using the given columns.
+func ExampleWrite_columns() {
+	in := []map[string]string{
+		{"id": "0001", "name": "alice"},
+		{"id": "0002", "name": "bartholomew"},
+	}
+	buf := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		Columns:       []Column{{Name: "id", Start: 0, Width: 4}, {Name: "name", Start: 4, Width: 8}},
+		LineSeparator: "\n",
+		Object:        in,
+		Limit:         -1,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(strings.Replace(buf.String(), " ", ".", -1))
+	// Output: 0001alice...
+	// 0002bartholo
+}
@@ -0,0 +1,73 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrite(t *testing.T) {
+	objects := []interface{}{
+		map[string]interface{}{"name": "alice", "age": 30},
+		map[string]interface{}{"name": "bob", "age": 4, "city": "paris"},
+	}
+
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		LineSeparator: "\n",
+		Object:        objects,
+		Sorted:        true,
+		Limit:         -1,
+		Ruler:         true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "age name  city \n--- ----- -----\n30  alice      \n4   bob   paris\n", buf.String())
+}
+
+func TestWriteColumns(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		Columns:       []Column{{Name: "name", Start: 0, Width: 4}, {Name: "note", Start: 4, Width: 6}},
+		LineSeparator: "\n",
+		Object:        map[string]interface{}{"name": "alice", "note": "a\nb"},
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "alica b   \n", buf.String())
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	objects := []map[string]string{
+		{"name": "alice", "city": "paris"},
+		{"name": "bob", "city": "new york"},
+	}
+
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		LineSeparator: "\n",
+		Object:        objects,
+		Sorted:        true,
+		Limit:         -1,
+		Ruler:         true,
+	})
+	assert.NoError(t, err)
+
+	obj, err := Read(&ReadInput{
+		Reader:        buf,
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, objects, obj)
+}
@@ -0,0 +1,155 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"io"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as fixed-width lines.
+type Writer struct {
+	writer          io.Writer     // writer for the underlying stream
+	columns         []Column      // the columns
+	keys            []interface{} // the keys of the objects for each column
+	lineSeparator   string        // the separator stirng to use, e.g, null byte or \n.
+	valueSerializer stringify.Stringer
+	ruler           bool // write a header line and ruler line before the first object
+	checked         bool // the columns were checked and the header was written, if any
+}
+
+// NewWriter returns a writer for formating and writing objects to the underlying writer as fixed-width lines.
+// NewWriter is a streaming writer, so the columns must be given up front.
+// If ruler is true, then writes a header line of column names and a ruler line before the first object,
+// so the columns can be inferred when reading.
+func NewWriter(w io.Writer, columns []Column, lineSeparator string, valueSerializer stringify.Stringer, ruler bool) *Writer {
+
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	keys := make([]interface{}, 0, len(columns))
+	for _, c := range columns {
+		keys = append(keys, c.Name)
+	}
+
+	return &Writer{
+		writer:          w,
+		columns:         columns,
+		keys:            keys,
+		lineSeparator:   lineSeparator,
+		valueSerializer: valueSerializer,
+		ruler:           ruler,
+		checked:         false,
+	}
+}
+
+func (w *Writer) writeLine(line string) error {
+	_, err := io.WriteString(w.writer, line+w.lineSeparator)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// WriteHeader writes a header line of column names followed by a ruler line.
+func (w *Writer) WriteHeader() error {
+	names := make([]string, 0, len(w.columns))
+	lastWidth := 0
+	for _, c := range w.columns {
+		names = append(names, c.Name)
+		lastWidth = utf8.RuneCountInString(c.Name)
+	}
+	err := w.writeLine(format(w.columns, names))
+	if err != nil {
+		return errors.Wrap(err, "error writing header line")
+	}
+	err = w.writeLine(ruler(w.columns, lastWidth))
+	if err != nil {
+		return errors.Wrap(err, "error writing ruler line")
+	}
+	return nil
+}
+
+// WriteObject formats and writes a single object to the underlying writer as a fixed-width line
+// and appends the writer's line separator.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if !w.checked {
+		if len(w.columns) == 0 {
+			return ErrMissingColumns
+		}
+		if err := checkColumns(w.columns); err != nil {
+			return errors.Wrap(err, "invalid columns")
+		}
+		if w.ruler {
+			if err := w.WriteHeader(); err != nil {
+				return errors.Wrap(err, "error writing header")
+			}
+		}
+		w.checked = true
+	}
+	row, err := sv.ToRow(obj, w.keys, w.valueSerializer)
+	if err != nil {
+		return errors.Wrap(err, "error serializing object as row")
+	}
+	err = w.writeLine(format(w.columns, row))
+	if err != nil {
+		return errors.Wrap(err, "error writing object")
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objets to the underlying writer as fixed-width lines
+// and separates the objects using the writer's line separator.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush flushes the underlying writer, if it has a Flush method.
+// This writer itself does no buffering.
+func (w *Writer) Flush() error {
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
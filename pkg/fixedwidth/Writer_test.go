@@ -0,0 +1,60 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	objects := []interface{}{
+		map[string]interface{}{"name": "alice", "age": 30},
+		map[string]interface{}{"name": "christopher", "age": 4, "city": "paris"},
+	}
+
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, []Column{{Name: "name", Start: 0, Width: 6}, {Name: "age", Start: 8, Width: 3}}, "\n", nil, false)
+	assert.NotNil(t, w)
+
+	err := w.WriteObjects(objects)
+	assert.NoError(t, err)
+
+	err = w.Flush()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "alice   30 \nchrist  4  \n", buf.String())
+}
+
+func TestWriterRuler(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, []Column{{Name: "name", Start: 0, Width: 6}, {Name: "age", Start: 6, Width: 0}}, "\n", nil, true)
+
+	err := w.WriteObject(map[string]interface{}{"name": "bob", "age": 4})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "name  age\n----- ---\nbob   4\n", buf.String())
+}
+
+func TestWriterOverlappingColumns(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, []Column{{Name: "name", Start: 0, Width: 6}, {Name: "age", Start: 4, Width: 3}}, "\n", nil, false)
+
+	err := w.WriteObject(map[string]interface{}{"name": "bob", "age": 4})
+	assert.Error(t, err)
+}
+
+func TestWriterMissingColumns(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, []Column{}, "\n", nil, false)
+
+	err := w.WriteObject(map[string]interface{}{"name": "bob"})
+	assert.Equal(t, ErrMissingColumns, err)
+}
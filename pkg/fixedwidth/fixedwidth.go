@@ -0,0 +1,42 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package fixedwidth provides a simple API for reading and writing fixed-width text,
+// where each column occupies the same character positions on every line.
+// fixedwidth also supports iterators and writers for efficiently processing a stream.
+//
+// Columns are described by specs formatted as name:start:width,
+// where start is the zero-based offset of the first character of the column and width is the number of characters.
+// A width of zero extends the column to the end of the line.
+// Offsets and widths are counted in characters (runes) rather than bytes.
+//
+// If no columns are given when reading, then the columns are inferred from the first two lines,
+// a header line of column names followed by a ruler line of dashes marking the extent of each column, e.g.,
+//
+//  name       age  city
+//  ---------- ---- ----------
+//  alice      30   paris
+//
+// Values are trimmed of surrounding whitespace when reading, and padded and truncated to fit the width of each column when writing.
+// See the examples below for usage.
+package fixedwidth
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]string{})
+)
+
+var (
+	ErrMissingColumns       = errors.New("missing columns")
+	ErrMissingLineSeparator = errors.New("missing line separator")
+	ErrMissingRuler         = errors.New("missing ruler line after header line")
+)
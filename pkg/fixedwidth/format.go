@@ -0,0 +1,67 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package fixedwidth
+
+import (
+	"strings"
+)
+
+// checkColumns returns an error if the columns are out of order or overlap,
+// or if a column other than the last extends to the end of the line.
+func checkColumns(columns []Column) error {
+	for i := 1; i < len(columns); i++ {
+		previous := columns[i-1]
+		if previous.Width == 0 || previous.Start+previous.Width > columns[i].Start {
+			return &ErrOverlappingColumns{Previous: previous, Next: columns[i]}
+		}
+	}
+	return nil
+}
+
+// format returns the values formatted as a fixed-width line.
+// Each value is placed at the start of its column and padded or truncated to fit the width of the column.
+// Line breaks and tabs in values are replaced with spaces.
+// The columns must already be checked with checkColumns.
+func format(columns []Column, values []string) string {
+	line := make([]rune, 0)
+	for i, c := range columns {
+		for len(line) < c.Start {
+			line = append(line, ' ')
+		}
+		value := []rune(strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ", "\t", " ").Replace(values[i]))
+		if c.Width > 0 && len(value) > c.Width {
+			value = value[:c.Width]
+		}
+		line = append(line, value...)
+		for len(line) < c.Start+c.Width {
+			line = append(line, ' ')
+		}
+	}
+	return string(line)
+}
+
+// ruler returns a ruler line marking the extent of each column with dashes.
+// The last character of each column except the last is left blank, so the ruler can be read by InferColumns.
+// If the last column extends to the end of the line, then the ruler extends to the given width.
+func ruler(columns []Column, lastWidth int) string {
+	values := make([]string, 0, len(columns))
+	for i, c := range columns {
+		n := c.Width - 1
+		if i == len(columns)-1 {
+			n = c.Width
+			if c.Width == 0 {
+				n = lastWidth
+			}
+		}
+		if n < 1 {
+			n = 1
+		}
+		values = append(values, strings.Repeat("-", n))
+	}
+	return format(columns, values)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"fmt"
+)
+
+// ErrInvalidGeometry is used when a geometry has an unknown type or its coordinates are not nested as expected.
+type ErrInvalidGeometry struct {
+	Type string // the type of the geometry
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidGeometry) Error() string {
+	return fmt.Sprintf("invalid geometry of type %q", e.Type)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"fmt"
+)
+
+// ErrInvalidObject is used when an object cannot be converted into a feature.
+type ErrInvalidObject struct {
+	Value interface{} // the invalid object
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidObject) Error() string {
+	return fmt.Sprintf("could not convert value with type %T into a feature", e.Value)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"fmt"
+)
+
+// ErrUnexpectedToken is used when the input is not a GeoJSON object or an array of objects.
+type ErrUnexpectedToken struct {
+	Value interface{} // the unexpected JSON token
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnexpectedToken) Error() string {
+	return fmt.Sprintf("unexpected token %v, expecting an object or an array", e.Value)
+}
@@ -0,0 +1,57 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"github.com/pkg/errors"
+)
+
+// FeatureToRecord converts a feature into a flat record with the properties of the feature.
+// If the feature has an id that is not a property, then the id is included in the record.
+// If the geometry is a 2-dimensional point and the longitude and latitude columns are given, then the point is written to those columns.
+// Otherwise, if the WKT column is given, then the geometry is written to that column as Well-Known Text.
+// Otherwise, the geometry object is included in the record as is.
+func FeatureToRecord(feature map[string]interface{}, longitude string, latitude string, wkt string) (map[string]interface{}, error) {
+	record := map[string]interface{}{}
+	if properties, ok := feature["properties"].(map[string]interface{}); ok {
+		for k, v := range properties {
+			record[k] = v
+		}
+	}
+
+	if id, ok := feature["id"]; ok {
+		if _, exists := record["id"]; !exists {
+			record["id"] = id
+		}
+	}
+
+	geometry, ok := feature["geometry"].(map[string]interface{})
+	if !ok {
+		return record, nil
+	}
+
+	if geometry["type"] == "Point" && len(longitude) > 0 && len(latitude) > 0 {
+		if position, ok := geometry["coordinates"].([]interface{}); ok && isPosition(position) && (len(position) == 2 || len(wkt) == 0) {
+			record[longitude] = position[0]
+			record[latitude] = position[1]
+			return record, nil
+		}
+	}
+
+	if len(wkt) > 0 {
+		text, err := FormatWKT(geometry)
+		if err != nil {
+			return nil, errors.Wrap(err, "error formatting geometry as WKT")
+		}
+		record[wkt] = text
+		return record, nil
+	}
+
+	record["geometry"] = geometry
+	return record, nil
+}
@@ -0,0 +1,46 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatureToRecordPoint(t *testing.T) {
+	r, err := FeatureToRecord(map[string]interface{}{
+		"type":       "Feature",
+		"id":         "1",
+		"geometry":   map[string]interface{}{"type": "Point", "coordinates": []interface{}{-77.0365, 38.8977}},
+		"properties": map[string]interface{}{"name": "a"},
+	}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "1", "name": "a", "lon": -77.0365, "lat": 38.8977}, r)
+}
+
+func TestFeatureToRecordWKT(t *testing.T) {
+	r, err := FeatureToRecord(map[string]interface{}{
+		"type":       "Feature",
+		"geometry":   map[string]interface{}{"type": "LineString", "coordinates": []interface{}{[]interface{}{0.0, 0.0}, []interface{}{1.5, 1.0}}},
+		"properties": map[string]interface{}{"name": "a"},
+	}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "a", "wkt": "LINESTRING (0 0, 1.5 1)"}, r)
+}
+
+func TestFeatureToRecordNoColumns(t *testing.T) {
+	g := map[string]interface{}{"type": "Point", "coordinates": []interface{}{1.0, 2.0}}
+	r, err := FeatureToRecord(map[string]interface{}{
+		"type":       "Feature",
+		"geometry":   g,
+		"properties": nil,
+	}, "", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"geometry": g}, r)
+}
@@ -0,0 +1,104 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatWKT formats a GeoJSON geometry object as Well-Known Text.
+// If the positions of the geometry have 3 values, then the geometry is tagged with Z.
+func FormatWKT(geometry map[string]interface{}) (string, error) {
+	t := fmt.Sprint(geometry["type"])
+	name := ""
+	for k, v := range wktTypes {
+		if v == t {
+			name = k
+			break
+		}
+	}
+	if len(name) == 0 {
+		return "", &ErrInvalidGeometry{Type: t}
+	}
+
+	if t == TypeGeometryCollection {
+		geometries, ok := geometry["geometries"].([]interface{})
+		if !ok {
+			return "", &ErrInvalidGeometry{Type: t}
+		}
+		if len(geometries) == 0 {
+			return name + " EMPTY", nil
+		}
+		parts := make([]string, 0, len(geometries))
+		for _, g := range geometries {
+			m, ok := g.(map[string]interface{})
+			if !ok {
+				return "", &ErrInvalidGeometry{Type: t}
+			}
+			part, err := FormatWKT(m)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return name + " (" + strings.Join(parts, ", ") + ")", nil
+	}
+
+	coordinates, ok := geometry["coordinates"].([]interface{})
+	if !ok {
+		return "", &ErrInvalidGeometry{Type: t}
+	}
+	if len(coordinates) == 0 {
+		return name + " EMPTY", nil
+	}
+	if !checkDepth(coordinates, depths[t]) {
+		return "", &ErrInvalidGeometry{Type: t}
+	}
+
+	if dimensions(coordinates) > 2 {
+		name += " Z"
+	}
+
+	switch t {
+	case "Point":
+		return name + " (" + formatPosition(coordinates) + ")", nil
+	case "MultiPoint":
+		points := make([]string, 0, len(coordinates))
+		for _, point := range coordinates {
+			points = append(points, "("+formatPosition(point.([]interface{}))+")")
+		}
+		return name + " (" + strings.Join(points, ", ") + ")", nil
+	}
+	return name + " " + formatList(coordinates, depths[t]), nil
+}
+
+// formatList formats nested lists of positions enclosed in parentheses.
+func formatList(coordinates []interface{}, depth int) string {
+	items := make([]string, 0, len(coordinates))
+	for _, item := range coordinates {
+		if depth == 2 {
+			items = append(items, formatPosition(item.([]interface{})))
+		} else {
+			items = append(items, formatList(item.([]interface{}), depth-1))
+		}
+	}
+	return "(" + strings.Join(items, ", ") + ")"
+}
+
+// formatPosition formats the numbers of a position separated by spaces.
+// The numbers must have already been validated.
+func formatPosition(position []interface{}) string {
+	values := make([]string, 0, len(position))
+	for _, x := range position {
+		f, _ := toFloat64(x)
+		values = append(values, strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	return strings.Join(values, " ")
+}
@@ -0,0 +1,53 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatWKT(t *testing.T) {
+	for _, in := range []string{
+		"POINT (30 10)",
+		"POINT Z (30 10 5)",
+		"LINESTRING (30 10, 10 30, 40 40)",
+		"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+		"MULTIPOINT ((10 40), (40 30))",
+		"MULTILINESTRING ((10 10, 20 20), (40 40, 30 30))",
+		"MULTIPOLYGON (((30 20, 45 40, 10 40, 30 20)), ((15 5, 40 10, 10 20, 5 10, 15 5)))",
+		"GEOMETRYCOLLECTION (POINT (40 10), LINESTRING (10 10, 20 20))",
+		"POINT EMPTY",
+	} {
+		t.Run(in, func(t *testing.T) {
+			g, err := ParseWKT(in)
+			assert.NoError(t, err)
+			out, err := FormatWKT(g)
+			assert.NoError(t, err)
+			assert.Equal(t, in, out)
+		})
+	}
+}
+
+func TestFormatWKTNumber(t *testing.T) {
+	out, err := FormatWKT(map[string]interface{}{
+		"type":        "Point",
+		"coordinates": []interface{}{stdjson.Number("-122.4194"), 37},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "POINT (-122.4194 37)", out)
+}
+
+func TestFormatWKTInvalid(t *testing.T) {
+	_, err := FormatWKT(map[string]interface{}{"type": "Circle", "coordinates": []interface{}{1.0, 2.0}})
+	assert.Error(t, err)
+	_, err = FormatWKT(map[string]interface{}{"type": "LineString", "coordinates": []interface{}{1.0, 2.0}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,225 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	stdjson "encoding/json"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates through the features of GeoJSON or GeoJSON Lines
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type       reflect.Type                  // the type to unmarshal for each feature
+	Decoder    *stdjson.Decoder              // the decoder that reads the underlying stream of tokens
+	Limit      int                           // Limit the number of objects to read and return from the underlying stream.
+	Count      int                           // The current count of the number of objects read.
+	Flatten    bool                          // Flatten each feature into a record with the properties and the geometry as columns.
+	Longitude  string                        // if flattening, the name of the longitude column of points
+	Latitude   string                        // if flattening, the name of the latitude column of points
+	WKT        string                        // if flattening, the name of the column with the geometry as Well-Known Text
+	NumberMode string                        // The mode for decoding numbers.  See the number package for the supported modes.
+	Strict     bool                          // Reject features with duplicate keys or invalid UTF-8.
+	Limits     limits.Limits                 // The resource limits for each feature.
+	array      bool                          // reading the elements of an array of features
+	object     bool                          // reading the members of a top-level object
+	features   bool                          // the top-level object has a features member
+	members    []string                      // the names of the other members of the top-level object
+	values     map[string]stdjson.RawMessage // the values of the other members of the top-level object
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader     io.Reader
+	Type       reflect.Type  // the type to unmarshal for each feature
+	Limit      int           // Limit the number of objects to read and return from the underlying stream.
+	Flatten    bool          // Flatten each feature into a record with the properties and the geometry as columns.
+	Longitude  string        // if flattening, the name of the longitude column of points
+	Latitude   string        // if flattening, the name of the latitude column of points
+	WKT        string        // if flattening, the name of the column with the geometry as Well-Known Text
+	NumberMode string        // The mode for decoding numbers.  See the number package for the supported modes.
+	Strict     bool          // Reject features with duplicate keys or invalid UTF-8.
+	Limits     limits.Limits // The resource limits for each feature.  MaxTotalBytes and MaxAliases are not used.
+}
+
+// NewIterator returns a new GeoJSON iterator based on the given input.
+// The iterator reads a FeatureCollection, a single Feature, an array of features, or any sequence of them, such as GeoJSON Lines.
+func NewIterator(input *NewIteratorInput) *Iterator {
+	return &Iterator{
+		Type:       input.Type,
+		Decoder:    stdjson.NewDecoder(input.Reader),
+		Limit:      input.Limit,
+		Count:      0,
+		Flatten:    input.Flatten,
+		Longitude:  input.Longitude,
+		Latitude:   input.Latitude,
+		WKT:        input.WKT,
+		NumberMode: input.NumberMode,
+		Strict:     input.Strict,
+		Limits:     input.Limits,
+	}
+}
+
+// unmarshal parses a feature into an object, flattening the feature if needed.
+func (it *Iterator) unmarshal(b []byte) (interface{}, error) {
+	if err := json.CheckLimits(b, it.Limits); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of feature %d", it.Count+1)
+	}
+	if it.Strict {
+		if err := json.Validate(b, it.Type); err != nil {
+			return nil, errors.Wrapf(err, "error validating feature %d", it.Count+1)
+		}
+	}
+	if it.Flatten {
+		obj, err := json.UnmarshalNumber(b, it.NumberMode)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing feature %d", it.Count+1)
+		}
+		feature, ok := obj.(map[string]interface{})
+		if !ok {
+			return nil, &ErrInvalidObject{Value: obj}
+		}
+		record, err := FeatureToRecord(feature, it.Longitude, it.Latitude, it.WKT)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error flattening feature %d", it.Count+1)
+		}
+		it.Count++
+		return record, nil
+	}
+	var obj interface{}
+	var err error
+	if it.Type != nil {
+		obj, err = json.UnmarshalTypeNumber(b, it.Type, it.NumberMode)
+	} else {
+		obj, err = json.UnmarshalNumber(b, it.NumberMode)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing feature %d", it.Count+1)
+	}
+	it.Count++
+	return obj, nil
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// The features of a FeatureCollection are returned one at a time.
+// Any other top-level object, e.g., a Feature, is returned as a whole.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	for {
+		if it.array {
+			if it.Decoder.More() {
+				var raw stdjson.RawMessage
+				if err := it.Decoder.Decode(&raw); err != nil {
+					return nil, errors.Wrapf(err, "error decoding feature %d", it.Count+1)
+				}
+				return it.unmarshal(raw)
+			}
+			// Consume the end of the array.
+			if _, err := it.Decoder.Token(); err != nil {
+				return nil, errors.Wrap(err, "error decoding end of array")
+			}
+			it.array = false
+			continue
+		}
+
+		if it.object {
+			token, err := it.Decoder.Token()
+			if err != nil {
+				return nil, errors.Wrap(err, "error decoding member of object")
+			}
+			if token == stdjson.Delim('}') {
+				it.object = false
+				if it.features {
+					continue
+				}
+				// The object is not a FeatureCollection, so return the object as a whole.
+				b, err := it.join()
+				if err != nil {
+					return nil, err
+				}
+				return it.unmarshal(b)
+			}
+			name, _ := token.(string)
+			if name == "features" {
+				token, err := it.Decoder.Token()
+				if err != nil {
+					return nil, errors.Wrap(err, "error decoding features")
+				}
+				if token != stdjson.Delim('[') {
+					return nil, &ErrUnexpectedToken{Value: token}
+				}
+				it.features = true
+				it.array = true
+				continue
+			}
+			var raw stdjson.RawMessage
+			if err := it.Decoder.Decode(&raw); err != nil {
+				return nil, errors.Wrapf(err, "error decoding member %q", name)
+			}
+			if !it.features {
+				if _, exists := it.values[name]; !exists {
+					it.members = append(it.members, name)
+				} else if it.Strict {
+					return nil, errors.Errorf("duplicate member %q", name)
+				}
+				it.values[name] = raw
+			}
+			continue
+		}
+
+		token, err := it.Decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			return nil, errors.Wrap(err, "error decoding GeoJSON")
+		}
+		switch token {
+		case stdjson.Delim('{'):
+			it.object = true
+			it.features = false
+			it.members = make([]string, 0)
+			it.values = map[string]stdjson.RawMessage{}
+		case stdjson.Delim('['):
+			it.array = true
+		default:
+			return nil, &ErrUnexpectedToken{Value: token}
+		}
+	}
+}
+
+// join joins the members of the top-level object back into a single object in the original order.
+func (it *Iterator) join() ([]byte, error) {
+	b := []byte{'{'}
+	for i, name := range it.members {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		key, err := stdjson.Marshal(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error encoding member %q", name)
+		}
+		b = append(b, key...)
+		b = append(b, ':')
+		b = append(b, it.values[name]...)
+	}
+	return append(b, '}'), nil
+}
@@ -0,0 +1,103 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIteratorFeatureCollection(t *testing.T) {
+	in := `{"type": "FeatureCollection", "name": "places", "features": [
+{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2]}, "properties": {"a": "x"}},
+{"type": "Feature", "geometry": null, "properties": {"a": "y"}}
+], "bbox": [1, 2, 1, 2]}`
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Limit:  -1,
+	})
+
+	for _, expected := range []interface{}{
+		map[string]interface{}{
+			"type":       "Feature",
+			"geometry":   map[string]interface{}{"type": "Point", "coordinates": []interface{}{1.0, 2.0}},
+			"properties": map[string]interface{}{"a": "x"},
+		},
+		map[string]interface{}{
+			"type":       "Feature",
+			"geometry":   nil,
+			"properties": map[string]interface{}{"a": "y"},
+		},
+	} {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err := it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLines(t *testing.T) {
+	in := "{\"type\":\"Feature\",\"properties\":{\"a\":\"x\"},\"geometry\":{\"type\":\"Point\",\"coordinates\":[1,2]}}\n" +
+		"{\"type\":\"Feature\",\"properties\":{\"a\":\"y\"},\"geometry\":{\"type\":\"LineString\",\"coordinates\":[[1,2],[3,4]]}}\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader:    strings.NewReader(in),
+		Limit:     -1,
+		Flatten:   true,
+		Longitude: DefaultLongitude,
+		Latitude:  DefaultLatitude,
+		WKT:       DefaultWKT,
+	})
+
+	for _, expected := range []interface{}{
+		map[string]interface{}{"a": "x", "lon": 1.0, "lat": 2.0},
+		map[string]interface{}{"a": "y", "wkt": "LINESTRING (1 2, 3 4)"},
+	} {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+
+	obj, err := it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimit(t *testing.T) {
+	in := `[{"type":"Feature","properties":{"a":"x"},"geometry":null},{"type":"Feature","properties":{"a":"y"},"geometry":null}]`
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Limit:  1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"type": "Feature", "properties": map[string]interface{}{"a": "x"}, "geometry": nil}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorInvalid(t *testing.T) {
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(`"Feature"`),
+		Limit:  -1,
+	})
+	_, err := it.Next()
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseWKT parses a geometry in Well-Known Text into a GeoJSON geometry object.
+// Supports points, line strings, polygons, their multi-part variants, and geometry collections, with optional Z values.
+// M values are dropped, since GeoJSON does not support measures.
+func ParseWKT(text string) (map[string]interface{}, error) {
+	if len(strings.TrimSpace(text)) == 0 {
+		return nil, ErrEmptyWKT
+	}
+	l := &lexer{text: text}
+	g, err := parseGeometry(l)
+	if err != nil {
+		return nil, err
+	}
+	if token := l.next(); len(token) > 0 {
+		return nil, errors.Errorf("unexpected token %q at offset %d", token, l.pos-len(token))
+	}
+	return g, nil
+}
+
+// parseGeometry parses the next tagged geometry.
+func parseGeometry(l *lexer) (map[string]interface{}, error) {
+	name := strings.ToUpper(l.next())
+	t, ok := wktTypes[name]
+	if !ok {
+		return nil, &ErrInvalidGeometry{Type: name}
+	}
+
+	measured := false
+	switch strings.ToUpper(l.peek()) {
+	case "Z":
+		l.next()
+	case "M", "ZM":
+		l.next()
+		measured = true
+	}
+
+	empty := strings.ToUpper(l.peek()) == "EMPTY"
+	if empty {
+		l.next()
+	}
+
+	if t == TypeGeometryCollection {
+		geometries := make([]interface{}, 0)
+		if !empty {
+			if err := expect(l, "("); err != nil {
+				return nil, err
+			}
+			for {
+				g, err := parseGeometry(l)
+				if err != nil {
+					return nil, err
+				}
+				geometries = append(geometries, g)
+				if token := l.next(); token == ")" {
+					break
+				} else if token != "," {
+					return nil, errors.Errorf("unexpected token %q at offset %d", token, l.pos-len(token))
+				}
+			}
+		}
+		return map[string]interface{}{"type": t, "geometries": geometries}, nil
+	}
+
+	if empty {
+		return map[string]interface{}{"type": t, "coordinates": []interface{}{}}, nil
+	}
+
+	coordinates, err := parseList(l, measured)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case "Point":
+		// The position of a point is enclosed in parentheses.
+		if len(coordinates) != 1 || !isPosition(coordinates[0]) {
+			return nil, &ErrInvalidGeometry{Type: t}
+		}
+		return map[string]interface{}{"type": t, "coordinates": coordinates[0]}, nil
+	case "MultiPoint":
+		// The points of a multi-point may or may not be enclosed in parentheses.
+		if checkDepth(coordinates, 3) {
+			for i, point := range coordinates {
+				if len(point.([]interface{})) != 1 {
+					return nil, &ErrInvalidGeometry{Type: t}
+				}
+				coordinates[i] = point.([]interface{})[0]
+			}
+		}
+	}
+
+	if !checkDepth(coordinates, depths[t]) {
+		return nil, &ErrInvalidGeometry{Type: t}
+	}
+
+	return map[string]interface{}{"type": t, "coordinates": coordinates}, nil
+}
+
+// parseList parses a list of positions or nested lists enclosed in parentheses.
+func parseList(l *lexer, measured bool) ([]interface{}, error) {
+	if err := expect(l, "("); err != nil {
+		return nil, err
+	}
+	items := make([]interface{}, 0)
+	for {
+		if l.peek() == "(" {
+			item, err := parseList(l, measured)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		} else {
+			position, err := parsePosition(l, measured)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, position)
+		}
+		if token := l.next(); token == ")" {
+			break
+		} else if token != "," {
+			return nil, errors.Errorf("unexpected token %q at offset %d", token, l.pos-len(token))
+		}
+	}
+	return items, nil
+}
+
+// parsePosition parses the numbers of a position separated by spaces.
+func parsePosition(l *lexer, measured bool) ([]interface{}, error) {
+	position := make([]interface{}, 0, 3)
+	for token := l.peek(); len(token) > 0 && token != "," && token != ")" && token != "("; token = l.peek() {
+		l.next()
+		f, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid number %q at offset %d", token, l.pos-len(token))
+		}
+		position = append(position, f)
+	}
+	if measured && len(position) > 2 {
+		position = position[:len(position)-1]
+	}
+	if len(position) < 2 {
+		return nil, errors.Errorf("position at offset %d has less than 2 values", l.pos)
+	}
+	return position, nil
+}
+
+// expect advances the lexer and returns an error if the next token is not the one given.
+func expect(l *lexer, expected string) error {
+	if token := l.next(); token != expected {
+		return errors.Errorf("unexpected token %q at offset %d, expecting %q", token, l.pos-len(token), expected)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWKT(t *testing.T) {
+	testCases := []struct {
+		in       string
+		expected map[string]interface{}
+	}{
+		{
+			in:       "POINT (30 10)",
+			expected: map[string]interface{}{"type": "Point", "coordinates": []interface{}{30.0, 10.0}},
+		},
+		{
+			in:       "point z(30 10 5)",
+			expected: map[string]interface{}{"type": "Point", "coordinates": []interface{}{30.0, 10.0, 5.0}},
+		},
+		{
+			in:       "POINT M (30 10 2)",
+			expected: map[string]interface{}{"type": "Point", "coordinates": []interface{}{30.0, 10.0}},
+		},
+		{
+			in: "LINESTRING (30 10, 10 30, 40 40)",
+			expected: map[string]interface{}{"type": "LineString", "coordinates": []interface{}{
+				[]interface{}{30.0, 10.0}, []interface{}{10.0, 30.0}, []interface{}{40.0, 40.0},
+			}},
+		},
+		{
+			in: "POLYGON ((30 10, 40 40, 20 40, 30 10))",
+			expected: map[string]interface{}{"type": "Polygon", "coordinates": []interface{}{
+				[]interface{}{[]interface{}{30.0, 10.0}, []interface{}{40.0, 40.0}, []interface{}{20.0, 40.0}, []interface{}{30.0, 10.0}},
+			}},
+		},
+		{
+			in: "MULTIPOINT (10 40, 40 30)",
+			expected: map[string]interface{}{"type": "MultiPoint", "coordinates": []interface{}{
+				[]interface{}{10.0, 40.0}, []interface{}{40.0, 30.0},
+			}},
+		},
+		{
+			in: "MULTIPOINT ((10 40), (40 30))",
+			expected: map[string]interface{}{"type": "MultiPoint", "coordinates": []interface{}{
+				[]interface{}{10.0, 40.0}, []interface{}{40.0, 30.0},
+			}},
+		},
+		{
+			in: "MULTIPOLYGON (((30 20, 45 40, 10 40, 30 20)))",
+			expected: map[string]interface{}{"type": "MultiPolygon", "coordinates": []interface{}{
+				[]interface{}{[]interface{}{[]interface{}{30.0, 20.0}, []interface{}{45.0, 40.0}, []interface{}{10.0, 40.0}, []interface{}{30.0, 20.0}}},
+			}},
+		},
+		{
+			in: "GEOMETRYCOLLECTION (POINT (40 10), LINESTRING (10 10, 20 20))",
+			expected: map[string]interface{}{"type": "GeometryCollection", "geometries": []interface{}{
+				map[string]interface{}{"type": "Point", "coordinates": []interface{}{40.0, 10.0}},
+				map[string]interface{}{"type": "LineString", "coordinates": []interface{}{[]interface{}{10.0, 10.0}, []interface{}{20.0, 20.0}}},
+			}},
+		},
+		{
+			in:       "LINESTRING EMPTY",
+			expected: map[string]interface{}{"type": "LineString", "coordinates": []interface{}{}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.in, func(t *testing.T) {
+			out, err := ParseWKT(testCase.in)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, out)
+		})
+	}
+}
+
+func TestParseWKTInvalid(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"CIRCLE (1 2)",
+		"POINT (1)",
+		"POINT (1 2, 3 4)",
+		"POINT (1 2",
+		"POINT (1 x)",
+		"POLYGON (1 2, 3 4)",
+		"POINT (1 2) POINT (3 4)",
+	} {
+		_, err := ParseWKT(in)
+		assert.Error(t, err, in)
+	}
+}
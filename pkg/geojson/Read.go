@@ -0,0 +1,60 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input parameters for the Read function.
+type ReadInput struct {
+	Type       reflect.Type // the output type.  If nil, then returns a slice of type []interface{}.
+	Reader     io.Reader    // the underlying reader
+	Limit      int
+	Flatten    bool   // Flatten each feature into a record with the properties and the geometry as columns.
+	Longitude  string // if flattening, the name of the longitude column of points
+	Latitude   string // if flattening, the name of the latitude column of points
+	WKT        string // if flattening, the name of the column with the geometry as Well-Known Text
+	NumberMode string // The mode for decoding numbers.  See the number package for the supported modes.
+	Strict     bool   // Reject features with duplicate keys or invalid UTF-8.
+	Limits     limits.Limits
+}
+
+// Read reads the features of GeoJSON or GeoJSON Lines from the input reader into a slice of the type given.
+func Read(input *ReadInput) (interface{}, error) {
+
+	outputType := reflect.TypeOf([]interface{}{})
+	if input.Type != nil {
+		outputType = input.Type
+	}
+
+	it := NewIterator(&NewIteratorInput{
+		Type:       outputType.Elem(),
+		Reader:     input.Reader,
+		Limit:      input.Limit,
+		Flatten:    input.Flatten,
+		Longitude:  input.Longitude,
+		Latitude:   input.Latitude,
+		WKT:        input.WKT,
+		NumberMode: input.NumberMode,
+		Strict:     input.Strict,
+		Limits:     input.Limits,
+	})
+
+	output := reflect.MakeSlice(outputType, 0, 0).Interface()
+
+	w := pipe.NewSliceWriterWithValues(output)
+
+	err := pipe.NewBuilder().Input(it).Output(w).Run()
+
+	return w.Values(), err
+}
@@ -0,0 +1,32 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	in := `{"type":"Feature","properties":{"a":"x"},"geometry":{"type":"Point","coordinates":[1,2]}}`
+	out, err := Read(&ReadInput{
+		Type:      reflect.TypeOf([]map[string]interface{}{}),
+		Reader:    strings.NewReader(in),
+		Limit:     -1,
+		Flatten:   true,
+		Longitude: "x",
+		Latitude:  "y",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"a": "x", "x": 1.0, "y": 2.0},
+	}, out)
+}
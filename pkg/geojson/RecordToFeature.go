@@ -0,0 +1,75 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// RecordToFeature converts a flat record into a feature.
+// If the WKT column is given and is not blank, then the geometry is parsed from the Well-Known Text in that column.
+// Otherwise, if the longitude and latitude columns are given and are not blank, then the geometry is a point.
+// Otherwise, if the record has a geometry object, then that object is used.
+// The WKT, longitude, and latitude columns are removed and all other values become the properties of the feature.
+// If no geometry is found, then the feature has a null geometry.
+func RecordToFeature(record map[string]interface{}, longitude string, latitude string, wkt string) (*Feature, error) {
+	properties := make(map[string]interface{}, len(record))
+	for k, v := range record {
+		properties[k] = v
+	}
+
+	var geometry interface{}
+
+	if len(wkt) > 0 {
+		if v, ok := properties[wkt]; ok {
+			delete(properties, wkt)
+			if v != nil && len(fmt.Sprint(v)) > 0 {
+				g, err := ParseWKT(fmt.Sprint(v))
+				if err != nil {
+					return nil, errors.Wrapf(err, "error parsing WKT %q", fmt.Sprint(v))
+				}
+				geometry = g
+			}
+		}
+	}
+
+	if len(longitude) > 0 && len(latitude) > 0 {
+		x, okx := properties[longitude]
+		y, oky := properties[latitude]
+		if okx && oky {
+			// The longitude and latitude columns are removed even if the geometry was given as WKT.
+			delete(properties, longitude)
+			delete(properties, latitude)
+			if geometry == nil && x != nil && y != nil && len(fmt.Sprint(x)) > 0 && len(fmt.Sprint(y)) > 0 {
+				lon, err := toFloat64(x)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error parsing longitude %q", fmt.Sprint(x))
+				}
+				lat, err := toFloat64(y)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error parsing latitude %q", fmt.Sprint(y))
+				}
+				geometry = map[string]interface{}{
+					"type":        "Point",
+					"coordinates": []interface{}{lon, lat},
+				}
+			}
+		}
+	}
+
+	if geometry == nil {
+		if g, ok := properties["geometry"].(map[string]interface{}); ok {
+			delete(properties, "geometry")
+			geometry = g
+		}
+	}
+
+	return &Feature{Type: TypeFeature, Geometry: geometry, Properties: properties}, nil
+}
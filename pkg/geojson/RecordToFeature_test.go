@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordToFeaturePoint(t *testing.T) {
+	f, err := RecordToFeature(map[string]interface{}{"name": "a", "lon": "-77.0365", "lat": "38.8977"}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.NoError(t, err)
+	assert.Equal(t, &Feature{
+		Type:       "Feature",
+		Geometry:   map[string]interface{}{"type": "Point", "coordinates": []interface{}{-77.0365, 38.8977}},
+		Properties: map[string]interface{}{"name": "a"},
+	}, f)
+}
+
+func TestRecordToFeatureWKT(t *testing.T) {
+	f, err := RecordToFeature(map[string]interface{}{"name": "a", "wkt": "LINESTRING (0 0, 1 1)", "lon": 5, "lat": 5}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.NoError(t, err)
+	assert.Equal(t, &Feature{
+		Type:       "Feature",
+		Geometry:   map[string]interface{}{"type": "LineString", "coordinates": []interface{}{[]interface{}{0.0, 0.0}, []interface{}{1.0, 1.0}}},
+		Properties: map[string]interface{}{"name": "a"},
+	}, f)
+
+	f, err = RecordToFeature(map[string]interface{}{"name": "a", "wkt": "POINT (1 2)", "lon": "", "lat": ""}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.NoError(t, err)
+	assert.Equal(t, &Feature{
+		Type:       "Feature",
+		Geometry:   map[string]interface{}{"type": "Point", "coordinates": []interface{}{1.0, 2.0}},
+		Properties: map[string]interface{}{"name": "a"},
+	}, f)
+}
+
+func TestRecordToFeatureNull(t *testing.T) {
+	f, err := RecordToFeature(map[string]interface{}{"name": "a", "wkt": "", "lon": "", "lat": ""}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.NoError(t, err)
+	assert.Equal(t, &Feature{
+		Type:       "Feature",
+		Geometry:   nil,
+		Properties: map[string]interface{}{"name": "a"},
+	}, f)
+}
+
+func TestRecordToFeatureInvalid(t *testing.T) {
+	_, err := RecordToFeature(map[string]interface{}{"lon": "x", "lat": "1"}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.Error(t, err)
+	_, err = RecordToFeature(map[string]interface{}{"wkt": "POINT ("}, DefaultLongitude, DefaultLatitude, DefaultWKT)
+	assert.Error(t, err)
+}
@@ -0,0 +1,64 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer        io.Writer   // the underlying writer
+	Object        interface{} // the object to write
+	Lines         bool        // write one feature per line as GeoJSON Lines
+	Longitude     string      // the name of the longitude column
+	Latitude      string      // the name of the latitude column
+	WKT           string      // the name of the column with the geometry as Well-Known Text
+	KeySerializer stringify.Stringer
+	Limit         int
+}
+
+// Write writes the given object(s) as GeoJSON or GeoJSON Lines.
+// If the type of the input object is of kind Array or Slice, then writes each object as its own feature.
+// Otherwise, the object is written as a single feature, or as its features if a FeatureCollection.
+func Write(input *WriteInput) error {
+	inputObject := input.Object
+	inputObjectValue := reflect.ValueOf(inputObject)
+	for reflect.TypeOf(inputObjectValue.Interface()).Kind() == reflect.Ptr {
+		inputObjectValue = inputObjectValue.Elem()
+	}
+	inputObjectValue = reflect.ValueOf(inputObjectValue.Interface()) // sets value to concerete type
+	inputObjectKind := inputObjectValue.Type().Kind()
+
+	p := pipe.NewBuilder().OutputLimit(input.Limit)
+	if inputObjectKind == reflect.Array || inputObjectKind == reflect.Slice {
+		it, errorIterator := pipe.NewSliceIterator(inputObject)
+		if errorIterator != nil {
+			return errors.Wrap(errorIterator, "error creating slice iterator")
+		}
+		p = p.Input(it)
+	} else {
+		it, errorIterator := pipe.NewSliceIterator([]interface{}{inputObject})
+		if errorIterator != nil {
+			return errors.Wrap(errorIterator, "error creating slice iterator")
+		}
+		p = p.Input(it)
+	}
+	w := NewWriter(input.Writer, input.Lines, input.Longitude, input.Latitude, input.WKT, input.KeySerializer)
+	errorRun := p.Output(w).Run()
+	if errorRun != nil {
+		return errors.Wrap(errorRun, "error serializing GeoJSON")
+	}
+	return nil
+}
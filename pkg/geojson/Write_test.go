@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWrite(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: []interface{}{
+			map[string]interface{}{"a": "x", "lon": 1.5, "lat": 2},
+			map[string]interface{}{"a": "y", "lon": nil, "lat": nil},
+		},
+		Lines:         true,
+		Longitude:     DefaultLongitude,
+		Latitude:      DefaultLatitude,
+		KeySerializer: stringify.NewStringer("", false, false, false),
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"type\":\"Feature\",\"geometry\":{\"coordinates\":[1.5,2],\"type\":\"Point\"},\"properties\":{\"a\":\"x\"}}\n{\"type\":\"Feature\",\"geometry\":null,\"properties\":{\"a\":\"y\"}}\n", buf.String())
+}
@@ -0,0 +1,203 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as the features of a FeatureCollection or as GeoJSON Lines.
+type Writer struct {
+	writer        io.Writer // writer for the underlying stream
+	lines         bool      // write one feature per line rather than a FeatureCollection
+	longitude     string    // the name of the longitude column
+	latitude      string    // the name of the latitude column
+	wkt           string    // the name of the column with the geometry as Well-Known Text
+	keySerializer stringify.Stringer
+	started       bool // the start of a FeatureCollection has been written
+	finished      bool // the end of a FeatureCollection has been written
+	count         int  // the number of features written to the current FeatureCollection
+}
+
+// NewWriter returns a writer for formating and writing objects to the underlying writer as GeoJSON.
+// If lines is true, then writes one feature per line as GeoJSON Lines.
+// Otherwise, the features are written as a FeatureCollection that is completed when the writer is flushed.
+func NewWriter(w io.Writer, lines bool, longitude string, latitude string, wkt string, keySerializer stringify.Stringer) *Writer {
+	return &Writer{
+		writer:        w,
+		lines:         lines,
+		longitude:     longitude,
+		latitude:      latitude,
+		wkt:           wkt,
+		keySerializer: keySerializer,
+	}
+}
+
+func (w *Writer) write(s string) error {
+	_, err := io.WriteString(w.writer, s)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// start writes the start of a FeatureCollection, unless already started.
+func (w *Writer) start() error {
+	if w.started {
+		return nil
+	}
+	if err := w.write("{\"type\":\"" + TypeFeatureCollection + "\",\"features\":["); err != nil {
+		return err
+	}
+	w.started = true
+	return nil
+}
+
+// toMap converts a map with string keys into a map[string]interface{}.
+func toMap(obj interface{}) (map[string]interface{}, bool) {
+	if m, ok := obj.(map[string]interface{}); ok {
+		return m, true
+	}
+	v := reflect.ValueOf(obj)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() != reflect.Map {
+		return nil, false
+	}
+	m := make(map[string]interface{}, v.Len())
+	for _, k := range v.MapKeys() {
+		m[fmt.Sprint(k.Interface())] = v.MapIndex(k).Interface()
+	}
+	return m, true
+}
+
+// writeFeature writes a single feature.
+func (w *Writer) writeFeature(feature interface{}) error {
+	b, err := json.Marshal(feature, false)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling feature")
+	}
+	if w.lines {
+		return w.write(string(b) + "\n")
+	}
+	if err := w.start(); err != nil {
+		return err
+	}
+	if w.count > 0 {
+		if err := w.write(",\n"); err != nil {
+			return err
+		}
+	} else {
+		if err := w.write("\n"); err != nil {
+			return err
+		}
+	}
+	w.count++
+	return w.write(string(b))
+}
+
+// WriteObject formats and writes a single object to the underlying writer as a feature.
+// Features are written as is and the features of a FeatureCollection are written one at a time.
+// All other objects are converted into features using RecordToFeature.
+func (w *Writer) WriteObject(obj interface{}) error {
+	obj, err := stringify.StringifyMapKeys(obj, w.keySerializer)
+	if err != nil {
+		return errors.Wrap(err, "error stringifying map keys")
+	}
+	m, ok := toMap(obj)
+	if !ok {
+		return &ErrInvalidObject{Value: obj}
+	}
+	switch m["type"] {
+	case TypeFeatureCollection:
+		if features, ok := m["features"].([]interface{}); ok {
+			for _, feature := range features {
+				if err := w.WriteObject(feature); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case TypeFeature:
+		return w.writeFeature(m)
+	}
+	feature, err := RecordToFeature(m, w.longitude, w.latitude, w.wkt)
+	if err != nil {
+		return errors.Wrap(err, "error converting object into feature")
+	}
+	return w.writeFeature(feature)
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer as features.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the end of the FeatureCollection, if any, and flushes the underlying writer, if it has a Flush method.
+// If no features have been written, then writes an empty FeatureCollection.
+func (w *Writer) Flush() error {
+	if !w.lines {
+		if !w.finished {
+			if err := w.start(); err != nil {
+				return err
+			}
+		}
+		if w.started {
+			end := "]}\n"
+			if w.count > 0 {
+				end = "\n" + end
+			}
+			if err := w.write(end); err != nil {
+				return err
+			}
+			w.started = false
+			w.finished = true
+			w.count = 0
+		}
+	}
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
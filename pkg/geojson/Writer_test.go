@@ -0,0 +1,76 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriter(t *testing.T) {
+	objects := []interface{}{
+		map[string]string{"a": "x", "lon": "1", "lat": "2"},
+		map[string]interface{}{"a": "y", "wkt": "LINESTRING (1 2, 3 4)"},
+		map[string]interface{}{"type": "Feature", "id": 3, "geometry": nil, "properties": map[string]interface{}{"a": "z"}},
+	}
+
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, false, DefaultLongitude, DefaultLatitude, DefaultWKT, stringify.NewStringer("", false, false, false))
+	for _, obj := range objects {
+		require.NoError(t, w.WriteObject(obj))
+	}
+	require.NoError(t, w.Flush())
+	require.Equal(t, `{"type":"FeatureCollection","features":[
+{"type":"Feature","geometry":{"coordinates":[1,2],"type":"Point"},"properties":{"a":"x"}},
+{"type":"Feature","geometry":{"coordinates":[[1,2],[3,4]],"type":"LineString"},"properties":{"a":"y"}},
+{"geometry":null,"id":3,"properties":{"a":"z"},"type":"Feature"}
+]}
+`, buf.String())
+
+	// Should read back the same features.
+	it := NewIterator(&NewIteratorInput{
+		Reader:    buf,
+		Limit:     -1,
+		Flatten:   true,
+		Longitude: DefaultLongitude,
+		Latitude:  DefaultLatitude,
+		WKT:       DefaultWKT,
+	})
+	for _, expected := range []interface{}{
+		map[string]interface{}{"a": "x", "lon": 1.0, "lat": 2.0},
+		map[string]interface{}{"a": "y", "wkt": "LINESTRING (1 2, 3 4)"},
+		map[string]interface{}{"a": "z", "id": 3.0},
+	} {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, false, DefaultLongitude, DefaultLatitude, DefaultWKT, stringify.NewStringer("", false, false, false))
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.Flush())
+	require.Equal(t, "{\"type\":\"FeatureCollection\",\"features\":[]}\n", buf.String())
+}
+
+func TestWriterLines(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, true, DefaultLongitude, DefaultLatitude, DefaultWKT, stringify.NewStringer("", false, false, false))
+	require.NoError(t, w.WriteObject(map[string]interface{}{
+		"type":     "FeatureCollection",
+		"features": []interface{}{map[string]interface{}{"a": "x"}, map[string]interface{}{"a": "y"}},
+	}))
+	require.NoError(t, w.Flush())
+	require.Equal(t, "{\"type\":\"Feature\",\"geometry\":null,\"properties\":{\"a\":\"x\"}}\n{\"type\":\"Feature\",\"geometry\":null,\"properties\":{\"a\":\"y\"}}\n", buf.String())
+}
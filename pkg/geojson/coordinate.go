@@ -0,0 +1,81 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// toFloat64 converts a number or the text of a number into a float64.
+func toFloat64(obj interface{}) (float64, error) {
+	if str, ok := number.Format(obj, false); ok {
+		return strconv.ParseFloat(str, 64)
+	}
+	if str, ok := obj.(string); ok {
+		return strconv.ParseFloat(strings.TrimSpace(str), 64)
+	}
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	}
+	return 0, errors.New("value with type " + reflect.TypeOf(obj).String() + " is not a number")
+}
+
+// isPosition returns true if the value is a slice of at least two numbers.
+func isPosition(obj interface{}) bool {
+	position, ok := obj.([]interface{})
+	if !ok || len(position) < 2 {
+		return false
+	}
+	for _, x := range position {
+		if _, err := toFloat64(x); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// checkDepth returns true if the coordinates are nested to the given depth, where a position has a depth of 1.
+func checkDepth(coordinates interface{}, depth int) bool {
+	if depth == 1 {
+		return isPosition(coordinates)
+	}
+	items, ok := coordinates.([]interface{})
+	if !ok {
+		return false
+	}
+	for _, item := range items {
+		if !checkDepth(item, depth-1) {
+			return false
+		}
+	}
+	return true
+}
+
+// dimensions returns the number of values in the first position of the coordinates.
+func dimensions(coordinates interface{}) int {
+	items, ok := coordinates.([]interface{})
+	if !ok || len(items) == 0 {
+		return 0
+	}
+	if _, ok := items[0].([]interface{}); ok {
+		return dimensions(items[0])
+	}
+	return len(items)
+}
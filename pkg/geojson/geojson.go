@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package geojson provides an API for reading and writing GeoJSON (RFC 7946) and GeoJSON Lines.
+// geojson also supports iterators and writers for efficiently processing a stream.
+//
+// When reading, the features of a FeatureCollection are streamed one at a time, so the collection is never held in memory.
+// A single Feature, an array of features, and a sequence of features, one per line as in GeoJSON Lines, are read the same way.
+// If flattening, then each feature is converted into a flat record with the properties of the feature and the geometry as columns.
+// Points are written to the longitude and latitude columns and all other geometries are written to the WKT column as Well-Known Text.
+//
+// When writing, each object is converted into a feature.
+// The geometry is parsed from the WKT column, if present, or otherwise created as a point from the longitude and latitude columns.
+// All other values become the properties of the feature.
+// Objects that are already features are written as is.
+//
+// References:
+//	- https://tools.ietf.org/html/rfc7946
+//	- https://stevage.github.io/ndgeojson/
+//	- https://www.ogc.org/standards/sfa
+//
+package geojson
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	TypeFeature            = "Feature"
+	TypeFeatureCollection  = "FeatureCollection"
+	TypeGeometryCollection = "GeometryCollection"
+
+	DefaultLongitude = "lon" // the default name of the longitude column
+	DefaultLatitude  = "lat" // the default name of the latitude column
+	DefaultWKT       = "wkt" // the default name of the Well-Known Text column
+)
+
+var (
+	ErrEmptyWKT = errors.New("empty WKT")
+)
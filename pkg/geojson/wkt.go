@@ -0,0 +1,68 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package geojson
+
+import (
+	"strings"
+)
+
+var (
+	// wktTypes maps the names of geometry types in Well-Known Text to the names of geometry types in GeoJSON.
+	wktTypes = map[string]string{
+		"POINT":              "Point",
+		"LINESTRING":         "LineString",
+		"POLYGON":            "Polygon",
+		"MULTIPOINT":         "MultiPoint",
+		"MULTILINESTRING":    "MultiLineString",
+		"MULTIPOLYGON":       "MultiPolygon",
+		"GEOMETRYCOLLECTION": TypeGeometryCollection,
+	}
+
+	// depths maps the names of geometry types in GeoJSON to the depth of their coordinates, where a position has a depth of 1.
+	depths = map[string]int{
+		"Point":           1,
+		"LineString":      2,
+		"MultiPoint":      2,
+		"Polygon":         3,
+		"MultiLineString": 3,
+		"MultiPolygon":    4,
+	}
+)
+
+// lexer splits Well-Known Text into words, numbers, parentheses, and commas.
+type lexer struct {
+	text string
+	pos  int
+}
+
+// next returns the next token and advances the lexer.  Returns an empty string at the end of the text.
+func (l *lexer) next() string {
+	for l.pos < len(l.text) && strings.ContainsRune(" \t\r\n", rune(l.text[l.pos])) {
+		l.pos++
+	}
+	if l.pos == len(l.text) {
+		return ""
+	}
+	start := l.pos
+	if strings.ContainsRune("(),", rune(l.text[l.pos])) {
+		l.pos++
+		return l.text[start:l.pos]
+	}
+	for l.pos < len(l.text) && !strings.ContainsRune(" \t\r\n(),", rune(l.text[l.pos])) {
+		l.pos++
+	}
+	return l.text[start:l.pos]
+}
+
+// peek returns the next token without advancing the lexer.
+func (l *lexer) peek() string {
+	pos := l.pos
+	token := l.next()
+	l.pos = pos
+	return token
+}
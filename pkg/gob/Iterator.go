@@ -11,6 +11,8 @@ import (
 	stdgob "encoding/gob"
 	"io"
 	"reflect"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // Iterator iterates trough a stream of bytes
@@ -21,13 +23,15 @@ type Iterator struct {
 	Decoder *stdgob.Decoder // the scanner that splits the underlying stream of bytes
 	Limit   int             // Limit the number of objects to read and return from the underlying stream.
 	Count   int             // The current count of the number of objects read.
+	Limits  limits.Limits   // The maximum depth and number of keys of each object.
 }
 
 // NewIteratorInput provides the input parameters for the NewIterator function.
 type NewIteratorInput struct {
 	Reader io.Reader
-	Type   reflect.Type // the type to unmarshal for each line
-	Limit  int          // Limit the number of objects to read and return from the underlying stream.
+	Type   reflect.Type  // the type to unmarshal for each line
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Limits limits.Limits // The maximum depth and number of keys of each object.  Only MaxDepth and MaxKeys are used.
 }
 
 // NewIterator returns a new gob iterator base on the given input.
@@ -38,6 +42,7 @@ func NewIterator(input *NewIteratorInput) *Iterator {
 		Decoder: stdgob.NewDecoder(input.Reader),
 		Limit:   input.Limit,
 		Count:   0,
+		Limits:  input.Limits,
 	}
 }
 
@@ -72,5 +77,9 @@ func (it *Iterator) Next() (interface{}, error) {
 		return nil, err
 	}
 
+	if err := it.Limits.Check(ptr.Elem().Interface()); err != nil {
+		return nil, err
+	}
+
 	return ptr.Elem().Interface(), nil
 }
@@ -12,6 +12,7 @@ import (
 	"reflect"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // ReadInput provides the input parameters for the Read function.
@@ -19,6 +20,7 @@ type ReadInput struct {
 	Type   reflect.Type // the output type
 	Reader io.Reader    // the underlying reader
 	Limit  int
+	Limits limits.Limits // the maximum depth and number of keys of each object
 }
 
 // Read reads the sequence of gob items from the input reader of the type given.
@@ -32,6 +34,7 @@ func Read(input *ReadInput) (interface{}, error) {
 		Type:   input.Type.Elem(),
 		Reader: input.Reader,
 		Limit:  input.Limit,
+		Limits: input.Limits,
 	})
 
 	output := reflect.MakeSlice(input.Type, 0, 0).Interface()
@@ -22,14 +22,14 @@ func CanStream(inputFormat string, outputFormat string, outputSorted bool) bool
 	}
 
 	switch inputFormat {
-	case serializer.FormatCSV, serializer.FormatTSV:
+	case serializer.FormatCSV, serializer.FormatFixedWidth, serializer.FormatTSV, serializer.FormatXLSX:
 		switch outputFormat {
-		case serializer.FormatCSV, serializer.FormatJSONL, serializer.FormatFmt, serializer.FormatGo, serializer.FormatGob, serializer.FormatTags, serializer.FormatTSV:
+		case serializer.FormatAvro, serializer.FormatBSON, serializer.FormatCBOR, serializer.FormatCSV, serializer.FormatJSONL, serializer.FormatJSONSeq, serializer.FormatFmt, serializer.FormatGeoJSON, serializer.FormatGeoJSONL, serializer.FormatGo, serializer.FormatGob, serializer.FormatLogfmt, serializer.FormatMsgPack, serializer.FormatParquet, serializer.FormatProtobuf, serializer.FormatSQL, serializer.FormatTags, serializer.FormatTSV, serializer.FormatXLSX:
 			return true
 		}
-	case serializer.FormatJSONL, serializer.FormatGob, serializer.FormatTags:
+	case serializer.FormatAvro, serializer.FormatBSON, serializer.FormatCBOR, serializer.FormatGeoJSON, serializer.FormatGeoJSONL, serializer.FormatJSONL, serializer.FormatJSONSeq, serializer.FormatGob, serializer.FormatLogfmt, serializer.FormatMsgPack, serializer.FormatParquet, serializer.FormatProtobuf, serializer.FormatRegex, serializer.FormatTags, serializer.FormatXML:
 		switch outputFormat {
-		case serializer.FormatJSONL, serializer.FormatFmt, serializer.FormatGo, serializer.FormatGob, serializer.FormatTags:
+		case serializer.FormatAvro, serializer.FormatBSON, serializer.FormatCBOR, serializer.FormatJSONL, serializer.FormatJSONSeq, serializer.FormatFmt, serializer.FormatGeoJSON, serializer.FormatGeoJSONL, serializer.FormatGo, serializer.FormatGob, serializer.FormatLogfmt, serializer.FormatMsgPack, serializer.FormatParquet, serializer.FormatProtobuf, serializer.FormatSQL, serializer.FormatTags, serializer.FormatXLSX:
 			return true
 		}
 	}
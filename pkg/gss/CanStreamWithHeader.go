@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package gss
+
+import (
+	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+)
+
+// CanStreamWithHeader returns true if you can process the data as a stream from the given input format to the output format,
+// given the output header.
+// The fixedwidth and table output formats (html, markdown, and table) can only stream if the output header is given up front without a wildcard,
+// since otherwise the columns are created from all the objects.
+// Otherwise, returns the same as CanStream.
+func CanStreamWithHeader(inputFormat string, outputFormat string, outputHeader []interface{}, outputSorted bool) bool {
+
+	if CanStream(inputFormat, outputFormat, outputSorted) {
+		return true
+	}
+
+	switch outputFormat {
+	case serializer.FormatFixedWidth, serializer.FormatHTML, serializer.FormatMarkdown, serializer.FormatTable:
+		if len(outputHeader) == 0 {
+			return false
+		}
+		for _, column := range outputHeader {
+			if str, ok := column.(string); ok && str == sv.Wildcard {
+				return false
+			}
+		}
+		// the input format can stream if it can stream to a line-delimited format
+		return CanStream(inputFormat, serializer.FormatJSONL, outputSorted)
+	}
+
+	return false
+}
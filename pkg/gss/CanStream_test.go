@@ -24,3 +24,99 @@ func TestCanStreamJSONLJSONL(t *testing.T) {
 func TestCanStreamJSONLJSON(t *testing.T) {
 	assert.False(t, CanStream("jsonl", "json", false))
 }
+
+func TestCanStreamMsgPackJSONL(t *testing.T) {
+	assert.True(t, CanStream("msgpack", "jsonl", false))
+}
+
+func TestCanStreamCBORJSONL(t *testing.T) {
+	assert.True(t, CanStream("cbor", "jsonl", false))
+}
+
+func TestCanStreamXMLJSONL(t *testing.T) {
+	assert.True(t, CanStream("xml", "jsonl", false))
+}
+
+func TestCanStreamWithHeaderJSONLTable(t *testing.T) {
+	assert.True(t, CanStreamWithHeader("jsonl", "table", []interface{}{"a", "b"}, false))
+	assert.False(t, CanStreamWithHeader("jsonl", "table", []interface{}{}, false))
+	assert.False(t, CanStreamWithHeader("jsonl", "table", []interface{}{"a", "*"}, false))
+	assert.False(t, CanStreamWithHeader("jsonl", "markdown", []interface{}{"a"}, true))
+	assert.False(t, CanStreamWithHeader("json", "html", []interface{}{"a"}, false))
+}
+
+func TestCanStreamWithHeaderJSONLJSONL(t *testing.T) {
+	assert.True(t, CanStreamWithHeader("jsonl", "jsonl", []interface{}{}, false))
+}
+
+func TestCanStreamFixedWidthJSONL(t *testing.T) {
+	assert.True(t, CanStream("fixedwidth", "jsonl", false))
+	assert.False(t, CanStream("jsonl", "fixedwidth", false))
+	assert.True(t, CanStreamWithHeader("jsonl", "fixedwidth", []interface{}{"a:0:4"}, false))
+}
+
+func TestCanStreamRegexJSONL(t *testing.T) {
+	assert.True(t, CanStream("regex", "jsonl", false))
+	assert.False(t, CanStream("regex", "jsonl", true))
+}
+
+func TestCanStreamJSONSeqJSONL(t *testing.T) {
+	assert.True(t, CanStream("jsonseq", "jsonl", false))
+	assert.True(t, CanStream("jsonl", "jsonseq", false))
+	assert.True(t, CanStream("csv", "jsonseq", false))
+}
+
+func TestCanStreamAvroJSONL(t *testing.T) {
+	assert.True(t, CanStream("avro", "jsonl", false))
+	assert.True(t, CanStream("jsonl", "avro", false))
+	assert.True(t, CanStream("csv", "avro", false))
+	assert.False(t, CanStream("avro", "csv", false))
+}
+
+func TestCanStreamParquetJSONL(t *testing.T) {
+	assert.True(t, CanStream("parquet", "jsonl", false))
+	assert.True(t, CanStream("csv", "parquet", false))
+	assert.False(t, CanStream("parquet", "csv", false))
+}
+
+func TestCanStreamXLSXJSONL(t *testing.T) {
+	assert.True(t, CanStream("xlsx", "jsonl", false))
+	assert.True(t, CanStream("xlsx", "csv", false))
+	assert.True(t, CanStream("jsonl", "xlsx", false))
+	assert.False(t, CanStream("xlsx", "jsonl", true))
+}
+
+func TestCanStreamSQL(t *testing.T) {
+	assert.True(t, CanStream("csv", "sql", false))
+	assert.True(t, CanStream("jsonl", "sql", false))
+	assert.False(t, CanStream("csv", "sql", true))
+	assert.False(t, CanStream("sql", "jsonl", false))
+}
+
+func TestCanStreamProtobufJSONL(t *testing.T) {
+	assert.True(t, CanStream("protobuf", "jsonl", false))
+	assert.True(t, CanStream("jsonl", "protobuf", false))
+	assert.True(t, CanStream("csv", "protobuf", false))
+	assert.False(t, CanStream("protobuf", "csv", false))
+}
+
+func TestCanStreamPlist(t *testing.T) {
+	assert.False(t, CanStream("plist", "jsonl", false))
+	assert.False(t, CanStream("jsonl", "plist", false))
+}
+
+func TestCanStreamGeoJSON(t *testing.T) {
+	assert.True(t, CanStream("geojson", "jsonl", false))
+	assert.True(t, CanStream("geojsonl", "geojson", false))
+	assert.True(t, CanStream("csv", "geojson", false))
+	assert.True(t, CanStream("jsonl", "geojsonl", false))
+	assert.False(t, CanStream("geojson", "csv", false))
+}
+
+func TestCanStreamBSON(t *testing.T) {
+	assert.True(t, CanStream("bson", "jsonl", false))
+	assert.True(t, CanStream("jsonl", "bson", false))
+	assert.True(t, CanStream("csv", "bson", false))
+	assert.False(t, CanStream("bson", "csv", false))
+	assert.False(t, CanStream("bson", "jsonl", true))
+}
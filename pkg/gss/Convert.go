@@ -9,82 +9,165 @@ package gss
 
 import (
 	"reflect"
+	"regexp"
 
 	"github.com/pkg/errors"
 
+	"github.com/spatialcurrent/go-simple-serializer/pkg/geojson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
 
 // ConvertInput provides the input for the Convert function.
 type ConvertInput struct {
-	InputBytes              []byte
-	InputFormat             string
-	InputHeader             []interface{}
-	InputComment            string
-	InputLazyQuotes         bool
-	InputScannerBufferSize  int
-	InputSkipLines          int
-	InputLimit              int
-	InputLineSeparator      string
-	InputDropCR             bool
-	InputTrim               bool
-	InputEscapePrefix       string
-	InputUnescapeSpace      bool
-	InputUnescapeNewLine    bool
-	InputUnescapeEqual      bool
-	InputType               reflect.Type
-	OutputFormat            string
-	OutputFormatSpecifier   string
-	OutputFit               bool
-	OutputHeader            []interface{}
-	OutputLimit             int
-	OutputPretty            bool
-	OutputSorted            bool
-	OutputReversed          bool
-	OutputKeySerializer     stringify.Stringer
-	OutputValueSerializer   stringify.Stringer
-	OutputLineSeparator     string
-	OutputKeyValueSeparator string
-	OutputEscapePrefix      string
-	OutputEscapeSpace       bool
-	OutputEscapeNewLine     bool
-	OutputEscapeEqual       bool
+	InputBytes               []byte
+	InputFormat              string
+	InputHeader              []interface{}
+	InputComment             string
+	InputLazyQuotes          bool
+	InputScannerBufferSize   int
+	InputSkipLines           int
+	InputLimit               int
+	InputLineSeparator       string
+	InputLineSeparatorRegexp *regexp.Regexp
+	InputDropCR              bool
+	InputTrim                bool
+	InputEscapePrefix        string
+	InputUnescapeSpace       bool
+	InputUnescapeNewLine     bool
+	InputUnescapeEqual       bool
+	InputType                reflect.Type
+	InputNumberMode          string
+	InputStrict              bool
+	InputLimits              limits.Limits
+	InputXMLPath             string
+	InputPattern             string
+	InputNoMatch             string
+	InputColumns             []string
+	InputSheet               string
+	InputDescriptorSet       []byte
+	InputMessage             string
+	InputFlatten             bool
+	InputLongitude           string
+	InputLatitude            string
+	InputWKT                 string
+	InputEncoding            string
+	OutputFormat             string
+	OutputFormatSpecifier    string
+	OutputFit                bool
+	OutputHeader             []interface{}
+	OutputLimit              int
+	OutputPretty             bool
+	OutputSorted             bool
+	OutputReversed           bool
+	OutputKeySerializer      stringify.Stringer
+	OutputValueSerializer    stringify.Stringer
+	OutputLineSeparator      string
+	OutputKeyValueSeparator  string
+	OutputEscapePrefix       string
+	OutputEscapeSpace        bool
+	OutputEscapeNewLine      bool
+	OutputEscapeEqual        bool
+	OutputBorders            string
+	OutputAlign              string
+	OutputMaxWidth           int
+	OutputWrap               bool
+	OutputSchema             string
+	OutputCodec              string
+	OutputSampleSize         int
+	OutputSheet              string
+	OutputSheetKey           string
+	OutputAutoFilter         bool
+	OutputTable              string
+	OutputDialect            string
+	OutputStatement          string
+	OutputBatchSize          int
+	OutputCreateTable        bool
+	OutputDescriptorSet      []byte
+	OutputMessage            string
+	OutputPlistFormat        string
+	OutputLongitude          string
+	OutputLatitude           string
+	OutputWKT                string
+	OutputExtendedJSON       string
+	OutputEncoding           string
+	OutputBOM                bool
 }
 
 func NewConvertInput(bytes []byte, inputFormat string, outputFormat string) *ConvertInput {
 	return &ConvertInput{
-		InputBytes:              bytes,
-		InputFormat:             inputFormat,
-		InputHeader:             NoHeader,
-		InputComment:            NoComment,
-		InputLazyQuotes:         false,
-		InputScannerBufferSize:  0,
-		InputSkipLines:          NoSkip,
-		InputLimit:              NoLimit,
-		InputLineSeparator:      "\n",
-		InputDropCR:             true,
-		InputEscapePrefix:       "\\",
-		InputUnescapeSpace:      false,
-		InputUnescapeNewLine:    false,
-		InputUnescapeEqual:      false,
-		InputType:               nil,
-		OutputFormat:            outputFormat,
-		OutputFormatSpecifier:   "",
-		OutputFit:               false,
-		OutputHeader:            NoHeader,
-		OutputLimit:             NoLimit,
-		OutputPretty:            false,
-		OutputSorted:            false,
-		OutputReversed:          false,
-		OutputKeySerializer:     nil,
-		OutputValueSerializer:   nil,
-		OutputLineSeparator:     "\n",
-		OutputKeyValueSeparator: "=",
-		OutputEscapePrefix:      "\\",
-		OutputEscapeSpace:       false,
-		OutputEscapeNewLine:     false,
-		OutputEscapeEqual:       false,
+		InputBytes:               bytes,
+		InputFormat:              inputFormat,
+		InputHeader:              NoHeader,
+		InputComment:             NoComment,
+		InputLazyQuotes:          false,
+		InputScannerBufferSize:   0,
+		InputSkipLines:           NoSkip,
+		InputLimit:               NoLimit,
+		InputLineSeparator:       "\n",
+		InputLineSeparatorRegexp: nil,
+		InputDropCR:              true,
+		InputEscapePrefix:        "\\",
+		InputUnescapeSpace:       false,
+		InputUnescapeNewLine:     false,
+		InputUnescapeEqual:       false,
+		InputType:                nil,
+		InputNumberMode:          "",
+		InputStrict:              false,
+		InputLimits:              limits.Limits{},
+		InputXMLPath:             "",
+		InputPattern:             "",
+		InputNoMatch:             "skip",
+		InputColumns:             nil,
+		InputSheet:               "",
+		InputDescriptorSet:       nil,
+		InputMessage:             "",
+		InputFlatten:             false,
+		InputLongitude:           geojson.DefaultLongitude,
+		InputLatitude:            geojson.DefaultLatitude,
+		InputWKT:                 geojson.DefaultWKT,
+		InputEncoding:            "",
+		OutputFormat:             outputFormat,
+		OutputFormatSpecifier:    "",
+		OutputFit:                false,
+		OutputHeader:             NoHeader,
+		OutputLimit:              NoLimit,
+		OutputPretty:             false,
+		OutputSorted:             false,
+		OutputReversed:           false,
+		OutputKeySerializer:      nil,
+		OutputValueSerializer:    nil,
+		OutputLineSeparator:      "\n",
+		OutputKeyValueSeparator:  "=",
+		OutputEscapePrefix:       "\\",
+		OutputEscapeSpace:        false,
+		OutputEscapeNewLine:      false,
+		OutputEscapeEqual:        false,
+		OutputBorders:            "plain",
+		OutputAlign:              "auto",
+		OutputMaxWidth:           0,
+		OutputWrap:               false,
+		OutputSchema:             "",
+		OutputCodec:              "",
+		OutputSampleSize:         100,
+		OutputSheet:              "",
+		OutputSheetKey:           "",
+		OutputAutoFilter:         false,
+		OutputTable:              "",
+		OutputDialect:            "",
+		OutputStatement:          "",
+		OutputBatchSize:          0,
+		OutputCreateTable:        false,
+		OutputDescriptorSet:      nil,
+		OutputMessage:            "",
+		OutputPlistFormat:        "",
+		OutputLongitude:          geojson.DefaultLongitude,
+		OutputLatitude:           geojson.DefaultLatitude,
+		OutputWKT:                geojson.DefaultWKT,
+		OutputExtendedJSON:       "",
+		OutputEncoding:           "",
+		OutputBOM:                false,
 	}
 }
 
@@ -99,12 +182,28 @@ func Convert(input *ConvertInput) ([]byte, error) {
 		LazyQuotes(input.InputLazyQuotes).
 		SkipLines(input.InputSkipLines).
 		LineSeparator(input.InputLineSeparator).
+		LineSeparatorRegexp(input.InputLineSeparatorRegexp).
 		DropCR(input.InputDropCR).
 		Trim(input.InputTrim).
 		EscapePrefix(input.InputEscapePrefix).
 		UnescapeEqual(input.InputUnescapeEqual).
 		UnescapeSpace(input.InputUnescapeSpace).
-		UnescapeNewLine(input.InputUnescapeNewLine)
+		UnescapeNewLine(input.InputUnescapeNewLine).
+		UseNumber(input.InputNumberMode).
+		Strict(input.InputStrict).
+		Limits(input.InputLimits).
+		XMLPath(input.InputXMLPath).
+		Pattern(input.InputPattern).
+		NoMatch(input.InputNoMatch).
+		Columns(input.InputColumns).
+		Sheet(input.InputSheet).
+		DescriptorSet(input.InputDescriptorSet).
+		Message(input.InputMessage).
+		Flatten(input.InputFlatten).
+		Longitude(input.InputLongitude).
+		Latitude(input.InputLatitude).
+		WKT(input.InputWKT).
+		Encoding(input.InputEncoding)
 
 	obj, err := in.Deserialize(input.InputBytes)
 	if err != nil {
@@ -126,7 +225,31 @@ func Convert(input *ConvertInput) ([]byte, error) {
 		EscapePrefix(input.OutputEscapePrefix).
 		EscapeEqual(input.OutputEscapeEqual).
 		EscapeSpace(input.OutputEscapeSpace).
-		EscapeNewLine(input.OutputEscapeNewLine)
+		EscapeNewLine(input.OutputEscapeNewLine).
+		Borders(input.OutputBorders).
+		Align(input.OutputAlign).
+		MaxWidth(input.OutputMaxWidth).
+		Wrap(input.OutputWrap).
+		Schema(input.OutputSchema).
+		Codec(input.OutputCodec).
+		SampleSize(input.OutputSampleSize).
+		Sheet(input.OutputSheet).
+		SheetKey(input.OutputSheetKey).
+		AutoFilter(input.OutputAutoFilter).
+		Table(input.OutputTable).
+		Dialect(input.OutputDialect).
+		Statement(input.OutputStatement).
+		BatchSize(input.OutputBatchSize).
+		CreateTable(input.OutputCreateTable).
+		DescriptorSet(input.OutputDescriptorSet).
+		Message(input.OutputMessage).
+		PlistFormat(input.OutputPlistFormat).
+		Longitude(input.OutputLongitude).
+		Latitude(input.OutputLatitude).
+		WKT(input.OutputWKT).
+		ExtendedJSON(input.OutputExtendedJSON).
+		Encoding(input.OutputEncoding).
+		BOM(input.OutputBOM)
 
 	b, err := out.Serialize(obj)
 	if err != nil {
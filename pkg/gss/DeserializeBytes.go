@@ -11,59 +11,85 @@ import (
 	"bytes"
 	"encoding/gob"
 	"reflect"
+	"regexp"
 
 	"github.com/hashicorp/hcl"
 
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/iterator"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
 )
 
 // DeserializeBytesInput provides the input for the DeserializeBytes function.
 type DeserializeBytesInput struct {
-	Bytes             []byte
-	Format            string
-	Header            []interface{}
-	Comment           string
-	LazyQuotes        bool
-	ScannerBufferSize int
-	SkipLines         int
-	SkipBlanks        bool
-	SkipComments      bool
-	Trim              bool
-	Limit             int
-	LineSeparator     string
-	DropCR            bool
-	Type              reflect.Type
-	EscapePrefix      string
-	UnescapeSpace     bool
-	UnescapeNewLine   bool
-	UnescapeColon     bool
-	UnescapeEqual     bool
+	Bytes               []byte
+	Format              string
+	Header              []interface{}
+	Comment             string
+	LazyQuotes          bool
+	ScannerBufferSize   int
+	SkipLines           int
+	SkipBlanks          bool
+	SkipComments        bool
+	Trim                bool
+	Limit               int
+	LineSeparator       string
+	LineSeparatorRegexp *regexp.Regexp
+	DropCR              bool
+	Type                reflect.Type
+	EscapePrefix        string
+	UnescapeSpace       bool
+	UnescapeNewLine     bool
+	UnescapeColon       bool
+	UnescapeEqual       bool
+	NumberMode          string        // the mode for decoding numbers
+	Strict              bool          // reject ambiguous input, e.g., duplicate keys
+	Limits              limits.Limits // the resource limits enforced when deserializing
+	XMLPath             string        // for xml, the path to the elements to read, e.g., "/catalog/book"
+	Pattern             string        // for regex, the regular expression with named capture groups, e.g., "%{COMBINEDLOG}"
+	NoMatch             string        // for regex, the policy for lines that do not match the pattern, one of "skip", "error", or "raw"
+	Columns             []string      // for parquet, the columns to read.  If empty, then reads all columns.
+	Sheet               string        // for xlsx, the name or zero-based index of the sheet to read.  If empty, then reads the first sheet.
+	DescriptorSet       []byte        // for protobuf, the serialized FileDescriptorSet that includes the message type
+	Message             string        // for protobuf, the full name of the message type, e.g., "example.v1.Place"
+	Flatten             bool          // for geojson and geojsonl, flatten each feature into a record with the properties and the geometry as columns
+	Longitude           string        // for geojson and geojsonl, if flattening, the name of the longitude column of points
+	Latitude            string        // for geojson and geojsonl, if flattening, the name of the latitude column of points
+	WKT                 string        // for geojson and geojsonl, if flattening, the name of the column with the geometry as Well-Known Text
+	Encoding            string        // the text encoding of the input, one of encoding.InputEncodings.  If empty, then the input is read as is.
 }
 
 // DeserializeBytes reads in an object as string bytes and returns the representative Go instance.
 func DeserializeBytes(input *DeserializeBytesInput) (interface{}, error) {
 
 	switch input.Format {
-	case "csv", "tsv", "jsonl", "geojsonl", "tags":
+	case "csv", "tsv", "fixedwidth", "jsonl", "jsonseq", "logfmt", "regex", "tags":
 		it, errorIterator := iterator.NewIterator(&iterator.NewIteratorInput{
-			Reader:            bytes.NewReader(input.Bytes),
-			Type:              input.Type,
-			Format:            input.Format,
-			Header:            input.Header,
-			Comment:           input.Comment,
-			ScannerBufferSize: input.ScannerBufferSize,
-			SkipLines:         input.SkipLines,
-			SkipBlanks:        input.SkipBlanks,
-			SkipComments:      input.SkipComments,
-			LazyQuotes:        input.LazyQuotes,
-			Trim:              input.Trim,
-			Limit:             input.Limit,
-			LineSeparator:     input.LineSeparator,
-			DropCR:            input.DropCR,
+			Reader:              bytes.NewReader(input.Bytes),
+			Type:                input.Type,
+			Format:              input.Format,
+			Header:              input.Header,
+			Comment:             input.Comment,
+			ScannerBufferSize:   input.ScannerBufferSize,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			LazyQuotes:          input.LazyQuotes,
+			Trim:                input.Trim,
+			Limit:               input.Limit,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			NumberMode:          input.NumberMode,
+			Strict:              input.Strict,
+			Limits:              input.Limits,
+			Encoding:            input.Encoding,
+			Pattern:             input.Pattern,
+			NoMatch:             input.NoMatch,
 		})
 		if errorIterator != nil {
 			return nil, errors.Wrap(errorIterator, "error creating iterator")
@@ -74,11 +100,38 @@ func DeserializeBytes(input *DeserializeBytesInput) (interface{}, error) {
 			return w.Values(), errors.Wrap(errorRun, "error deserializing")
 		}
 		return w.Values(), nil
-	case "bson", "json", "properties", "toml", "yaml":
-		s := serializer.New(input.Format).Type(input.Type)
-		if input.Format == "properties" {
+	case "avro", "bson", "cbor", "dotenv", "env", "geojson", "geojsonl", "ini", "json", "json5", "msgpack", "parquet", "plist", "properties", "protobuf", "toml", "xlsx", "xml", "yaml":
+		s := serializer.New(input.Format).Type(input.Type).UseNumber(input.NumberMode).Strict(input.Strict).Limits(input.Limits).Encoding(input.Encoding)
+		if input.Format == "xml" {
+			s = s.XMLPath(input.XMLPath)
+		}
+		if input.Format == "parquet" {
+			s = s.Columns(input.Columns).Limit(input.Limit)
+		}
+		if input.Format == "protobuf" {
+			s = s.DescriptorSet(input.DescriptorSet).Message(input.Message).Limit(input.Limit)
+		}
+		if input.Format == "geojson" || input.Format == "geojsonl" {
+			s = s.
+				Flatten(input.Flatten).
+				Longitude(input.Longitude).
+				Latitude(input.Latitude).
+				WKT(input.WKT).
+				Limit(input.Limit)
+		}
+		if input.Format == "xlsx" {
+			s = s.Sheet(input.Sheet).Header(input.Header).SkipLines(input.SkipLines).Limit(input.Limit)
+		}
+		if input.Format == "dotenv" || input.Format == "env" {
+			s = s.
+				LineSeparator(input.LineSeparator).
+				LineSeparatorRegexp(input.LineSeparatorRegexp).
+				DropCR(input.DropCR)
+		}
+		if input.Format == "ini" || input.Format == "properties" {
 			s = s.
 				LineSeparator(input.LineSeparator).
+				LineSeparatorRegexp(input.LineSeparatorRegexp).
 				Comment(input.Comment).
 				Trim(input.Trim).
 				DropCR(input.DropCR).
@@ -93,17 +146,27 @@ func DeserializeBytes(input *DeserializeBytesInput) (interface{}, error) {
 		obj := make([]interface{}, 0)
 		d := gob.NewDecoder(bytes.NewReader(input.Bytes))
 		err := d.Decode(&obj)
-		return obj, err
+		if err != nil {
+			return obj, err
+		}
+		return obj, input.Limits.Check(obj)
 	case "hcl":
 		ptr := reflect.New(input.Type)
 		ptr.Elem().Set(reflect.MakeMap(input.Type))
-		obj, err := hcl.Parse(string(input.Bytes))
+		b, err := encoding.Decode(input.Bytes, input.Encoding, input.Strict)
+		if err != nil {
+			return nil, errors.Wrap(err, "error decoding hcl")
+		}
+		obj, err := hcl.Parse(string(b))
 		if err != nil {
 			return nil, errors.Wrap(err, "Error parsing hcl")
 		}
 		if err := hcl.DecodeObject(ptr.Interface(), obj); err != nil {
 			return nil, errors.Wrap(err, "Error decoding hcl")
 		}
+		if err := input.Limits.Check(ptr.Elem().Interface()); err != nil {
+			return nil, errors.Wrap(err, "error checking limits of hcl")
+		}
 		return ptr.Elem().Interface(), nil
 	}
 
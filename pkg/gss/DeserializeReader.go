@@ -12,41 +12,59 @@ import (
 	"io"
 	"io/ioutil"
 	"reflect"
+	"regexp"
 
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/iterator"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/serializer"
 )
 
 // DeserializeReaderInput provides the input for the DeserializeReader function.
 type DeserializeReaderInput struct {
-	Reader          io.Reader
-	Format          string
-	Header          []interface{}
-	Comment         string
-	LazyQuotes      bool
-	SkipLines       int
-	SkipBlanks      bool
-	SkipComments    bool
-	Trim            bool
-	Limit           int
-	LineSeparator   string
-	DropCR          bool
-	Type            reflect.Type
-	EscapePrefix    string
-	UnescapeSpace   bool
-	UnescapeNewLine bool
-	UnescapeColon   bool
-	UnescapeEqual   bool
+	Reader              io.Reader
+	Format              string
+	Header              []interface{}
+	Comment             string
+	LazyQuotes          bool
+	SkipLines           int
+	SkipBlanks          bool
+	SkipComments        bool
+	Trim                bool
+	Limit               int
+	LineSeparator       string
+	LineSeparatorRegexp *regexp.Regexp
+	DropCR              bool
+	Type                reflect.Type
+	EscapePrefix        string
+	UnescapeSpace       bool
+	UnescapeNewLine     bool
+	UnescapeColon       bool
+	UnescapeEqual       bool
+	NumberMode          string        // the mode for decoding numbers
+	Strict              bool          // reject ambiguous input, e.g., duplicate keys
+	Limits              limits.Limits // the resource limits enforced when deserializing
+	XMLPath             string        // for xml, the path to the elements to read, e.g., "/catalog/book"
+	Pattern             string        // for regex, the regular expression with named capture groups, e.g., "%{COMBINEDLOG}"
+	NoMatch             string        // for regex, the policy for lines that do not match the pattern, one of "skip", "error", or "raw"
+	Columns             []string      // for parquet, the columns to read.  If empty, then reads all columns.
+	Sheet               string        // for xlsx, the name or zero-based index of the sheet to read.  If empty, then reads the first sheet.
+	DescriptorSet       []byte        // for protobuf, the serialized FileDescriptorSet that includes the message type
+	Message             string        // for protobuf, the full name of the message type, e.g., "example.v1.Place"
+	Flatten             bool          // for geojson and geojsonl, flatten each feature into a record with the properties and the geometry as columns
+	Longitude           string        // for geojson and geojsonl, if flattening, the name of the longitude column of points
+	Latitude            string        // for geojson and geojsonl, if flattening, the name of the latitude column of points
+	WKT                 string        // for geojson and geojsonl, if flattening, the name of the column with the geometry as Well-Known Text
+	Encoding            string        // the text encoding of the input, one of encoding.InputEncodings.  If empty, then the input is read as is.
 }
 
 // DeserializeReader reads the serialized object from an io.Reader and returns the representative Go instance.
 func DeserializeReader(input *DeserializeReaderInput) (interface{}, error) {
 
 	switch input.Format {
-	case "csv", "tsv", "jsonl", "geojsonl", "tags":
+	case "avro", "cbor", "csv", "tsv", "fixedwidth", "jsonl", "jsonseq", "geojson", "geojsonl", "logfmt", "msgpack", "parquet", "protobuf", "regex", "tags", "xlsx":
 
 		var iteratorType reflect.Type
 		if input.Type != nil {
@@ -54,19 +72,34 @@ func DeserializeReader(input *DeserializeReaderInput) (interface{}, error) {
 		}
 		// These formats can be streamed.
 		it, errorIterator := iterator.NewIterator(&iterator.NewIteratorInput{
-			Reader:        input.Reader,
-			Type:          iteratorType,
-			Format:        input.Format,
-			Header:        input.Header,
-			Comment:       input.Comment,
-			SkipLines:     input.SkipLines,
-			SkipBlanks:    input.SkipBlanks,
-			SkipComments:  input.SkipComments,
-			LazyQuotes:    input.LazyQuotes,
-			Trim:          input.Trim,
-			Limit:         input.Limit,
-			LineSeparator: input.LineSeparator,
-			DropCR:        input.DropCR,
+			Reader:              input.Reader,
+			Type:                iteratorType,
+			Format:              input.Format,
+			Header:              input.Header,
+			Comment:             input.Comment,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			LazyQuotes:          input.LazyQuotes,
+			Trim:                input.Trim,
+			Limit:               input.Limit,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			NumberMode:          input.NumberMode,
+			Strict:              input.Strict,
+			Limits:              input.Limits,
+			Encoding:            input.Encoding,
+			Pattern:             input.Pattern,
+			NoMatch:             input.NoMatch,
+			Columns:             input.Columns,
+			Sheet:               input.Sheet,
+			DescriptorSet:       input.DescriptorSet,
+			Message:             input.Message,
+			Flatten:             input.Flatten,
+			Longitude:           input.Longitude,
+			Latitude:            input.Latitude,
+			WKT:                 input.WKT,
 		})
 		if errorIterator != nil {
 			return nil, errors.Wrap(errorIterator, "error creating iterator")
@@ -87,12 +120,15 @@ func DeserializeReader(input *DeserializeReaderInput) (interface{}, error) {
 		return w.Values(), nil
 	case "gob":
 		obj := make([]interface{}, 0)
-		d := gob.NewDecoder(input.Reader)
+		d := gob.NewDecoder(limits.NewReader(input.Reader, input.Limits.MaxTotalBytes))
 		err := d.Decode(&obj)
-		return obj, err
-	case "bson", "hcl", "hcl2", "json", "properties", "toml", "yaml":
+		if err != nil {
+			return obj, err
+		}
+		return obj, input.Limits.Check(obj)
+	case "bson", "dotenv", "env", "hcl", "hcl2", "ini", "json", "json5", "plist", "properties", "toml", "xml", "yaml":
 		// These formats do not support streaming.
-		b, err := ioutil.ReadAll(input.Reader)
+		b, err := ioutil.ReadAll(limits.NewReader(input.Reader, input.Limits.MaxTotalBytes))
 		if err != nil {
 			if err == io.EOF {
 				return nil, io.EOF
@@ -101,13 +137,23 @@ func DeserializeReader(input *DeserializeReaderInput) (interface{}, error) {
 		}
 
 		// Set up Serializer
-		s := serializer.New(input.Format).Type(input.Type)
+		s := serializer.New(input.Format).Type(input.Type).UseNumber(input.NumberMode).Strict(input.Strict).Limits(input.Limits).Encoding(input.Encoding)
 		if input.Format == "properties" || input.Format == "yaml" {
 			s = s.Comment(input.Comment)
 		}
-		if input.Format == "properties" {
+		if input.Format == "xml" {
+			s = s.XMLPath(input.XMLPath)
+		}
+		if input.Format == "dotenv" || input.Format == "env" {
+			s = s.
+				LineSeparator(input.LineSeparator).
+				LineSeparatorRegexp(input.LineSeparatorRegexp).
+				DropCR(input.DropCR)
+		}
+		if input.Format == "ini" || input.Format == "properties" {
 			s = s.
 				LineSeparator(input.LineSeparator).
+				LineSeparatorRegexp(input.LineSeparatorRegexp).
 				Comment(input.Comment).
 				Trim(input.Trim).
 				DropCR(input.DropCR).
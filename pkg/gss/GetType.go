@@ -13,6 +13,10 @@ import (
 	"unicode"
 
 	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/bson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json5"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/plist"
 )
 
 // GetType takes in the content of an object as a string and the serialization format.
@@ -31,11 +35,25 @@ func GetType(content []byte, format string) (reflect.Type, error) {
 			}
 			return reflect.TypeOf(map[string]interface{}{}), nil
 		}
-	} else if format == "bson" || format == "hcl" || format == "hcl2" || format == "properties" || format == "toml" {
+	} else if format == "json5" {
+		// If the input is not valid JSON5, then the error is returned when deserializing.
+		n, _ := json5.Normalize(content)
+		return GetTypeJSON(string(n)), nil
+	} else if format == "plist" {
+		if plist.IsArray(content) {
+			return reflect.TypeOf([]interface{}{}), nil
+		}
+		return reflect.TypeOf(map[string]interface{}{}), nil
+	} else if format == "bson" {
+		if bson.IsStream(content) {
+			return reflect.TypeOf([]interface{}{}), nil
+		}
+		return reflect.TypeOf(map[string]interface{}{}), nil
+	} else if format == "dotenv" || format == "env" || format == "hcl" || format == "hcl2" || format == "ini" || format == "properties" || format == "toml" || format == "xml" {
 		return reflect.TypeOf(map[string]interface{}{}), nil
-	} else if format == "csv" || format == "tsv" {
+	} else if format == "csv" || format == "fixedwidth" || format == "logfmt" || format == "parquet" || format == "regex" || format == "tsv" || format == "xlsx" {
 		return reflect.TypeOf([]map[string]interface{}{}), nil
-	} else if format == "jsonl" {
+	} else if format == "avro" || format == "cbor" || format == "geojson" || format == "geojsonl" || format == "jsonl" || format == "jsonseq" || format == "msgpack" || format == "protobuf" {
 		return reflect.TypeOf([]interface{}{}), nil
 	}
 
@@ -37,6 +37,30 @@ type SerializeBytesInput struct {
 	EscapeEqual       bool
 	EscapeColon       bool
 	ExpandHeader      bool
+	Borders           string
+	Align             string
+	MaxWidth          int
+	Wrap              bool
+	Schema            string
+	Codec             string
+	SampleSize        int
+	Sheet             string
+	SheetKey          string
+	AutoFilter        bool
+	Table             string
+	Dialect           string
+	Statement         string
+	BatchSize         int
+	CreateTable       bool
+	DescriptorSet     []byte
+	Message           string
+	PlistFormat       string
+	Longitude         string
+	Latitude          string
+	WKT               string
+	ExtendedJSON      string
+	Encoding          string
+	BOM               bool
 }
 
 // SerializeBytes serializes an object to its representation given by format.
@@ -45,21 +69,24 @@ func SerializeBytes(input *SerializeBytesInput) ([]byte, error) {
 	f := input.Format
 
 	switch f {
-	case "bson", "csv", "fmt", "go", "gob", "json", "jsonl", "properties", "tags", "toml", "tsv", "yaml":
-		s := serializer.New(f)
+	case "avro", "bson", "cbor", "csv", "dotenv", "env", "fixedwidth", "fmt", "geojson", "geojsonl", "go", "gob", "html", "ini", "json", "jsonl", "jsonseq", "logfmt", "markdown", "msgpack", "parquet", "plist", "properties", "protobuf", "sql", "table", "tags", "toml", "tsv", "xlsx", "xml", "yaml":
+		s := serializer.New(f).Encoding(input.Encoding).BOM(input.BOM)
 		if f == serializer.FormatFmt {
 			s = s.FormatSpecifier(input.FormatSpecifier)
 		}
-		if f == serializer.FormatGo || f == serializer.FormatJSON || f == serializer.FormatJSONL {
+		if f == serializer.FormatGo || f == serializer.FormatJSON || f == serializer.FormatJSONL || f == serializer.FormatJSONSeq || f == serializer.FormatPlist || f == serializer.FormatXML {
 			s = s.Pretty(input.Pretty)
 		}
-		if f == serializer.FormatJSONL || f == serializer.FormatProperties || f == serializer.FormatTags {
+		if f == serializer.FormatDotEnv || f == serializer.FormatEnv || f == serializer.FormatFixedWidth || f == serializer.FormatINI || f == serializer.FormatJSONL || f == serializer.FormatLogfmt || f == serializer.FormatProperties || f == serializer.FormatTags {
 			s = s.LineSeparator(input.LineSeparator)
 		}
-		if f == serializer.FormatProperties || f == serializer.FormatTags {
+		if f == serializer.FormatINI || f == serializer.FormatProperties || f == serializer.FormatTags {
 			s = s.KeyValueSeparator(input.KeyValueSeparator)
 		}
-		if f == serializer.FormatCSV || f == serializer.FormatProperties || f == serializer.FormatTags || f == serializer.FormatTSV {
+		if f == serializer.FormatAvro || f == serializer.FormatCBOR || f == serializer.FormatGeoJSON || f == serializer.FormatGeoJSONL || f == serializer.FormatMsgPack || f == serializer.FormatParquet || f == serializer.FormatProtobuf {
+			s = s.KeySerializer(input.KeySerializer)
+		}
+		if f == serializer.FormatCSV || f == serializer.FormatDotEnv || f == serializer.FormatEnv || f == serializer.FormatFixedWidth || f == serializer.FormatHTML || f == serializer.FormatINI || f == serializer.FormatLogfmt || f == serializer.FormatMarkdown || f == serializer.FormatProperties || f == serializer.FormatSQL || f == serializer.FormatTable || f == serializer.FormatTags || f == serializer.FormatTSV || f == serializer.FormatXLSX {
 			// Sort the order of the keys/properties
 			// Does not sort the order of the records (if serializing multiples objects as tags)
 			// If sorted and reversed, then sort in reverse alphabetical order.
@@ -69,10 +96,57 @@ func SerializeBytes(input *SerializeBytesInput) ([]byte, error) {
 				Sorted(input.Sorted).
 				Reversed(input.Reversed)
 		}
-		if f == serializer.FormatCSV || f == serializer.FormatTSV || f == serializer.FormatTags {
+		if f == serializer.FormatCSV || f == serializer.FormatTSV || f == serializer.FormatFixedWidth || f == serializer.FormatHTML || f == serializer.FormatLogfmt || f == serializer.FormatMarkdown || f == serializer.FormatTable || f == serializer.FormatTags {
 			s = s.Header(input.Header).ExpandHeader(input.ExpandHeader)
 		}
-		if f == "properties" {
+		if f == serializer.FormatHTML || f == serializer.FormatMarkdown || f == serializer.FormatTable {
+			s = s.
+				Borders(input.Borders).
+				Align(input.Align).
+				MaxWidth(input.MaxWidth).
+				Wrap(input.Wrap)
+		}
+		if f == serializer.FormatAvro || f == serializer.FormatParquet {
+			s = s.
+				Schema(input.Schema).
+				Codec(input.Codec).
+				SampleSize(input.SampleSize)
+		}
+		if f == serializer.FormatParquet || f == serializer.FormatSQL || f == serializer.FormatXLSX {
+			s = s.Header(input.Header)
+		}
+		if f == serializer.FormatXLSX {
+			s = s.
+				Sheet(input.Sheet).
+				SheetKey(input.SheetKey).
+				AutoFilter(input.AutoFilter)
+		}
+		if f == serializer.FormatProtobuf {
+			s = s.
+				DescriptorSet(input.DescriptorSet).
+				Message(input.Message)
+		}
+		if f == serializer.FormatGeoJSON || f == serializer.FormatGeoJSONL {
+			s = s.
+				Longitude(input.Longitude).
+				Latitude(input.Latitude).
+				WKT(input.WKT)
+		}
+		if f == serializer.FormatPlist {
+			s = s.PlistFormat(input.PlistFormat)
+		}
+		if f == serializer.FormatJSON || f == serializer.FormatJSONL || f == serializer.FormatJSONSeq {
+			s = s.ExtendedJSON(input.ExtendedJSON)
+		}
+		if f == serializer.FormatSQL {
+			s = s.
+				Table(input.Table).
+				Dialect(input.Dialect).
+				Statement(input.Statement).
+				BatchSize(input.BatchSize).
+				CreateTable(input.CreateTable)
+		}
+		if f == "ini" || f == "properties" {
 			s = s.
 				EscapePrefix(input.EscapePrefix).
 				EscapeSpace(input.EscapeSpace).
@@ -80,7 +154,7 @@ func SerializeBytes(input *SerializeBytesInput) ([]byte, error) {
 				EscapeNewLine(input.EscapeNewLine).
 				EscapeEqual(input.EscapeEqual)
 		}
-		if f == "csv" || f == "jsonl" || f == "tags" || f == "tsv" {
+		if f == "avro" || f == "bson" || f == "cbor" || f == "csv" || f == "fixedwidth" || f == "geojson" || f == "geojsonl" || f == "html" || f == "jsonl" || f == "jsonseq" || f == "logfmt" || f == "markdown" || f == "msgpack" || f == "parquet" || f == "protobuf" || f == "sql" || f == "table" || f == "tags" || f == "tsv" || f == "xlsx" {
 			s = s.Limit(input.Limit)
 		}
 		if f == "gob" {
@@ -89,7 +163,7 @@ func SerializeBytes(input *SerializeBytesInput) ([]byte, error) {
 				Type(reflect.TypeOf(make([]interface{}, 0)))
 		}
 		return s.Serialize(input.Object)
-	case "hcl", "hcl2":
+	case "hcl", "hcl2", "json5":
 		return make([]byte, 0), fmt.Errorf("cannot serialize to format %q", f)
 	}
 	return make([]byte, 0), errors.Wrap(&ErrUnknownFormat{Name: f}, "could not serialize object")
@@ -51,7 +51,7 @@
 //
 // Formats
 //
-// GSS supports the following formats: bson, csv, hcl, hcl2, json, jsonl, properties, tags, toml, yaml.
+// GSS supports the following formats: avro, bson, cbor, csv, dotenv, env, fixedwidth, geojson, geojsonl, hcl, hcl2, html, ini, json, json5, jsonl, jsonseq, logfmt, markdown, msgpack, parquet, plist, properties, protobuf, regex, sql, table, tags, toml, xlsx, xml, yaml.
 package gss
 
 import (
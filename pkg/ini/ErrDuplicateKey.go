@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"fmt"
+)
+
+// ErrDuplicateKey is used when a property is defined more than once in the same section.
+type ErrDuplicateKey struct {
+	Section  string // the section of the duplicate property, or blank if global
+	Key      string // the duplicate key
+	Line     int    // the line of the duplicate property, starting at 1
+	Previous int    // the line where the property was first defined, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrDuplicateKey) Error() string {
+	if len(e.Section) > 0 {
+		return fmt.Sprintf("duplicate key %q in section %q at line %d, first defined at line %d", e.Key, e.Section, e.Line, e.Previous)
+	}
+	return fmt.Sprintf("duplicate key %q at line %d, first defined at line %d", e.Key, e.Line, e.Previous)
+}
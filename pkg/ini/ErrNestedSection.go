@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"fmt"
+)
+
+// ErrNestedSection is used when a property of a section is a map, which cannot be written since sections cannot be nested.
+type ErrNestedSection struct {
+	Section string // the name of the section
+	Key     string // the key of the property
+}
+
+// Error returns the error formatted as a string.
+func (e ErrNestedSection) Error() string {
+	return fmt.Sprintf("property %q of section %q is a map, but sections cannot be nested", e.Key, e.Section)
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"fmt"
+)
+
+// ErrSectionConflict is used when a section has the same name as a global property.
+type ErrSectionConflict struct {
+	Section string // the name of the section
+	Line    int    // the line of the conflicting section or property, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrSectionConflict) Error() string {
+	return fmt.Sprintf("section %q at line %d conflicts with a global property of the same name", e.Section, e.Line)
+}
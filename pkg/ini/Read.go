@@ -0,0 +1,173 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/escaper"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
+)
+
+// parseValue parses the raw value of a property.
+// Double-quoted values are unquoted as Go string literals, falling back to the unescaped text between the quotes.
+// Single-quoted values are returned as is.
+// Inline comments after unquoted values must be preceded by whitespace.
+func parseValue(raw string, e *escaper.Escaper) string {
+	if len(raw) >= 2 && (raw[0] == '"' || raw[0] == '\'') {
+		if end := strings.LastIndexByte(raw, raw[0]); end > 0 {
+			rest := strings.TrimSpace(raw[end+1:])
+			if len(rest) == 0 || rest[0] == ';' || rest[0] == '#' {
+				if raw[0] == '\'' {
+					return raw[1:end]
+				}
+				if value, err := strconv.Unquote(raw[0 : end+1]); err == nil {
+					return value
+				}
+				return e.Unescape(raw[1:end])
+			}
+		}
+	}
+	for i := 1; i < len(raw); i++ {
+		if (raw[i] == ';' || raw[i] == '#') && (raw[i-1] == ' ' || raw[i-1] == '\t') {
+			raw = strings.TrimSpace(raw[0:i])
+			break
+		}
+	}
+	return e.Unescape(raw)
+}
+
+// Read parses an INI document from the given reader and returns a map of the properties, and error if any.
+// Properties within a section are returned as a nested map of type map[string]interface{}.
+// If no type is given, returns a map of type map[string]interface{}.
+func Read(input *ReadInput) (interface{}, error) {
+
+	// Initialize Escaper
+	e := escaper.New()
+	if len(input.EscapePrefix) > 0 {
+		e = e.Prefix(input.EscapePrefix)
+		if input.UnescapeSpace {
+			e = e.Sub(" ")
+		}
+		if input.UnescapeEqual {
+			e = e.Sub("=")
+		}
+		if input.UnescapeColon {
+			e = e.Sub(":")
+		}
+		if input.UnescapeNewLine {
+			e = e.Sub("\n")
+		}
+	}
+
+	m := map[string]interface{}{}
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
+	section := ""
+	current := m
+	keys := map[string]map[string]int{} // the line where each key was first defined in each section, if strict
+	lineNumber := 0
+	for s.Scan() {
+		lineNumber++
+		line := s.Text()
+		if input.Strict && !utf8.ValidString(line) {
+			return nil, errors.Wrapf(ErrInvalidUTF8, "invalid byte on line %d", lineNumber)
+		}
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || line[0] == ';' || line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' {
+			end := strings.IndexByte(line, ']')
+			if end == -1 {
+				return nil, errors.Errorf("error deserializing section on line %d: missing closing bracket", lineNumber)
+			}
+			section = strings.TrimSpace(line[1:end])
+			if existing, ok := m[section]; ok {
+				sectionMap, ok := existing.(map[string]interface{})
+				if !ok {
+					return nil, &ErrSectionConflict{Section: section, Line: lineNumber}
+				}
+				current = sectionMap
+			} else {
+				current = map[string]interface{}{}
+				m[section] = current
+				if err := input.Limits.CheckKeys(len(m)); err != nil {
+					return nil, errors.Wrapf(err, "error checking limits of section on line %d", lineNumber)
+				}
+			}
+			continue
+		}
+		propertyName := ""
+		propertyValue := ""
+		for i, c := range line {
+			split := false
+			if c == '=' {
+				split = (!input.UnescapeEqual) || (i == 0) || (line[i-1] != '\\')
+			} else if c == ':' {
+				split = (!input.UnescapeColon) || (i == 0) || (line[i-1] != '\\')
+			}
+			if split {
+				propertyName = line[0:i]
+				propertyValue = line[i+1:]
+				break
+			}
+		}
+		if len(propertyName) == 0 {
+			return nil, errors.Wrapf(ErrMissingKeyValueSeparator, "error deserializing property on line %d", lineNumber)
+		}
+		key := e.Unescape(strings.TrimSpace(propertyName))
+		if input.Strict {
+			if _, ok := keys[section]; !ok {
+				keys[section] = map[string]int{}
+			}
+			if previous, ok := keys[section][key]; ok {
+				return nil, &ErrDuplicateKey{Section: section, Key: key, Line: lineNumber, Previous: previous}
+			}
+			keys[section][key] = lineNumber
+		}
+		if len(section) == 0 {
+			if _, ok := m[key].(map[string]interface{}); ok {
+				return nil, &ErrSectionConflict{Section: key, Line: lineNumber}
+			}
+		}
+		current[key] = parseValue(strings.TrimSpace(propertyValue), e)
+		if err := input.Limits.CheckKeys(len(current)); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of property on line %d", lineNumber)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", lineNumber+1)
+	}
+
+	if input.Type == nil || input.Type == DefaultType {
+		return m, nil
+	}
+
+	if input.Type.Kind() != reflect.Map {
+		return nil, &ErrInvalidKind{Value: input.Type, Expected: []reflect.Kind{reflect.Map}}
+	}
+	out := reflect.MakeMap(input.Type)
+	for k, v := range m {
+		value := reflect.ValueOf(v)
+		if !value.Type().AssignableTo(input.Type.Elem()) {
+			return nil, errors.Errorf("error deserializing property %q: value of type %q is not assignable to %q", k, value.Type(), input.Type.Elem())
+		}
+		out.SetMapIndex(reflect.ValueOf(k), value)
+	}
+	return out.Interface(), nil
+}
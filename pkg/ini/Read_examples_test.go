@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows you can read an INI document with a section into a map.
+func ExampleRead_section() {
+	in := "a=1\n\n[b]\nc=2 ; comment\nd='3'\n"
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: map[a:1 b:map[c:2 d:3]]
+}
@@ -0,0 +1,111 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestRead(t *testing.T) {
+	in := `
+; global properties
+name = example
+version: 1
+
+[server]
+# connection
+host = localhost   ; inline comment
+port = 8080
+motd = "hello; world\t!"
+path = 'C:\data'
+
+[client]
+retries=3
+
+[server]
+timeout = 30 # seconds
+url = http://example.com/#anchor
+`
+
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":    "example",
+		"version": "1",
+		"server": map[string]interface{}{
+			"host":    "localhost",
+			"port":    "8080",
+			"motd":    "hello; world\t!",
+			"path":    "C:\\data",
+			"timeout": "30",
+			"url":     "http://example.com/#anchor",
+		},
+		"client": map[string]interface{}{
+			"retries": "3",
+		},
+	}, out)
+}
+
+func TestReadType(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Type:          reflect.TypeOf(map[string]string{}),
+		Reader:        strings.NewReader("a=1\nb=2\n"),
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, out)
+}
+
+func TestReadStrict(t *testing.T) {
+	in := "a=1\n[s]\na=2\n[t]\nb=3\n[s]\na=4\n"
+
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+	assert.Equal(t, &ErrDuplicateKey{Section: "s", Key: "a", Line: 7, Previous: 3}, err)
+	assert.Nil(t, out)
+}
+
+func TestReadSectionConflict(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader("a=1\n[a]\nb=2\n"),
+		LineSeparator: "\n",
+	})
+	assert.Equal(t, &ErrSectionConflict{Section: "a", Line: 2}, err)
+	assert.Nil(t, out)
+}
+
+func TestReadMissingKeyValueSeparator(t *testing.T) {
+	_, err := Read(&ReadInput{
+		Reader:        strings.NewReader("[a]\nb\n"),
+		LineSeparator: "\n",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, ErrMissingKeyValueSeparator, errors.Cause(err))
+}
+
+func TestReadLimits(t *testing.T) {
+	_, err := Read(&ReadInput{
+		Reader:        strings.NewReader("[a]\nb=1\nc=2\nd=3\n"),
+		LineSeparator: "\n",
+		Limits:        limits.Limits{MaxKeys: 2},
+	})
+	assert.Equal(t, &limits.ErrMaxKeys{Max: 2}, errors.Cause(err))
+}
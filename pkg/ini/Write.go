@@ -0,0 +1,174 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/inspector"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/properties"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer            io.Writer          // the underlying writer
+	LineSeparator     string             // the newline byte
+	KeyValueSeparator string             // the separator for key-value pairs
+	Object            interface{}        // the object to write
+	KeySerializer     stringify.Stringer // serializer for section names and object properties
+	ValueSerializer   stringify.Stringer // serializer for object properties
+	Sorted            bool               // sort output
+	Reversed          bool               // if sorted, sort in reverse alphabetical order
+	EscapePrefix      string             // escape prefix, if empty then doesn't escape
+	EscapeSpace       bool               // escape spaces
+	EscapeEqual       bool               // escape =
+	EscapeColon       bool               // escape :
+	EscapeNewLine     bool               // escape \n
+}
+
+// quoteValue wraps the given value serializer to quote values that would otherwise not be read back as is.
+func quoteValue(valueSerializer stringify.Stringer) stringify.Stringer {
+	return func(object interface{}) (string, error) {
+		str, err := valueSerializer(object)
+		if err != nil {
+			return str, err
+		}
+		if len(str) > 0 && (str != strings.TrimSpace(str) || str[0] == '"' || str[0] == '\'' || strings.ContainsAny(str, ";#")) {
+			return strconv.Quote(str), nil
+		}
+		return str, nil
+	}
+}
+
+// getSection returns the underlying map and true if the value should be written as a section.
+func getSection(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, v.Kind() == reflect.Map
+}
+
+// Write writes the given object as an INI document.
+// Values of the object that are maps are written as sections after the global properties.
+// Since sections cannot be nested, if a property of a section is a map, then returns ErrNestedSection.
+func Write(input *WriteInput) error {
+
+	if len(input.LineSeparator) == 0 {
+		return ErrMissingLineSeparator
+	}
+
+	if len(input.KeyValueSeparator) == 0 {
+		return ErrMissingKeyValueSeparator
+	}
+
+	inputObjectValue := reflect.ValueOf(input.Object)
+	for reflect.TypeOf(inputObjectValue.Interface()).Kind() == reflect.Ptr {
+		inputObjectValue = inputObjectValue.Elem()
+	}
+	inputObjectValue = reflect.ValueOf(inputObjectValue.Interface()) // sets value to concerete type
+	inputObjectKind := inputObjectValue.Type().Kind()
+
+	keySerializer := input.KeySerializer
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	valueSerializer := input.ValueSerializer
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	writeProperties := func(obj interface{}) error {
+		err := properties.Write(&properties.WriteInput{
+			Writer:            input.Writer,
+			LineSeparator:     input.LineSeparator,
+			KeyValueSeparator: input.KeyValueSeparator,
+			Object:            obj,
+			KeySerializer:     keySerializer,
+			ValueSerializer:   quoteValue(valueSerializer),
+			Sorted:            input.Sorted,
+			Reversed:          input.Reversed,
+			EscapePrefix:      input.EscapePrefix,
+			EscapeSpace:       input.EscapeSpace,
+			EscapeEqual:       input.EscapeEqual,
+			EscapeColon:       input.EscapeColon,
+			EscapeNewLine:     input.EscapeNewLine,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = input.Writer.Write([]byte(input.LineSeparator))
+		return err
+	}
+
+	if inputObjectKind == reflect.Struct {
+		return writeProperties(inputObjectValue.Interface())
+	}
+
+	if inputObjectKind != reflect.Map {
+		return &ErrInvalidKind{Value: inputObjectValue.Type(), Expected: []reflect.Kind{reflect.Map, reflect.Struct}}
+	}
+
+	globals := reflect.MakeMap(inputObjectValue.Type())
+	sections := make([]interface{}, 0)
+	for _, key := range inspector.GetKeysFromValue(inputObjectValue, input.Sorted, input.Reversed) {
+		value := inputObjectValue.MapIndex(reflect.ValueOf(key))
+		if section, ok := getSection(value); ok {
+			for _, k := range section.MapKeys() {
+				if _, nested := getSection(section.MapIndex(k)); nested {
+					name, _ := keySerializer(key)
+					property, _ := keySerializer(k.Interface())
+					return &ErrNestedSection{Section: name, Key: property}
+				}
+			}
+			sections = append(sections, key)
+		} else {
+			globals.SetMapIndex(reflect.ValueOf(key), value)
+		}
+	}
+
+	if globals.Len() > 0 {
+		err := writeProperties(globals.Interface())
+		if err != nil {
+			return errors.Wrap(err, "error writing global properties")
+		}
+	}
+
+	for i, key := range sections {
+		name, err := keySerializer(key)
+		if err != nil {
+			return errors.Wrap(err, "error serializing section name")
+		}
+		header := "[" + name + "]" + input.LineSeparator
+		if i > 0 || globals.Len() > 0 {
+			header = input.LineSeparator + header
+		}
+		_, err = input.Writer.Write([]byte(header))
+		if err != nil {
+			return errors.Wrap(err, "error writing section to output writer")
+		}
+		if value, _ := getSection(inputObjectValue.MapIndex(reflect.ValueOf(key))); value.Len() > 0 {
+			err = writeProperties(value.Interface())
+			if err != nil {
+				return errors.Wrapf(err, "error writing properties of section %q", name)
+			}
+		}
+	}
+
+	return nil
+}
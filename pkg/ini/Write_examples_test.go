@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// This example shows you can write a map with a nested map into INI text.
+func ExampleWrite_section() {
+	obj := map[string]interface{}{
+		"a": 1,
+		"b": map[string]interface{}{
+			"c": 2,
+			"d": 3,
+		},
+	}
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:            buf,
+		KeyValueSeparator: "=",
+		LineSeparator:     "\n",
+		Object:            obj,
+		Sorted:            true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+	// Output: a=1
+	//
+	// [b]
+	// c=2
+	// d=3
+}
@@ -0,0 +1,98 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package ini
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrite(t *testing.T) {
+	obj := map[string]interface{}{
+		"name": "example",
+		"server": map[string]interface{}{
+			"host": "localhost",
+			"motd": "hello; world",
+			"port": 8080,
+		},
+		"client": map[string]interface{}{
+			"retries": 3,
+		},
+	}
+	expected := "name=example\n\n[client]\nretries=3\n\n[server]\nhost=localhost\nmotd=\"hello; world\"\nport=8080\n"
+
+	b := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:            b,
+		LineSeparator:     "\n",
+		KeyValueSeparator: "=",
+		Object:            obj,
+		Sorted:            true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b.String())
+
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader(b.String()),
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name": "example",
+		"server": map[string]interface{}{
+			"host": "localhost",
+			"motd": "hello; world",
+			"port": "8080",
+		},
+		"client": map[string]interface{}{
+			"retries": "3",
+		},
+	}, out)
+}
+
+func TestWriteStruct(t *testing.T) {
+	obj := struct {
+		A string
+		B int
+	}{A: "x", B: 1}
+
+	b := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:            b,
+		LineSeparator:     "\n",
+		KeyValueSeparator: "=",
+		Object:            obj,
+		Sorted:            true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "A=x\nB=1\n", b.String())
+}
+
+func TestWriteInvalidKind(t *testing.T) {
+	err := Write(&WriteInput{
+		Writer:            new(strings.Builder),
+		LineSeparator:     "\n",
+		KeyValueSeparator: "=",
+		Object:            []string{"a"},
+	})
+	assert.IsType(t, &ErrInvalidKind{}, err)
+}
+
+func TestWriteNestedSection(t *testing.T) {
+	b := new(strings.Builder)
+	err := Write(&WriteInput{
+		Writer:            b,
+		LineSeparator:     "\n",
+		KeyValueSeparator: "=",
+		Object:            map[string]interface{}{"x": 1, "a": map[string]interface{}{"b": map[string]interface{}{"c": 1}}},
+	})
+	assert.Equal(t, &ErrNestedSection{Section: "a", Key: "b"}, err)
+	assert.Empty(t, b.String())
+}
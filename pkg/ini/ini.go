@@ -0,0 +1,31 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package ini includes functions for reading and writing from INI files.
+// Sections are read as nested maps and keys defined before the first section are kept at the top level.
+// Sections defined more than once are merged together.
+// Lines beginning with ";" or "#" are comments.
+// Values can be wrapped in double quotes, which are unquoted as Go string literals, or in single quotes, which are read literally.
+// See the examples below for usage.
+//
+// Reference:
+//  - https://en.wikipedia.org/wiki/INI_file
+//
+package ini
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	DefaultType                 = reflect.TypeOf(map[string]interface{}{})
+	ErrMissingLineSeparator     = errors.New("missing line separator")
+	ErrMissingKeyValueSeparator = errors.New("missing key-value separator")
+	ErrInvalidUTF8              = errors.New("invalid utf-8")
+)
@@ -7,21 +7,51 @@
 
 // Package iterator provides an easy API to create an iterator to read objects from a file.
 // Depends on the following packages in go-simple-serializer.
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/avro
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/bson
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/cbor
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/encoding
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/fixedwidth
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/geojson
 //	- github.com/spatialcurrent/go-simple-serializer/pkg/jsonl
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/jsonseq
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/logfmt
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/msgpack
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/parquet
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/protobuf
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/regex
 //	- github.com/spatialcurrent/go-simple-serializer/pkg/sv
 //	- github.com/spatialcurrent/go-simple-serializer/pkg/tags
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/xlsx
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/xml
 package iterator
 
 import (
 	"io"
 	"reflect"
+	"regexp"
 
 	"github.com/pkg/errors"
 
+	"github.com/spatialcurrent/go-simple-serializer/pkg/avro"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/bson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/cbor"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/fixedwidth"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/geojson"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/gob"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/jsonl"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/jsonseq"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/logfmt"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/msgpack"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/parquet"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/protobuf"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/regex"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/tags"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/xlsx"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/xml"
 )
 
 var (
@@ -36,46 +66,120 @@ type Iterator interface {
 
 // NewIteratorInput provides the input parameters for the NewIterator function.
 type NewIteratorInput struct {
-	Reader            io.Reader     // the underlying reader
-	Format            string        // the format
-	Header            []interface{} // for csv and tsv, the header.  If not given, then reads first line of stream as header.
-	ScannerBufferSize int           // the initial buffer size for the scanner
-	SkipLines         int           // Skip a given number of lines at the beginning of the stream.
-	SkipBlanks        bool          // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
-	SkipComments      bool          // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
-	Comment           string        // The comment line prefix.  CSV and TSV only support single characters.  JSON Lines support any string.
-	Trim              bool          // Trim each input line before parsing into an object.
-	LazyQuotes        bool          // for csv and tsv, parse with lazy quotes
-	Limit             int           // Limit the number of objects to read and return from the underlying stream.
-	KeyValueSeparator string        // For tags, the key-value separator.
-	LineSeparator     string        // For JSON Lines, the new line byte.
-	DropCR            bool          // For JSON Lines, drop carriage returns at the end of lines.
-	Type              reflect.Type  //
+	Reader              io.Reader      // the underlying reader
+	Format              string         // the format
+	Header              []interface{}  // for csv and tsv, the header.  If not given, then reads first line of stream as header.  For fixedwidth, the column specs.
+	ScannerBufferSize   int            // the initial buffer size for the scanner
+	SkipLines           int            // Skip a given number of lines at the beginning of the stream.
+	SkipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Comment             string         // The comment line prefix.  CSV and TSV only support single characters.  JSON Lines support any string.
+	Trim                bool           // Trim each input line before parsing into an object.
+	LazyQuotes          bool           // for csv and tsv, parse with lazy quotes
+	Limit               int            // Limit the number of objects to read and return from the underlying stream.
+	KeyValueSeparator   string         // For tags, the key-value separator.
+	LineSeparator       string         // For fixedwidth, JSON Lines, logfmt, regex, and tags, the line separator, which can be any sequence of bytes.
+	LineSeparatorRegexp *regexp.Regexp // For fixedwidth, JSON Lines, logfmt, regex, and tags, if not nil, split lines on matches of the regular expression.
+	DropCR              bool           // For JSON Lines, drop carriage returns at the end of lines.
+	Type                reflect.Type   //
+	NumberMode          string         // For GeoJSON, JSON Lines, and JSON text sequences, the mode for decoding numbers.  See the number package for the supported modes.
+	Strict              bool           // For JSON Lines, logfmt, and tags, reject lines with duplicate keys or invalid UTF-8.  For JSON text sequences, also reject truncated records.
+	Limits              limits.Limits  // The resource limits enforced when reading.
+	XMLPath             string         // For XML, the path to the elements to return, e.g., "/catalog/book".  If empty, then returns the root element.
+	Pattern             string         // For regex, the regular expression with named capture groups, which can reference named patterns, e.g., "%{COMBINEDLOG}".
+	NoMatch             string         // For regex, the policy for lines that do not match the pattern, one of "skip", "error", or "raw".
+	Columns             []string       // For parquet, the columns to read.  If empty, then reads all columns.
+	Sheet               string         // For xlsx, the name or zero-based index of the sheet to read.  If empty, then reads the first sheet.
+	DescriptorSet       []byte         // For protobuf, the serialized FileDescriptorSet that includes the message type.
+	Message             string         // For protobuf, the full name of the message type.
+	Flatten             bool           // For geojson and geojsonl, flatten each feature into a record with the properties and the geometry as columns.
+	Longitude           string         // For geojson and geojsonl, if flattening, the name of the longitude column of points.
+	Latitude            string         // For geojson and geojsonl, if flattening, the name of the latitude column of points.
+	WKT                 string         // For geojson and geojsonl, if flattening, the name of the column with the geometry as Well-Known Text.
+	Encoding            string         // The text encoding of the input, one of encoding.InputEncodings.  If empty, then the input is read as is.  Not used by binary formats.
 }
 
 // NewIterator returns an Iterator for the given input source, format, and other options.
 // Supports formats:
+//	- avro - Apache Avro object container files
+//	- bson - concatenated BSON documents, e.g., created by mongodump
+//	- cbor - CBOR Sequence (RFC 8742)
 //	- csv - Comma-Separated Values
+//	- fixedwidth - Fixed-width text
+//	- geojson - features of a GeoJSON FeatureCollection
+//	- geojsonl - GeoJSON Lines
+//	- gob - gob-encoded items
 //	- jsonl - JSON Lines
+//	- jsonseq - JSON text sequences (RFC 7464)
+//	- logfmt - logfmt (key-value pairs)
+//	- msgpack - concatenated MessagePack messages
+//	- parquet - Apache Parquet files
+//	- protobuf - length-delimited Protocol Buffers messages
+//	- regex - lines parsed with a regular expression
 //	- tags - Tags (key-value pairs)
 //	- tsv - Tab-Separated Values
+//	- xlsx - rows of a sheet in an Excel workbook
+//	- xml - XML elements that match a path
 func NewIterator(input *NewIteratorInput) (Iterator, error) {
 
-	if len(input.LineSeparator) == 0 {
-		return nil, ErrMissingLineSeparator
+	switch input.Format {
+	case "fixedwidth", "jsonl", "logfmt", "regex", "tags":
+		if len(input.LineSeparator) == 0 && input.LineSeparatorRegexp == nil {
+			return nil, ErrMissingLineSeparator
+		}
 	}
 
 	switch input.Format {
-	case "csv", "tsv", "gob":
+	case "csv", "tsv", "gob", "xlsx":
 		if input.Type == nil {
 			return nil, ErrMissingType
 		}
 	}
 
+	reader := limits.NewReader(input.Reader, input.Limits.MaxTotalBytes)
+
+	// Transcode text into UTF-8 and remove any byte order mark.  Binary formats are read as is.
 	switch input.Format {
+	case "avro", "bson", "cbor", "gob", "msgpack", "parquet", "protobuf", "xlsx":
+	default:
+		r, err := encoding.NewReader(reader, input.Encoding, input.Strict)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating decoder")
+		}
+		reader = r
+	}
+
+	switch input.Format {
+	case "avro":
+		it, err := avro.NewIterator(&avro.NewIteratorInput{
+			Reader: reader,
+			Type:   input.Type,
+			Limit:  input.Limit,
+			Limits: input.Limits,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating avro iterator")
+		}
+		return it, nil
+	case "bson":
+		it := bson.NewIterator(&bson.NewIteratorInput{
+			Reader: reader,
+			Type:   input.Type,
+			Limit:  input.Limit,
+			Limits: input.Limits,
+		})
+		return it, nil
+	case "cbor":
+		it := cbor.NewIterator(&cbor.NewIteratorInput{
+			Reader: reader,
+			Type:   input.Type,
+			Limit:  input.Limit,
+			Limits: input.Limits,
+		})
+		return it, nil
 	case "csv":
 		it, err := sv.NewIterator(&sv.NewIteratorInput{
-			Reader:     input.Reader,
+			Reader:     reader,
 			Type:       input.Type,
 			Separator:  ',',
 			Header:     input.Header,
@@ -83,45 +187,179 @@ func NewIterator(input *NewIteratorInput) (Iterator, error) {
 			Comment:    input.Comment,
 			LazyQuotes: input.LazyQuotes,
 			Limit:      input.Limit,
+			Limits:     input.Limits,
 		})
 		if err != nil {
 			return it, errors.Wrap(err, "error creating CSV iterator")
 		}
 		return it, nil
+	case "geojson", "geojsonl":
+		it := geojson.NewIterator(&geojson.NewIteratorInput{
+			Reader:     reader,
+			Type:       input.Type,
+			Limit:      input.Limit,
+			Flatten:    input.Flatten,
+			Longitude:  input.Longitude,
+			Latitude:   input.Latitude,
+			WKT:        input.WKT,
+			NumberMode: input.NumberMode,
+			Strict:     input.Strict,
+			Limits:     input.Limits,
+		})
+		return it, nil
+	case "fixedwidth":
+		columns, err := fixedwidth.ParseColumns(input.Header)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing header")
+		}
+		it, err := fixedwidth.NewIterator(&fixedwidth.NewIteratorInput{
+			Reader:              reader,
+			Type:                input.Type,
+			Columns:             columns,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			Comment:             input.Comment,
+			Limit:               input.Limit,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			Limits:              input.Limits,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating fixed-width iterator")
+		}
+		return it, nil
 	case "gob":
 		it := gob.NewIterator(&gob.NewIteratorInput{
-			Reader: input.Reader,
+			Reader: reader,
 			Type:   input.Type,
 			Limit:  input.Limit,
+			Limits: input.Limits,
 		})
 		return it, nil
 	case "jsonl":
 		it := jsonl.NewIterator(&jsonl.NewIteratorInput{
+			Type:                input.Type,
+			Reader:              reader,
+			ScannerBufferSize:   input.ScannerBufferSize,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			Comment:             input.Comment,
+			Trim:                input.Trim,
+			Limit:               input.Limit,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			NumberMode:          input.NumberMode,
+			Strict:              input.Strict,
+			Limits:              input.Limits,
+		})
+		return it, nil
+	case "jsonseq":
+		it := jsonseq.NewIterator(&jsonseq.NewIteratorInput{
 			Type:              input.Type,
-			Reader:            input.Reader,
+			Reader:            reader,
 			ScannerBufferSize: input.ScannerBufferSize,
-			SkipLines:         input.SkipLines,
-			SkipBlanks:        input.SkipBlanks,
-			SkipComments:      input.SkipComments,
-			Comment:           input.Comment,
-			Trim:              input.Trim,
 			Limit:             input.Limit,
-			LineSeparator:     []byte(input.LineSeparator)[0],
-			DropCR:            input.DropCR,
+			NumberMode:        input.NumberMode,
+			Strict:            input.Strict,
+			Limits:            input.Limits,
 		})
 		return it, nil
+	case "logfmt":
+		it := logfmt.NewIterator(&logfmt.NewIteratorInput{
+			Reader:              reader,
+			Type:                input.Type,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			Comment:             input.Comment,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			Limit:               input.Limit,
+			Strict:              input.Strict,
+			Limits:              input.Limits,
+		})
+		return it, nil
+	case "msgpack":
+		it := msgpack.NewIterator(&msgpack.NewIteratorInput{
+			Reader: reader,
+			Type:   input.Type,
+			Limit:  input.Limit,
+			Limits: input.Limits,
+		})
+		return it, nil
+	case "parquet":
+		// Parquet files are read from the footer, so the file is opened directly rather than through the limited reader.
+		it, err := parquet.NewIterator(&parquet.NewIteratorInput{
+			Reader:  input.Reader,
+			Type:    input.Type,
+			Columns: input.Columns,
+			Limit:   input.Limit,
+			Limits:  input.Limits,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating parquet iterator")
+		}
+		return it, nil
+	case "protobuf":
+		mt, err := protobuf.LoadMessageType(input.DescriptorSet, input.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading protobuf message type")
+		}
+		it, err := protobuf.NewIterator(&protobuf.NewIteratorInput{
+			Reader:      reader,
+			Type:        input.Type,
+			MessageType: mt,
+			Limit:       input.Limit,
+			Limits:      input.Limits,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating protobuf iterator")
+		}
+		return it, nil
+	case "regex":
+		re, err := regex.Compile(input.Pattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "error compiling pattern")
+		}
+		it, err := regex.NewIterator(&regex.NewIteratorInput{
+			Reader:              reader,
+			Type:                input.Type,
+			Pattern:             re,
+			NoMatch:             input.NoMatch,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			Comment:             input.Comment,
+			Limit:               input.Limit,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			Limits:              input.Limits,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating regex iterator")
+		}
+		return it, nil
 	case "tags":
 		it, err := tags.NewIterator(&tags.NewIteratorInput{
-			Reader:            input.Reader,
-			Type:              input.Type,
-			SkipLines:         input.SkipLines,
-			SkipBlanks:        input.SkipBlanks,
-			SkipComments:      input.SkipComments,
-			Comment:           input.Comment,
-			KeyValueSeparator: input.KeyValueSeparator,
-			LineSeparator:     []byte(input.LineSeparator)[0],
-			DropCR:            input.DropCR,
-			Limit:             input.Limit,
+			Reader:              reader,
+			Type:                input.Type,
+			SkipLines:           input.SkipLines,
+			SkipBlanks:          input.SkipBlanks,
+			SkipComments:        input.SkipComments,
+			Comment:             input.Comment,
+			KeyValueSeparator:   input.KeyValueSeparator,
+			LineSeparator:       input.LineSeparator,
+			LineSeparatorRegexp: input.LineSeparatorRegexp,
+			DropCR:              input.DropCR,
+			Limit:               input.Limit,
+			Strict:              input.Strict,
+			Limits:              input.Limits,
 		})
 		if err != nil {
 			return it, errors.Wrap(err, "error creating tags iterator")
@@ -129,7 +367,7 @@ func NewIterator(input *NewIteratorInput) (Iterator, error) {
 		return it, nil
 	case "tsv":
 		it, err := sv.NewIterator(&sv.NewIteratorInput{
-			Reader:     input.Reader,
+			Reader:     reader,
 			Type:       input.Type,
 			Separator:  '\t',
 			Header:     input.Header,
@@ -137,11 +375,37 @@ func NewIterator(input *NewIteratorInput) (Iterator, error) {
 			Comment:    input.Comment,
 			LazyQuotes: input.LazyQuotes,
 			Limit:      input.Limit,
+			Limits:     input.Limits,
 		})
 		if err != nil {
 			return it, errors.Wrap(err, "error creating TSV iterator")
 		}
 		return it, nil
+	case "xlsx":
+		it, err := xlsx.NewIterator(&xlsx.NewIteratorInput{
+			Reader:    reader,
+			Type:      input.Type,
+			Sheet:     input.Sheet,
+			Header:    input.Header,
+			SkipLines: input.SkipLines,
+			Limit:     input.Limit,
+			Limits:    input.Limits,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating xlsx iterator")
+		}
+		return it, nil
+	case "xml":
+		it, err := xml.NewIterator(&xml.NewIteratorInput{
+			Reader: reader,
+			Path:   input.XMLPath,
+			Limit:  input.Limit,
+			Limits: input.Limits,
+		})
+		if err != nil {
+			return it, errors.Wrap(err, "error creating XML iterator")
+		}
+		return it, nil
 	}
 	return nil, &ErrInvalidFormat{Format: input.Format}
 }
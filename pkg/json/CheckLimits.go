@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// CheckLimits scans the JSON document without decoding it and returns an error
+// if it exceeds the maximum record size, nesting depth, or number of keys in an object.
+// CheckLimits does not validate the document.
+func CheckLimits(b []byte, l limits.Limits) error {
+	if err := l.CheckRecordBytes(len(b)); err != nil {
+		return err
+	}
+	if l.MaxDepth == 0 && l.MaxKeys == 0 {
+		return nil
+	}
+	keys := make([]int, 0) // the number of keys in each open object or array
+	str := false
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if str {
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				str = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			str = true
+		case '{', '[':
+			keys = append(keys, 0)
+			if err := l.CheckDepth(len(keys)); err != nil {
+				return err
+			}
+		case '}', ']':
+			if len(keys) > 0 {
+				keys = keys[:len(keys)-1]
+			}
+		case ':':
+			// Colons outside of strings only occur between the keys and values of objects.
+			if len(keys) > 0 {
+				keys[len(keys)-1]++
+				if err := l.CheckKeys(keys[len(keys)-1]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestCheckLimits(t *testing.T) {
+	in := []byte(`{"a": [{"b": "c:{["}], "d": "}"}`)
+	assert.NoError(t, CheckLimits(in, limits.Limits{}))
+	assert.NoError(t, CheckLimits(in, limits.Limits{MaxDepth: 3, MaxKeys: 2, MaxRecordBytes: len(in)}))
+	assert.Equal(t, &limits.ErrMaxDepth{Max: 2}, CheckLimits(in, limits.Limits{MaxDepth: 2}))
+	assert.Equal(t, &limits.ErrMaxKeys{Max: 1}, CheckLimits(in, limits.Limits{MaxKeys: 1}))
+	assert.Equal(t, &limits.ErrMaxRecordBytes{Max: 10}, CheckLimits(in, limits.Limits{MaxRecordBytes: 10}))
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"fmt"
+)
+
+// ErrTrailingData is used when there is data after the end of a JSON document.
+type ErrTrailingData struct {
+	Line   int // the line where the trailing data starts, starting at 1
+	Column int // the column where the trailing data starts, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrTrailingData) Error() string {
+	return fmt.Sprintf("invalid data after top-level value at line %d, column %d", e.Line, e.Column)
+}
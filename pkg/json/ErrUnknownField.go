@@ -0,0 +1,26 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ErrUnknownField is used when an object includes a key that does not match any field of the struct type.
+type ErrUnknownField struct {
+	Field  string       // the name of the unknown field
+	Type   reflect.Type // the type of the output object
+	Line   int          // the line where decoding stopped, starting at 1
+	Column int          // the column where decoding stopped, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownField) Error() string {
+	return fmt.Sprintf("unknown field %q for type %q at line %d, column %d", e.Field, e.Type, e.Line, e.Column)
+}
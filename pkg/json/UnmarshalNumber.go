@@ -0,0 +1,69 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"bytes"
+	stdjson "encoding/json" // import the standard json library as stdjson
+	"fmt"
+	"io"
+	"unicode/utf8" // utf8 is used to decode the first rune in the string
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// UnmarshalNumber parses a slice of bytes into an object like Unmarshal,
+// but decodes numbers using the given number mode, so that large integers do not lose precision.
+// If the mode is empty or float, then simply uses Unmarshal.
+// See the number package for the supported modes.
+//
+//  - float => float64
+//  - number => json.Number
+//  - int64 => int64 for integers, float64 otherwise
+//  - big => *big.Int for integers, *big.Float otherwise
+func UnmarshalNumber(b []byte, mode string) (interface{}, error) {
+
+	if len(mode) == 0 || mode == number.ModeFloat {
+		return Unmarshal(b)
+	}
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	first, _ := utf8.DecodeRune(b)
+	if first == utf8.RuneError {
+		return nil, ErrInvalidRune
+	}
+
+	var obj interface{}
+	err := decodeNumber(b, &obj)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error unmarshaling JSON %q", string(b)))
+	}
+
+	return number.Convert(obj, mode)
+}
+
+// decodeNumber decodes the bytes into the value pointed to by ptr, decoding numbers as json.Number.
+// Like the standard Unmarshal function, returns an error if there is data after the first JSON value.
+func decodeNumber(b []byte, ptr interface{}) error {
+	d := stdjson.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	err := d.Decode(ptr)
+	if err != nil {
+		return err
+	}
+	_, err = d.Token()
+	if err != io.EOF {
+		return errors.New("invalid character after top-level value")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	stdjson "encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalNumberFloat(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("{\"id\":9007199254740993}"), "float")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": 9007199254740992.0}, obj)
+}
+
+func TestUnmarshalNumberNumber(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("{\"id\":9007199254740993,\"values\":[1.5]}"), "number")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": stdjson.Number("9007199254740993"), "values": []interface{}{stdjson.Number("1.5")}}, obj)
+}
+
+func TestUnmarshalNumberInt64(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("[9007199254740993,1.5]"), "int64")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(9007199254740993), 1.5}, obj)
+}
+
+func TestUnmarshalNumberBig(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("92233720368547758070"), "big")
+	assert.NoError(t, err)
+	expected, _ := new(big.Int).SetString("92233720368547758070", 10)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalNumberTrailing(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("{\"a\":1} x"), "number")
+	assert.Error(t, err)
+	assert.Nil(t, obj)
+}
+
+func TestUnmarshalTypeNumber(t *testing.T) {
+	obj, err := UnmarshalTypeNumber([]byte("{\"id\":9007199254740993}"), reflect.TypeOf(map[string]interface{}{}), "int64")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": int64(9007199254740993)}, obj)
+}
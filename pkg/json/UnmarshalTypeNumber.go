@@ -0,0 +1,49 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// UnmarshalTypeNumber parses a slice of bytes into an object of a given type like UnmarshalType,
+// but decodes numbers stored in interface values using the given number mode.
+// If the mode is empty or float, then simply uses UnmarshalType.
+func UnmarshalTypeNumber(b []byte, outputType reflect.Type, mode string) (interface{}, error) {
+
+	if len(mode) == 0 || mode == number.ModeFloat {
+		return UnmarshalType(b, outputType)
+	}
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	switch outputType.Kind() {
+	case reflect.Interface:
+		return UnmarshalNumber(b, mode)
+	case reflect.Map, reflect.Slice:
+		// A null value returns nil, like UnmarshalType.
+		if string(b) == "null" {
+			return nil, nil
+		}
+		ptr := reflect.New(outputType)
+		err := decodeNumber(b, ptr.Interface())
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error unmarshaling JSON %q into %T", string(b), ptr.Interface()))
+		}
+		return number.Convert(ptr.Elem().Interface(), mode)
+	}
+
+	return UnmarshalType(b, outputType)
+}
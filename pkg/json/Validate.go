@@ -0,0 +1,171 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"bytes"
+	stdjson "encoding/json" // import the standard json library as stdjson
+	"io"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Validate checks that the slice of bytes is a single JSON value that can be decoded without ambiguity,
+// and returns an error describing the first problem found, if any.
+// Validate is used by the serializer when strict mode is enabled.
+//
+//  - Invalid UTF-8 returns ErrInvalidUTF8 wrapped with the location of the invalid byte.
+//  - Objects with the same key more than once return ErrDuplicateKey.
+//  - Data after the first value returns ErrTrailingData.
+//  - If the output type is not nil and decodes structs, then keys that do not match a struct field return ErrUnknownField.
+func Validate(b []byte, outputType reflect.Type) error {
+
+	if len(b) == 0 {
+		return ErrEmptyInput
+	}
+
+	if !utf8.Valid(b) {
+		offset := 0
+		for offset < len(b) {
+			r, size := utf8.DecodeRune(b[offset:])
+			if r == utf8.RuneError && size == 1 {
+				break
+			}
+			offset += size
+		}
+		line, column := position(b, offset)
+		return errors.Wrapf(ErrInvalidUTF8, "invalid byte at line %d, column %d", line, column)
+	}
+
+	err := checkKeys(b)
+	if err != nil {
+		return err
+	}
+
+	if outputType != nil && outputType.Kind() != reflect.Interface {
+		d := stdjson.NewDecoder(bytes.NewReader(b))
+		d.DisallowUnknownFields()
+		err := d.Decode(reflect.New(outputType).Interface())
+		if err != nil {
+			if msg := err.Error(); strings.HasPrefix(msg, "json: unknown field ") {
+				line, column := position(b, int(d.InputOffset()))
+				return &ErrUnknownField{
+					Field:  strings.Trim(strings.TrimPrefix(msg, "json: unknown field "), "\""),
+					Type:   outputType,
+					Line:   line,
+					Column: column,
+				}
+			}
+			return errors.Wrapf(err, "error decoding JSON into %q", outputType)
+		}
+	}
+
+	return nil
+}
+
+// checkKeys reads through the tokens of the JSON value and returns ErrDuplicateKey if an object includes the same key twice.
+// Also returns ErrTrailingData if there is any data after the first value.
+func checkKeys(b []byte) error {
+	// objects is the stack of keys for each object that is currently open.
+	// Arrays are included in the stack as nil, so that keys are only checked within objects.
+	objects := make([]map[string]struct{}, 0)
+	// expectKey is true when the next string token in the current object is a key.
+	expectKey := make([]bool, 0)
+
+	d := stdjson.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	for {
+		offset := d.InputOffset()
+		t, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if se, ok := err.(*stdjson.SyntaxError); ok {
+				line, column := position(b, int(se.Offset))
+				return errors.Wrapf(err, "invalid JSON at line %d, column %d", line, column)
+			}
+			return errors.Wrap(err, "invalid JSON")
+		}
+
+		if depth := len(objects); depth > 0 && objects[depth-1] != nil && expectKey[depth-1] {
+			key, ok := t.(string)
+			if !ok {
+				// The object is closed.
+				objects = objects[:depth-1]
+				expectKey = expectKey[:depth-1]
+				if depth := len(objects); depth > 0 && objects[depth-1] != nil {
+					expectKey[depth-1] = true
+				}
+				if len(objects) == 0 && d.More() {
+					return trailingData(b, d)
+				}
+				continue
+			}
+			if _, ok := objects[depth-1][key]; ok {
+				// Skip the separator and whitespace before the key.
+				start := int(offset)
+				for start < len(b) && b[start] != '"' {
+					start++
+				}
+				line, column := position(b, start)
+				return &ErrDuplicateKey{Key: key, Line: line, Column: column}
+			}
+			objects[depth-1][key] = struct{}{}
+			expectKey[depth-1] = false
+			continue
+		}
+
+		switch t {
+		case stdjson.Delim('{'):
+			objects = append(objects, map[string]struct{}{})
+			expectKey = append(expectKey, true)
+			continue
+		case stdjson.Delim('['):
+			objects = append(objects, nil)
+			expectKey = append(expectKey, false)
+			continue
+		case stdjson.Delim('}'), stdjson.Delim(']'):
+			objects = objects[:len(objects)-1]
+			expectKey = expectKey[:len(expectKey)-1]
+		}
+
+		// After a value in an object, the next token is a key.
+		if depth := len(objects); depth > 0 && objects[depth-1] != nil {
+			expectKey[depth-1] = true
+		}
+
+		// After the top-level value, there should be no more data.
+		if len(objects) == 0 && d.More() {
+			return trailingData(b, d)
+		}
+	}
+}
+
+// trailingData returns ErrTrailingData with the location of the next token in the decoder.
+func trailingData(b []byte, d *stdjson.Decoder) error {
+	start := int(d.InputOffset())
+	for start < len(b) && (b[start] == ' ' || b[start] == '\t' || b[start] == '\n' || b[start] == '\r') {
+		start++
+	}
+	line, column := position(b, start)
+	return &ErrTrailingData{Line: line, Column: column}
+}
+
+// position returns the line and column of the byte offset, starting at 1.
+func position(b []byte, offset int) (int, int) {
+	if offset > len(b) {
+		offset = len(b)
+	}
+	line := bytes.Count(b[:offset], []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(b[:offset], '\n')
+	return line, column
+}
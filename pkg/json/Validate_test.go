@@ -0,0 +1,46 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	err := Validate([]byte("{\"a\": [1, {\"b\": 2}], \"c\": {\"a\": 3}}"), nil)
+	assert.NoError(t, err)
+}
+
+func TestValidateDuplicateKey(t *testing.T) {
+	err := Validate([]byte("{\n  \"a\": {\"b\": 1, \"b\": 2}\n}"), nil)
+	assert.Equal(t, &ErrDuplicateKey{Key: "b", Line: 2, Column: 17}, err)
+}
+
+func TestValidateTrailingData(t *testing.T) {
+	err := Validate([]byte("{\"a\": 1}\n  x"), nil)
+	assert.Equal(t, &ErrTrailingData{Line: 2, Column: 3}, err)
+}
+
+func TestValidateInvalidUTF8(t *testing.T) {
+	err := Validate([]byte("{\"a\": \"\xff\"}"), nil)
+	assert.Equal(t, ErrInvalidUTF8, errors.Cause(err))
+}
+
+func TestValidateUnknownField(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+	err := Validate([]byte("{\"x\": 1, \"z\": 2}"), reflect.TypeOf(point{}))
+	assert.IsType(t, &ErrUnknownField{}, err)
+	assert.Equal(t, "z", err.(*ErrUnknownField).Field)
+}
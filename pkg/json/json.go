@@ -16,6 +16,7 @@ import (
 var (
 	ErrEmptyInput  = errors.New("empty input")
 	ErrInvalidRune = errors.New("invalid rune")
+	ErrInvalidUTF8 = errors.New("invalid utf-8")
 
 	BytesTrue  = []byte("true")
 	BytesFalse = []byte("false")
@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"fmt"
+)
+
+// ErrUnexpectedToken is used when the input contains a token that is not valid at its position in a JSON5 document.
+type ErrUnexpectedToken struct {
+	Value  string // the unexpected token
+	Line   int    // the line of the token, starting at 1
+	Column int    // the column of the token, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnexpectedToken) Error() string {
+	return fmt.Sprintf("unexpected token %q at line %d, column %d", e.Value, e.Line, e.Column)
+}
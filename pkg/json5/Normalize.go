@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"bytes"
+)
+
+// Normalize converts the given JSON5 document into strict JSON.
+// Comments and trailing commas are removed, keys and single-quoted strings are converted into double-quoted strings,
+// and hexadecimal numbers, as well as numbers with a leading plus sign or a leading or trailing decimal point, are converted into decimal numbers.
+// Leading and trailing whitespace is removed, so an input with only whitespace and comments returns an empty slice.
+func Normalize(b []byte) ([]byte, error) {
+	p := &parser{
+		input:  b,
+		offset: 0,
+		output: new(bytes.Buffer),
+	}
+	if err := p.skip(); err != nil {
+		return nil, err
+	}
+	if p.offset == len(p.input) {
+		return make([]byte, 0), nil
+	}
+	if err := p.parseValue(); err != nil {
+		return nil, err
+	}
+	if err := p.skip(); err != nil {
+		return nil, err
+	}
+	if p.offset < len(p.input) {
+		return nil, p.unexpected()
+	}
+	return p.output.Bytes(), nil
+}
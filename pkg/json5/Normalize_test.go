@@ -0,0 +1,67 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		in       string
+		expected string
+	}{
+		{in: `{}`, expected: `{}`},
+		{in: `{"a": 1}`, expected: `{"a":1}`},
+		{in: "// comment\n{a: 1, /* inline */ b: 2,}", expected: `{"a":1,"b":2}`},
+		{in: `{$key_1: 'x'}`, expected: `{"$key_1":"x"}`},
+		{in: `['a "quoted" value', 'it\'s']`, expected: `["a \"quoted\" value","it's"]`},
+		{in: `[0x1F, -0XFF, +1, .5, 5., 1e3, -2.5E-1,]`, expected: `[31,-255,1,0.5,5,1e3,-2.5E-1]`},
+		{in: `'\x41\v\0'`, expected: `"\u0041\u000b\u0000"`},
+		{in: "'line \\\ncontinued'", expected: `"line continued"`},
+		{in: "'tab\there'", expected: `"tab\u0009here"`},
+		{in: `[true, false, null]`, expected: `[true,false,null]`},
+		{in: `{null: 1, true: 2}`, expected: `{"null":1,"true":2}`},
+		{in: "\ufeff  [ ]  ", expected: `[]`},
+		{in: " // only a comment\n", expected: ``},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			out, err := Normalize([]byte(tc.in))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(out))
+		})
+	}
+}
+
+func TestNormalizeErrors(t *testing.T) {
+	testCases := []struct {
+		in       string
+		expected error
+	}{
+		{in: `{a: 1`, expected: ErrUnexpectedEnd},
+		{in: `/* a`, expected: ErrUnterminatedComment},
+		{in: `'abc`, expected: ErrUnterminatedString},
+		{in: `[Infinity]`, expected: ErrNonFiniteNumber},
+		{in: `-NaN`, expected: ErrNonFiniteNumber},
+		{in: `{a: b}`, expected: &ErrUnexpectedToken{Value: "b", Line: 1, Column: 5}},
+		{in: "[1,\n,]", expected: &ErrUnexpectedToken{Value: ",", Line: 2, Column: 1}},
+		{in: `{} {}`, expected: &ErrUnexpectedToken{Value: "{", Line: 1, Column: 4}},
+		{in: `0x1G`, expected: &ErrUnexpectedToken{Value: "0x1G", Line: 1, Column: 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			out, err := Normalize([]byte(tc.in))
+			assert.Equal(t, tc.expected, errors.Cause(err))
+			assert.Nil(t, out)
+		})
+	}
+}
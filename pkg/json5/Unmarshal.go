@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+)
+
+// Unmarshal parses a JSON5 document into an object using the same type inference rules as json.Unmarshal.
+// If no input is given, or the input only includes whitespace and comments, then returns json.ErrEmptyInput.
+func Unmarshal(b []byte) (interface{}, error) {
+	n, err := Normalize(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "error normalizing JSON5")
+	}
+	return json.Unmarshal(n)
+}
@@ -0,0 +1,26 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+)
+
+// UnmarshalType parses a JSON5 document into an object of the given type, in the same way as json.UnmarshalType.
+// If no input is given, or the input only includes whitespace and comments, then returns json.ErrEmptyInput.
+func UnmarshalType(b []byte, outputType reflect.Type) (interface{}, error) {
+	n, err := Normalize(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "error normalizing JSON5")
+	}
+	return json.UnmarshalType(n, outputType)
+}
@@ -0,0 +1,31 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTypeStruct(t *testing.T) {
+	type config struct {
+		Name string `json:"name"`
+		Port int    `json:"port"`
+	}
+	obj, err := UnmarshalType([]byte(`{name: 'example', port: 0x50,}`), reflect.TypeOf(config{}))
+	assert.NoError(t, err)
+	assert.Equal(t, config{Name: "example", Port: 80}, obj)
+}
+
+func TestUnmarshalTypeMap(t *testing.T) {
+	obj, err := UnmarshalType([]byte(`{a: 'x', /* comment */ b: 'y'}`), reflect.TypeOf(map[string]string{}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "x", "b": "y"}, obj)
+}
@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+)
+
+func TestUnmarshalEmpty(t *testing.T) {
+	obj, err := Unmarshal([]byte("// nothing"))
+	assert.Equal(t, json.ErrEmptyInput, err)
+	assert.Nil(t, obj)
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	in := `{
+  // hand-written configuration
+  name: 'example',
+  port: 0x1F90,
+  tags: ['a', 'b',],
+}`
+	obj, err := Unmarshal([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name": "example",
+		"port": 8080.0,
+		"tags": []interface{}{"a", "b"},
+	}, obj)
+}
+
+func TestUnmarshalArray(t *testing.T) {
+	obj, err := Unmarshal([]byte(`[1, 'x', true, null,]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1.0, "x", true, nil}, obj)
+}
@@ -0,0 +1,31 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package json5 provides an API for reading JSON5, a relaxed superset of JSON for hand-written configuration.
+// JSON5 supports comments, trailing commas, unquoted keys, single-quoted strings, and hexadecimal numbers.
+// The input is normalized into strict JSON and then decoded by the json package in go-simple-serializer,
+// so that JSON5 is decoded into the same types as JSON.
+// Infinity and NaN are not supported, since they cannot be represented in JSON.
+//	- https://spec.json5.org/
+package json5
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxDepth is the maximum depth of nested objects and arrays, which matches the limit of the standard json library.
+	MaxDepth = 10000
+)
+
+var (
+	ErrNonFiniteNumber     = errors.New("Infinity and NaN are not supported")
+	ErrMaxDepth            = errors.New("exceeded maximum depth")
+	ErrUnexpectedEnd       = errors.New("unexpected end of input")
+	ErrUnterminatedComment = errors.New("unterminated comment")
+	ErrUnterminatedString  = errors.New("unterminated string")
+)
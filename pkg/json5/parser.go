@@ -0,0 +1,446 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package json5
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// parser is a recursive descent parser that writes the strict JSON equivalent of a JSON5 document to the output.
+type parser struct {
+	input  []byte
+	offset int
+	output *bytes.Buffer
+	depth  int
+}
+
+// peek returns the next rune and its size without advancing, or utf8.RuneError and 0 at the end of the input.
+func (p *parser) peek() (rune, int) {
+	if p.offset >= len(p.input) {
+		return utf8.RuneError, 0
+	}
+	return utf8.DecodeRune(p.input[p.offset:])
+}
+
+// errorf wraps the error with the line and column of the current offset.
+func (p *parser) errorf(err error) error {
+	line, column := position(p.input, p.offset)
+	return errors.Wrapf(err, "error at line %d, column %d", line, column)
+}
+
+// unexpected returns an error for the token at the current offset.
+func (p *parser) unexpected() error {
+	if p.offset >= len(p.input) {
+		return p.errorf(ErrUnexpectedEnd)
+	}
+	line, column := position(p.input, p.offset)
+	value := p.input[p.offset:]
+	if end := bytes.IndexAny(value, " \t\r\n,:[]{}"); end > 0 {
+		value = value[:end]
+	} else if end == 0 {
+		value = value[:1]
+	}
+	return &ErrUnexpectedToken{Value: string(value), Line: line, Column: column}
+}
+
+// skip advances past whitespace and comments.
+func (p *parser) skip() error {
+	for p.offset < len(p.input) {
+		r, size := p.peek()
+		switch {
+		case r == '/' && bytes.HasPrefix(p.input[p.offset:], []byte("//")):
+			p.offset += 2
+			for p.offset < len(p.input) {
+				r, size := p.peek()
+				p.offset += size
+				if isLineTerminator(r) {
+					break
+				}
+			}
+		case r == '/' && bytes.HasPrefix(p.input[p.offset:], []byte("/*")):
+			end := bytes.Index(p.input[p.offset+2:], []byte("*/"))
+			if end == -1 {
+				return p.errorf(ErrUnterminatedComment)
+			}
+			p.offset += 2 + end + 2
+		case isSpace(r):
+			p.offset += size
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseValue() error {
+	if err := p.skip(); err != nil {
+		return err
+	}
+	r, _ := p.peek()
+	switch {
+	case p.offset >= len(p.input):
+		return p.errorf(ErrUnexpectedEnd)
+	case r == '{':
+		return p.parseObject()
+	case r == '[':
+		return p.parseArray()
+	case r == '"' || r == '\'':
+		return p.parseString()
+	case r == '+' || r == '-' || r == '.' || (r >= '0' && r <= '9'):
+		return p.parseNumber()
+	case isIdentifierStart(r):
+		start := p.offset
+		switch identifier := p.parseIdentifier(); identifier {
+		case "true", "false", "null":
+			p.output.WriteString(identifier)
+			return nil
+		case "Infinity", "NaN":
+			p.offset = start
+			return p.errorf(ErrNonFiniteNumber)
+		}
+		p.offset = start
+		return p.unexpected()
+	}
+	return p.unexpected()
+}
+
+func (p *parser) parseObject() error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return p.errorf(ErrMaxDepth)
+	}
+	p.offset++
+	p.output.WriteByte('{')
+	for {
+		if err := p.skip(); err != nil {
+			return err
+		}
+		r, _ := p.peek()
+		switch {
+		case r == '}':
+			// empty object or trailing comma
+			p.offset++
+			p.output.WriteByte('}')
+			p.depth--
+			return nil
+		case r == '"' || r == '\'':
+			if err := p.parseString(); err != nil {
+				return err
+			}
+		case p.offset < len(p.input) && isIdentifierStart(r):
+			p.output.WriteByte('"')
+			p.output.WriteString(p.parseIdentifier())
+			p.output.WriteByte('"')
+		default:
+			return p.unexpected()
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if r, _ := p.peek(); r != ':' || p.offset >= len(p.input) {
+			return p.unexpected()
+		}
+		p.offset++
+		p.output.WriteByte(':')
+		if err := p.parseValue(); err != nil {
+			return err
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		switch r, _ := p.peek(); {
+		case p.offset >= len(p.input):
+			return p.errorf(ErrUnexpectedEnd)
+		case r == ',':
+			p.offset++
+			if err := p.skip(); err != nil {
+				return err
+			}
+			if r, _ := p.peek(); r != '}' || p.offset >= len(p.input) {
+				p.output.WriteByte(',')
+			}
+		case r == '}':
+		default:
+			return p.unexpected()
+		}
+	}
+}
+
+func (p *parser) parseArray() error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return p.errorf(ErrMaxDepth)
+	}
+	p.offset++
+	p.output.WriteByte('[')
+	for {
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if r, _ := p.peek(); r == ']' && p.offset < len(p.input) {
+			// empty array or trailing comma
+			p.offset++
+			p.output.WriteByte(']')
+			p.depth--
+			return nil
+		}
+		if err := p.parseValue(); err != nil {
+			return err
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		switch r, _ := p.peek(); {
+		case p.offset >= len(p.input):
+			return p.errorf(ErrUnexpectedEnd)
+		case r == ',':
+			p.offset++
+			if err := p.skip(); err != nil {
+				return err
+			}
+			if r, _ := p.peek(); r != ']' || p.offset >= len(p.input) {
+				p.output.WriteByte(',')
+			}
+		case r == ']':
+		default:
+			return p.unexpected()
+		}
+	}
+}
+
+// parseString writes a single-quoted or double-quoted string as a double-quoted string,
+// converting the escape sequences that are not valid in JSON.
+func (p *parser) parseString() error {
+	quote, _ := p.peek()
+	start := p.offset
+	p.offset++
+	p.output.WriteByte('"')
+	for {
+		if p.offset >= len(p.input) {
+			p.offset = start
+			return p.errorf(ErrUnterminatedString)
+		}
+		r, size := p.peek()
+		p.offset += size
+		switch {
+		case r == quote:
+			p.output.WriteByte('"')
+			return nil
+		case r == '"':
+			p.output.WriteString(`\"`)
+		case r == '\n' || r == '\r':
+			p.offset = start
+			return p.errorf(ErrUnterminatedString)
+		case r == '\\':
+			if err := p.parseEscape(); err != nil {
+				return err
+			}
+		case r < 0x20:
+			fmt.Fprintf(p.output, `\u%04x`, r)
+		default:
+			p.output.Write(p.input[p.offset-size : p.offset])
+		}
+	}
+}
+
+// parseEscape writes the JSON equivalent of the escape sequence after a backslash.
+func (p *parser) parseEscape() error {
+	if p.offset >= len(p.input) {
+		return p.errorf(ErrUnterminatedString)
+	}
+	r, size := p.peek()
+	p.offset += size
+	switch r {
+	case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+		p.output.WriteByte('\\')
+		p.output.WriteRune(r)
+	case '\'':
+		p.output.WriteByte('\'')
+	case 'v':
+		p.output.WriteString(`\u000b`)
+	case '0':
+		p.output.WriteString(`\u0000`)
+	case 'x', 'u':
+		n := 2
+		if r == 'u' {
+			n = 4
+		}
+		if p.offset+n > len(p.input) || !isHex(p.input[p.offset:p.offset+n]) {
+			p.offset -= size + 1
+			return p.unexpected()
+		}
+		p.output.WriteString(`\u`)
+		if n == 2 {
+			p.output.WriteString("00")
+		}
+		p.output.Write(p.input[p.offset : p.offset+n])
+		p.offset += n
+	case '\r':
+		// line continuation
+		if r, _ := p.peek(); r == '\n' {
+			p.offset++
+		}
+	case '\n', '\u2028', '\u2029':
+		// line continuation
+	default:
+		// Any other escaped character is the character itself.
+		if r < 0x20 {
+			fmt.Fprintf(p.output, `\u%04x`, r)
+		} else {
+			p.output.WriteRune(r)
+		}
+	}
+	return nil
+}
+
+// parseNumber writes a number in decimal notation.
+func (p *parser) parseNumber() error {
+	start := p.offset
+	r, _ := p.peek()
+	if r == '+' || r == '-' {
+		if r == '-' {
+			p.output.WriteByte('-')
+		}
+		p.offset++
+	}
+	if r, _ := p.peek(); isIdentifierStart(r) && p.offset < len(p.input) {
+		if identifier := p.parseIdentifier(); identifier == "Infinity" || identifier == "NaN" {
+			p.offset = start
+			return p.errorf(ErrNonFiniteNumber)
+		}
+		p.offset = start
+		return p.unexpected()
+	}
+	// hexadecimal
+	if rest := p.input[p.offset:]; len(rest) > 1 && rest[0] == '0' && (rest[1] == 'x' || rest[1] == 'X') {
+		p.offset += 2
+		digits := p.readWhile(func(c byte) bool { return isHex([]byte{c}) })
+		i, ok := new(big.Int).SetString(string(digits), 16)
+		if !ok {
+			p.offset = start
+			return p.unexpected()
+		}
+		p.output.WriteString(i.String())
+		return p.checkNumberEnd(start)
+	}
+	integer := p.readWhile(isDigit)
+	var fraction []byte
+	if p.offset < len(p.input) && p.input[p.offset] == '.' {
+		p.offset++
+		fraction = p.readWhile(isDigit)
+	}
+	if len(integer) == 0 && len(fraction) == 0 {
+		p.offset = start
+		return p.unexpected()
+	}
+	if len(integer) == 0 {
+		p.output.WriteByte('0')
+	} else {
+		p.output.Write(integer)
+	}
+	if len(fraction) > 0 {
+		p.output.WriteByte('.')
+		p.output.Write(fraction)
+	}
+	if p.offset < len(p.input) && (p.input[p.offset] == 'e' || p.input[p.offset] == 'E') {
+		p.output.WriteByte(p.input[p.offset])
+		p.offset++
+		if p.offset < len(p.input) && (p.input[p.offset] == '+' || p.input[p.offset] == '-') {
+			p.output.WriteByte(p.input[p.offset])
+			p.offset++
+		}
+		exponent := p.readWhile(isDigit)
+		if len(exponent) == 0 {
+			p.offset = start
+			return p.unexpected()
+		}
+		p.output.Write(exponent)
+	}
+	return p.checkNumberEnd(start)
+}
+
+// checkNumberEnd returns an error if a number is immediately followed by an identifier or another number, e.g., 0x1G or 1.2.3.
+func (p *parser) checkNumberEnd(start int) error {
+	if r, _ := p.peek(); p.offset < len(p.input) && (r == '.' || isIdentifierPart(r)) {
+		p.offset = start
+		return p.unexpected()
+	}
+	return nil
+}
+
+// parseIdentifier reads and returns an ECMAScript 5.1 identifier name.
+// Unicode escape sequences in identifiers are not supported.
+func (p *parser) parseIdentifier() string {
+	start := p.offset
+	for p.offset < len(p.input) {
+		r, size := p.peek()
+		if (p.offset == start && !isIdentifierStart(r)) || (p.offset > start && !isIdentifierPart(r)) {
+			break
+		}
+		p.offset += size
+	}
+	return string(p.input[start:p.offset])
+}
+
+// readWhile reads and returns the bytes that match the given function.
+func (p *parser) readWhile(f func(c byte) bool) []byte {
+	start := p.offset
+	for p.offset < len(p.input) && f(p.input[p.offset]) {
+		p.offset++
+	}
+	return p.input[start:p.offset]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHex(b []byte) bool {
+	for _, c := range b {
+		if !(isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLineTerminator(r rune) bool {
+	return r == '\n' || r == '\r' || r == '\u2028' || r == '\u2029'
+}
+
+func isSpace(r rune) bool {
+	switch r {
+	case '\t', '\n', '\v', '\f', '\r', ' ', '\u00a0', '\u2028', '\u2029', '\ufeff':
+		return true
+	}
+	return unicode.Is(unicode.Zs, r)
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '$' || r == '_' || unicode.IsLetter(r) || unicode.Is(unicode.Nl, r)
+}
+
+func isIdentifierPart(r rune) bool {
+	return isIdentifierStart(r) || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc) || r == '\u200c' || r == '\u200d'
+}
+
+// position returns the line and column of the byte offset, starting at 1.
+func position(b []byte, offset int) (int, int) {
+	if offset > len(b) {
+		offset = len(b)
+	}
+	line := bytes.Count(b[:offset], []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(b[:offset], '\n')
+	return line, column
+}
@@ -12,11 +12,14 @@ import (
 	"bytes"
 	"io"
 	"reflect"
+	"regexp"
 
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
 )
 
 // Iterator iterates trough a stream of bytes
@@ -31,36 +34,55 @@ type Iterator struct {
 	SkipComments bool            // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
 	Limit        int             // Limit the number of objects to read and return from the underlying stream.
 	Count        int             // The current count of the number of objects read.
+	NumberMode   string          // The mode for decoding numbers.  See the number package for the supported modes.
+	Line         int             // The current line number.
+	Strict       bool            // Reject lines with duplicate keys, unknown fields, trailing data, or invalid UTF-8.
+	Limits       limits.Limits   // The resource limits for each line.
 }
 
 // NewIteratorInput provides the input parameters for the NewIterator function.
 type NewIteratorInput struct {
-	Reader            io.Reader
-	Type              reflect.Type // the type to unmarshal for each line
-	ScannerBufferSize int          // the initial buffer size for the scanner
-	SkipLines         int          // Skip a given number of lines at the beginning of the stream.
-	SkipBlanks        bool         // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
-	SkipComments      bool         // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
-	Comment           string       // The comment line prefix. Can be any string.
-	Trim              bool         // Trim each input line before parsing into an object.
-	Limit             int          // Limit the number of objects to read and return from the underlying stream.
-	LineSeparator     byte         // The new line byte.
-	DropCR            bool         // Drop carriage returns at the end of lines.
+	Reader              io.Reader
+	Type                reflect.Type   // the type to unmarshal for each line
+	ScannerBufferSize   int            // the initial buffer size for the scanner
+	SkipLines           int            // Skip a given number of lines at the beginning of the stream.
+	SkipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Comment             string         // The comment line prefix. Can be any string.
+	Trim                bool           // Trim each input line before parsing into an object.
+	Limit               int            // Limit the number of objects to read and return from the underlying stream.
+	LineSeparator       string         // The line separator, which can be any sequence of bytes, e.g., "\n" or "\r\n".
+	LineSeparatorRegexp *regexp.Regexp // If not nil, split lines on matches of the regular expression rather than the line separator.
+	DropCR              bool           // Drop carriage returns at the end of lines.
+	NumberMode          string         // The mode for decoding numbers.  See the number package for the supported modes.
+	Strict              bool           // Reject lines with duplicate keys, unknown fields, trailing data, or invalid UTF-8.
+	Limits              limits.Limits  // The resource limits for each line.  MaxTotalBytes and MaxAliases are not used.
 }
 
 // NewIterator returns a new JSON Lines (aka jsonl) Iterator base on the given input.
 func NewIterator(input *NewIteratorInput) *Iterator {
 
-	s := scanner.New(input.Reader, input.LineSeparator, input.DropCR)
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
 
 	if input.ScannerBufferSize > 0 {
-		s.Buffer(make([]byte, 0, input.ScannerBufferSize), bufio.MaxScanTokenSize)
+		// The buffer must fit a line of the maximum size, a carriage return, and the line separator.
+		max := bufio.MaxScanTokenSize
+		if input.Limits.MaxRecordBytes+2 > max {
+			max = input.Limits.MaxRecordBytes + 2
+		}
+		s.Buffer(make([]byte, 0, input.ScannerBufferSize), max)
 	}
 
+	line := 0
 	for i := 0; i < input.SkipLines; i++ {
 		if !s.Scan() {
 			break
 		}
+		line++
 	}
 
 	return &Iterator{
@@ -72,6 +94,10 @@ func NewIterator(input *NewIteratorInput) *Iterator {
 		SkipComments: input.SkipComments,
 		Limit:        input.Limit,
 		Count:        0,
+		NumberMode:   input.NumberMode,
+		Line:         line,
+		Strict:       input.Strict,
+		Limits:       input.Limits,
 	}
 }
 
@@ -90,6 +116,7 @@ func (it *Iterator) Next() (interface{}, error) {
 	it.Count++
 
 	if it.Scanner.Scan() {
+		it.Line++
 		line := it.Scanner.Bytes()
 		if it.Trim {
 			line = bytes.TrimSpace(line)
@@ -106,18 +133,30 @@ func (it *Iterator) Next() (interface{}, error) {
 			}
 			return nil, nil
 		}
+		if err := json.CheckLimits(line, it.Limits); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of JSON object on line %d", it.Line)
+		}
+		if it.Strict {
+			err := json.Validate(line, it.Type)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error validating JSON object on line %d", it.Line)
+			}
+		}
 		if it.Type != nil {
-			obj, err := json.UnmarshalType(line, it.Type)
+			obj, err := json.UnmarshalTypeNumber(line, it.Type, it.NumberMode)
 			if err != nil {
 				return obj, errors.Wrap(err, "error unmarshaling next JSON object")
 			}
 			return obj, nil
 		}
-		obj, err := json.Unmarshal(line)
+		obj, err := json.UnmarshalNumber(line, it.NumberMode)
 		if err != nil {
 			return obj, errors.Wrap(err, "error unmarshaling next JSON object")
 		}
 		return obj, nil
 	}
+	if err := it.Scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", it.Line+1)
+	}
 	return nil, io.EOF
 }
@@ -12,7 +12,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 func TestIterator(t *testing.T) {
@@ -33,7 +37,7 @@ func TestIterator(t *testing.T) {
 		Trim:          true,
 		SkipBlanks:    false,
 		SkipComments:  false,
-		LineSeparator: []byte("\n")[0],
+		LineSeparator: "\n",
 		DropCR:        true,
 	})
 
@@ -106,7 +110,7 @@ func TestIteratorComment(t *testing.T) {
 		Comment:       "#",
 		Trim:          true,
 		SkipBlanks:    false,
-		LineSeparator: []byte("\n")[0],
+		LineSeparator: "\n",
 		DropCR:        true,
 	})
 
@@ -148,7 +152,7 @@ func TestIteratorEmpty(t *testing.T) {
 		Comment:       "#",
 		Trim:          true,
 		SkipBlanks:    false,
-		LineSeparator: []byte("\n")[0],
+		LineSeparator: "\n",
 		DropCR:        true,
 	})
 
@@ -165,7 +169,7 @@ func TestIteratorBlanks(t *testing.T) {
 		Comment:       "#",
 		Trim:          true,
 		SkipBlanks:    true,
-		LineSeparator: []byte("\n")[0],
+		LineSeparator: "\n",
 		DropCR:        true,
 	})
 
@@ -174,3 +178,98 @@ func TestIteratorBlanks(t *testing.T) {
 	assert.Equal(t, io.EOF, err)
 	assert.Nil(t, obj)
 }
+
+func TestIteratorNumberMode(t *testing.T) {
+	text := "{\"id\": 9007199254740993}\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		SkipBlanks:    true,
+		LineSeparator: "\n",
+		NumberMode:    "int64",
+	})
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": int64(9007199254740993)}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorStrict(t *testing.T) {
+	text := "{\"a\": 1}\n{\"a\": 1, \"a\": 2}\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		SkipBlanks:    true,
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": 1.0}, obj)
+
+	obj, err = it.Next()
+	assert.IsType(t, &json.ErrDuplicateKey{}, errors.Cause(err))
+	assert.Contains(t, err.Error(), "line 2")
+	assert.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	text := "{\"a\": 1}\n{\"a\": [[1]]}\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		SkipBlanks:    true,
+		LineSeparator: "\n",
+		Limits:        limits.Limits{MaxDepth: 2},
+	})
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": 1.0}, obj)
+
+	obj, err = it.Next()
+	assert.IsType(t, &limits.ErrMaxDepth{}, errors.Cause(err))
+	assert.Nil(t, obj)
+
+	it = NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		SkipBlanks:    true,
+		LineSeparator: "\n",
+		Limits:        limits.Limits{MaxRecordBytes: 8},
+	})
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": 1.0}, obj)
+
+	obj, err = it.Next()
+	assert.IsType(t, &limits.ErrMaxRecordBytes{}, errors.Cause(err))
+	assert.Nil(t, obj)
+}
+
+func TestIteratorMultiByteLineSeparator(t *testing.T) {
+	text := "{\n  \"a\": \"x\"\n}\x1e\n{\"b\": 2}\x1e\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		SkipBlanks:    true,
+		LineSeparator: "\x1e\n",
+	})
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "x"}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"b": 2.0}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
@@ -10,23 +10,29 @@ package jsonl
 import (
 	"io"
 	"reflect"
+	"regexp"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // ReadInput provides the input for the Read function.
 type ReadInput struct {
-	Type              reflect.Type // the output type
-	Reader            io.Reader    // the underlying reader
-	ScannerBufferSize int          // the initial buffer size of the scanner
-	SkipLines         int
-	SkipBlanks        bool
-	SkipComments      bool
-	Comment           string // the comment prefix
-	Trim              bool   // trim lines
-	LineSeparator     byte   // the newline byte
-	DropCR            bool   // drop carriage return
-	Limit             int
+	Type                reflect.Type // the output type
+	Reader              io.Reader    // the underlying reader
+	ScannerBufferSize   int          // the initial buffer size of the scanner
+	SkipLines           int
+	SkipBlanks          bool
+	SkipComments        bool
+	Comment             string         // the comment prefix
+	Trim                bool           // trim lines
+	LineSeparator       string         // the line separator, which can be any sequence of bytes
+	LineSeparatorRegexp *regexp.Regexp // if not nil, split lines on matches of the regular expression
+	DropCR              bool           // drop carriage return
+	Limit               int
+	NumberMode          string        // the mode for decoding numbers
+	Strict              bool          // reject duplicate keys, unknown fields, trailing data, and invalid UTF-8
+	Limits              limits.Limits // the resource limits for each line
 }
 
 // Read reads the json lines from the input reader of the type given.
@@ -43,17 +49,21 @@ func Read(input *ReadInput) (interface{}, error) {
 	}
 
 	it := NewIterator(&NewIteratorInput{
-		Type:              inputType,
-		Reader:            input.Reader,
-		ScannerBufferSize: input.ScannerBufferSize,
-		SkipLines:         input.SkipLines,
-		SkipBlanks:        input.SkipBlanks,
-		SkipComments:      input.SkipComments,
-		Comment:           input.Comment,
-		Trim:              input.Trim,
-		Limit:             input.Limit,
-		LineSeparator:     input.LineSeparator,
-		DropCR:            input.DropCR,
+		Type:                inputType,
+		Reader:              input.Reader,
+		ScannerBufferSize:   input.ScannerBufferSize,
+		SkipLines:           input.SkipLines,
+		SkipBlanks:          input.SkipBlanks,
+		SkipComments:        input.SkipComments,
+		Comment:             input.Comment,
+		Trim:                input.Trim,
+		Limit:               input.Limit,
+		LineSeparator:       input.LineSeparator,
+		LineSeparatorRegexp: input.LineSeparatorRegexp,
+		DropCR:              input.DropCR,
+		NumberMode:          input.NumberMode,
+		Strict:              input.Strict,
+		Limits:              input.Limits,
 	})
 
 	output := reflect.MakeSlice(outputType, 0, 0).Interface()
@@ -42,7 +42,7 @@ func TestRead(t *testing.T) {
 		SkipLines:     0,
 		SkipBlanks:    true,
 		SkipComments:  false,
-		LineSeparator: []byte("\n")[0],
+		LineSeparator: "\n",
 		DropCR:        true,
 		Comment:       "",
 		Trim:          true,
@@ -80,7 +80,7 @@ func TestReadBlanks(t *testing.T) {
 		SkipLines:     0,
 		SkipBlanks:    false,
 		SkipComments:  false,
-		LineSeparator: []byte("\n")[0],
+		LineSeparator: "\n",
 		DropCR:        true,
 		Comment:       "",
 		Trim:          true,
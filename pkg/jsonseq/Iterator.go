@@ -0,0 +1,145 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package jsonseq
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
+)
+
+// Iterator iterates trough a JSON text sequence (RFC 7464)
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type       reflect.Type    // the type to unmarshal for each record
+	Scanner    scanner.Scanner // the scanner that splits the underlying stream of bytes on record separators
+	Limit      int             // Limit the number of objects to read and return from the underlying stream.
+	Count      int             // The current count of the number of objects read.
+	Record     int             // The current record number.
+	Skipped    int             // The number of truncated or malformed records skipped.
+	NumberMode string          // The mode for decoding numbers.  See the number package for the supported modes.
+	Strict     bool            // Reject truncated or malformed records, and records with duplicate keys, unknown fields, or invalid UTF-8.
+	Limits     limits.Limits   // The resource limits for each record.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader            io.Reader
+	Type              reflect.Type  // the type to unmarshal for each record
+	ScannerBufferSize int           // the initial buffer size for the scanner
+	Limit             int           // Limit the number of objects to read and return from the underlying stream.
+	NumberMode        string        // The mode for decoding numbers.  See the number package for the supported modes.
+	Strict            bool          // Reject truncated or malformed records, and records with duplicate keys, unknown fields, or invalid UTF-8.
+	Limits            limits.Limits // The resource limits for each record.  MaxTotalBytes and MaxAliases are not used.
+}
+
+// NewIterator returns a new JSON text sequence Iterator base on the given input.
+func NewIterator(input *NewIteratorInput) *Iterator {
+
+	s := scanner.NewWithSplitFunc(input.Reader, splitter.ScanSeparator([]byte{RecordSeparator}, false), input.Limits.MaxRecordBytes)
+
+	if input.ScannerBufferSize > 0 {
+		// The buffer must fit a record of the maximum size and the record separator.
+		max := bufio.MaxScanTokenSize
+		if input.Limits.MaxRecordBytes+1 > max {
+			max = input.Limits.MaxRecordBytes + 1
+		}
+		s.Buffer(make([]byte, 0, input.ScannerBufferSize), max)
+	}
+
+	return &Iterator{
+		Type:       input.Type,
+		Scanner:    s,
+		Limit:      input.Limit,
+		Count:      0,
+		Record:     0,
+		Skipped:    0,
+		NumberMode: input.NumberMode,
+		Strict:     input.Strict,
+		Limits:     input.Limits,
+	}
+}
+
+// truncated returns true if the record is a top-level number, true, false, or null that is not followed by whitespace,
+// since then the record may have been truncated.  Truncated objects, arrays, and strings fail to parse.
+func truncated(record []byte) bool {
+	text := bytes.TrimLeft(record, " \t\r\n")
+	switch text[0] {
+	case '{', '[', '"':
+		return false
+	}
+	switch record[len(record)-1] {
+	case ' ', '\t', '\r', '\n':
+		return false
+	}
+	return true
+}
+
+// unmarshal parses the record into an object.
+func (it *Iterator) unmarshal(record []byte) (interface{}, error) {
+	if truncated(record) {
+		return nil, ErrTruncatedRecord
+	}
+	if it.Strict {
+		err := json.Validate(record, it.Type)
+		if err != nil {
+			return nil, errors.Wrap(err, "error validating JSON text")
+		}
+	}
+	if it.Type != nil {
+		return json.UnmarshalTypeNumber(record, it.Type, it.NumberMode)
+	}
+	return json.UnmarshalNumber(record, it.NumberMode)
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// Truncated and malformed records are skipped, unless Strict is true.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	for it.Scanner.Scan() {
+		record := it.Scanner.Bytes()
+		// Skip empty records, e.g., before the first record separator or between consecutive record separators.
+		if len(bytes.TrimSpace(record)) == 0 {
+			continue
+		}
+		it.Record++
+		if err := json.CheckLimits(record, it.Limits); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of JSON text in record %d", it.Record)
+		}
+		obj, err := it.unmarshal(record)
+		if err != nil {
+			if it.Strict {
+				return nil, errors.Wrapf(err, "error parsing record %d", it.Record)
+			}
+			it.Skipped++
+			continue
+		}
+		// Increment Counter
+		it.Count++
+		return obj, nil
+	}
+	if err := it.Scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning record %d", it.Record+1)
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,119 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package jsonseq
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestIterator(t *testing.T) {
+	in := "\x1e{\"a\":\"x\"}\n\x1e{\n  \"b\": \"y\"\n}\n\x1e\x1e[1,2]\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Limit:  -1,
+	})
+
+	for _, expected := range []interface{}{
+		map[string]interface{}{"a": "x"},
+		map[string]interface{}{"b": "y"},
+		[]interface{}{1.0, 2.0},
+	} {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err := it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorType(t *testing.T) {
+	in := "\x1e{\"a\":\"x\"}\n\x1e{\"b\":\"y\"}\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Type:   reflect.TypeOf(map[string]string{}),
+		Limit:  1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "x"}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorTruncated(t *testing.T) {
+	// The second record is a truncated object, and the fourth record is a number that may have been truncated.
+	in := "\x1e{\"a\":\"x\"}\n\x1e{\"b\":\x1e123\n\x1e456"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Limit:  -1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "x"}, obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, 123.0, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+
+	assert.Equal(t, 2, it.Skipped)
+}
+
+func TestIteratorTruncatedStrict(t *testing.T) {
+	in := "\x1e{\"a\":\"x\"}\n\x1e456"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Limit:  -1,
+		Strict: true,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "x"}, obj)
+
+	obj, err = it.Next()
+	require.Error(t, err)
+	assert.Equal(t, "error parsing record 2: truncated record", err.Error())
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	in := "\x1e{\"a\":{\"b\":{\"c\":1}}}\n"
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Limit:  -1,
+		Limits: limits.Limits{MaxDepth: 2},
+	})
+
+	obj, err := it.Next()
+	require.Error(t, err)
+	require.Nil(t, obj)
+}
@@ -0,0 +1,58 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package jsonseq
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input for the Read function.
+type ReadInput struct {
+	Type              reflect.Type // the output type.  If nil, then returns a slice of type []interface{}.
+	Reader            io.Reader    // the underlying reader
+	ScannerBufferSize int          // the initial buffer size of the scanner
+	Limit             int
+	NumberMode        string        // the mode for decoding numbers
+	Strict            bool          // reject truncated or malformed records, duplicate keys, unknown fields, and invalid UTF-8
+	Limits            limits.Limits // the resource limits for each record
+}
+
+// Read reads the records of a JSON text sequence (RFC 7464) from the input reader into a slice of the type given.
+func Read(input *ReadInput) (interface{}, error) {
+
+	var inputType reflect.Type
+	if input.Type != nil {
+		inputType = input.Type.Elem()
+	}
+
+	outputType := reflect.TypeOf([]interface{}{})
+	if input.Type != nil {
+		outputType = input.Type
+	}
+
+	it := NewIterator(&NewIteratorInput{
+		Type:              inputType,
+		Reader:            input.Reader,
+		ScannerBufferSize: input.ScannerBufferSize,
+		Limit:             input.Limit,
+		NumberMode:        input.NumberMode,
+		Strict:            input.Strict,
+		Limits:            input.Limits,
+	})
+
+	output := reflect.MakeSlice(outputType, 0, 0).Interface()
+
+	w := pipe.NewSliceWriterWithValues(output)
+
+	err := pipe.NewBuilder().Input(it).Output(w).Run()
+	return w.Values(), err
+}
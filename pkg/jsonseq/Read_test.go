@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package jsonseq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	in := "\x1e{\"a\":\"1\"}\n\x1e{\"a\":\"2\"}\n\x1e{\"a\":\"3\"}\n"
+
+	out, err := Read(&ReadInput{
+		Type:   reflect.TypeOf([]map[string]string{}),
+		Reader: strings.NewReader(in),
+		Limit:  2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"a": "1"}, {"a": "2"}}, out)
+}
@@ -0,0 +1,58 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package jsonseq
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWrite(t *testing.T) {
+	in := []map[string]interface{}{
+		map[string]interface{}{
+			"a": "1",
+			"b": "2",
+		},
+		map[string]interface{}{
+			"a": "3",
+			"b": "4",
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		KeySerializer: stringify.NewStringer("", false, false, false),
+		Object:        in,
+		Pretty:        false,
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "\x1e{\"a\":\"1\",\"b\":\"2\"}\n\x1e{\"a\":\"3\",\"b\":\"4\"}\n", buf.String())
+}
+
+func TestWritePretty(t *testing.T) {
+	in := map[string]interface{}{
+		"a": "1",
+	}
+
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:        buf,
+		KeySerializer: stringify.NewStringer("", false, false, false),
+		Object:        in,
+		Pretty:        true,
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "\x1e{\n  \"a\": \"1\"\n}\n", buf.String())
+}
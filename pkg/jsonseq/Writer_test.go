@@ -0,0 +1,49 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package jsonseq
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriter(t *testing.T) {
+	objects := []interface{}{
+		map[string]interface{}{"a": "x"},
+		[]interface{}{"b", "y"},
+		"c",
+		1.0,
+	}
+
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, stringify.NewStringer("", false, false, false), false)
+	for _, obj := range objects {
+		require.NoError(t, w.WriteObject(obj))
+	}
+	require.NoError(t, w.Flush())
+	require.Equal(t, "\x1e{\"a\":\"x\"}\n\x1e[\"b\",\"y\"]\n\x1e\"c\"\n\x1e1\n", buf.String())
+
+	// Should read back the same objects, including the top-level number that is followed by a line feed.
+	it := NewIterator(&NewIteratorInput{
+		Reader: buf,
+		Limit:  -1,
+	})
+	for _, expected := range objects {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, obj)
+	}
+	obj, err := it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
@@ -0,0 +1,33 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package jsonseq provides a simple API for reading and writing JSON text sequences (RFC 7464), aka application/json-seq.
+// Each JSON text in a sequence is preceded by an ASCII record separator (0x1E) and followed by a line feed (0x0A).
+// Unlike JSON Lines, the JSON texts can contain new lines, e.g., when pretty printed.
+// jsonseq also supports iterators and writers for efficiently processing a stream.
+// jsonseq uses the github.com/spatialcurrent/go-simple-serializer/pkg/json for marshaling/unmarshaling JSON.
+//
+// As required by RFC 7464, truncated and malformed JSON texts are skipped when reading, unless in strict mode.
+// A top-level number, true, false, or null that is not followed by whitespace is considered truncated.
+// See the examples below for usage.
+//
+//  - https://tools.ietf.org/html/rfc7464
+//  - https://godoc.org/pkg/github.com/spatialcurrent/go-simple-serializer/pkg/json
+package jsonseq
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	RecordSeparator = 0x1E // the ASCII record separator that precedes each JSON text
+	LineFeed        = 0x0A // the line feed that follows each JSON text
+)
+
+var (
+	ErrTruncatedRecord = errors.New("truncated record")
+)
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"fmt"
+)
+
+// ErrMaxAliases is used when a YAML document expands more than the maximum number of aliases.
+type ErrMaxAliases struct {
+	Max int // the limit that was exceeded
+}
+
+// Error returns the error formatted as a string.
+func (e ErrMaxAliases) Error() string {
+	return fmt.Sprintf("document exceeds the maximum of %d alias expansions", e.Max)
+}
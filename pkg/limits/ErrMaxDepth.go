@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"fmt"
+)
+
+// ErrMaxDepth is used when maps and slices are nested deeper than the maximum depth.
+type ErrMaxDepth struct {
+	Max int // the limit that was exceeded
+}
+
+// Error returns the error formatted as a string.
+func (e ErrMaxDepth) Error() string {
+	return fmt.Sprintf("input exceeds the maximum nesting depth of %d", e.Max)
+}
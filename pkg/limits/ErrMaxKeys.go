@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"fmt"
+)
+
+// ErrMaxKeys is used when a map has more than the maximum number of keys.
+type ErrMaxKeys struct {
+	Max int // the limit that was exceeded
+}
+
+// Error returns the error formatted as a string.
+func (e ErrMaxKeys) Error() string {
+	return fmt.Sprintf("map exceeds the maximum of %d keys", e.Max)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"fmt"
+)
+
+// ErrMaxRecordBytes is used when a line or document is larger than the maximum number of bytes.
+type ErrMaxRecordBytes struct {
+	Max int // the limit that was exceeded
+}
+
+// Error returns the error formatted as a string.
+func (e ErrMaxRecordBytes) Error() string {
+	return fmt.Sprintf("record exceeds the maximum size of %d bytes", e.Max)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"fmt"
+)
+
+// ErrMaxTotalBytes is used when the input is larger than the maximum number of bytes.
+type ErrMaxTotalBytes struct {
+	Max int // the limit that was exceeded
+}
+
+// Error returns the error formatted as a string.
+func (e ErrMaxTotalBytes) Error() string {
+	return fmt.Sprintf("input exceeds the maximum size of %d bytes", e.Max)
+}
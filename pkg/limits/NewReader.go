@@ -0,0 +1,43 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"io"
+)
+
+type reader struct {
+	reader io.Reader
+	max    int
+	count  int
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if r.count > r.max {
+		return 0, &ErrMaxTotalBytes{Max: r.max}
+	}
+	// Read at most one byte past the limit, so that input of exactly max bytes is allowed.
+	if len(p) > r.max-r.count+1 {
+		p = p[:r.max-r.count+1]
+	}
+	n, err := r.reader.Read(p)
+	r.count += n
+	if r.count > r.max {
+		return n - (r.count - r.max), &ErrMaxTotalBytes{Max: r.max}
+	}
+	return n, err
+}
+
+// NewReader returns a reader that returns ErrMaxTotalBytes after more than max bytes are read from the underlying reader.
+// If max is zero or less, returns the underlying reader.
+func NewReader(r io.Reader, max int) io.Reader {
+	if max <= 0 {
+		return r
+	}
+	return &reader{reader: r, max: max, count: 0}
+}
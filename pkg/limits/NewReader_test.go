@@ -0,0 +1,26 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReader(t *testing.T) {
+	b, err := ioutil.ReadAll(NewReader(strings.NewReader("hello"), 5))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+
+	b, err = ioutil.ReadAll(NewReader(strings.NewReader("hello world"), 5))
+	assert.Equal(t, &ErrMaxTotalBytes{Max: 5}, err)
+	assert.Equal(t, "hello", string(b))
+}
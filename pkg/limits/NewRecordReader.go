@@ -0,0 +1,45 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"io"
+)
+
+type recordReader struct {
+	reader    io.Reader
+	separator byte
+	max       int
+	count     int // the number of bytes since the last separator
+}
+
+func (r *recordReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	for i := 0; i < n; i++ {
+		if p[i] == r.separator {
+			r.count = 0
+			continue
+		}
+		r.count++
+		// A carriage return directly before the separator is not counted.
+		if r.count > r.max && !(r.count == r.max+1 && p[i] == '\r') {
+			return i, &ErrMaxRecordBytes{Max: r.max}
+		}
+	}
+	return n, err
+}
+
+// NewRecordReader returns a reader that returns ErrMaxRecordBytes when a record
+// between separators is longer than max bytes, before the record is buffered.
+// If max is zero or less, returns the underlying reader.
+func NewRecordReader(r io.Reader, separator byte, max int) io.Reader {
+	if max <= 0 {
+		return r
+	}
+	return &recordReader{reader: r, separator: separator, max: max, count: 0}
+}
@@ -0,0 +1,26 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRecordReader(t *testing.T) {
+	b, err := ioutil.ReadAll(NewRecordReader(strings.NewReader("abc\r\ndef\nghi"), '\n', 3))
+	assert.NoError(t, err)
+	assert.Equal(t, "abc\r\ndef\nghi", string(b))
+
+	b, err = ioutil.ReadAll(NewRecordReader(strings.NewReader("abc\ndefg\n"), '\n', 3))
+	assert.Equal(t, &ErrMaxRecordBytes{Max: 3}, err)
+	assert.Equal(t, "abc\ndef", string(b))
+}
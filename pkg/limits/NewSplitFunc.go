@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"bufio"
+	"bytes"
+)
+
+// NewSplitFunc returns a bufio.SplitFunc that wraps the given split function
+// and returns ErrMaxRecordBytes when a token is longer than max bytes, before the token is fully buffered.
+// A carriage return at the end of a token is not counted.
+// If max is zero or less, returns the given split function.
+func NewSplitFunc(split bufio.SplitFunc, max int) bufio.SplitFunc {
+	if max <= 0 {
+		return split
+	}
+	return bufio.SplitFunc(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := split(data, atEOF)
+		if err != nil {
+			return advance, token, err
+		}
+		if token != nil {
+			if len(bytes.TrimSuffix(token, []byte("\r"))) > max {
+				return 0, nil, &ErrMaxRecordBytes{Max: max}
+			}
+			return advance, token, nil
+		}
+		// The split function requested more data, so the buffered data is a partial token.
+		if advance == 0 && len(data) > max+1 {
+			return 0, nil, &ErrMaxRecordBytes{Max: max}
+		}
+		return advance, token, nil
+	})
+}
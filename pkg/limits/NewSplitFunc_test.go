@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSplitFunc(t *testing.T) {
+	s := bufio.NewScanner(strings.NewReader("abc\r\ndef\nghij\nklm\n"))
+	s.Split(NewSplitFunc(bufio.ScanLines, 3))
+	lines := make([]string, 0)
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	assert.Equal(t, []string{"abc", "def"}, lines)
+	assert.Equal(t, &ErrMaxRecordBytes{Max: 3}, s.Err())
+}
@@ -0,0 +1,115 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package limits provides resource limits for decoding untrusted input.
+// A zero value for a limit means the limit is not enforced.
+//
+//	- MaxRecordBytes => the maximum size of a line or document
+//	- MaxDepth => the maximum nesting depth of maps and slices
+//	- MaxKeys => the maximum number of keys in a single map
+//	- MaxTotalBytes => the maximum number of bytes read from the input
+//	- MaxAliases => the maximum number of YAML alias expansions
+package limits
+
+import (
+	"reflect"
+)
+
+// Limits is the set of resource limits enforced when decoding input.
+// A zero value for a field means the limit is not enforced.
+type Limits struct {
+	MaxRecordBytes int // the maximum number of bytes in a record, e.g., a line of JSON Lines or a JSON document.
+	MaxDepth       int // the maximum nesting depth of maps and slices.  A scalar has a depth of 0.
+	MaxKeys        int // the maximum number of keys in a single map.
+	MaxTotalBytes  int // the maximum number of bytes read from the input.
+	MaxAliases     int // the maximum number of alias expansions in a YAML document.
+}
+
+// CheckRecordBytes returns an error if the given record size exceeds MaxRecordBytes.
+func (l Limits) CheckRecordBytes(n int) error {
+	if l.MaxRecordBytes > 0 && n > l.MaxRecordBytes {
+		return &ErrMaxRecordBytes{Max: l.MaxRecordBytes}
+	}
+	return nil
+}
+
+// CheckDepth returns an error if the given depth exceeds MaxDepth.
+func (l Limits) CheckDepth(depth int) error {
+	if l.MaxDepth > 0 && depth > l.MaxDepth {
+		return &ErrMaxDepth{Max: l.MaxDepth}
+	}
+	return nil
+}
+
+// CheckKeys returns an error if the given number of keys exceeds MaxKeys.
+func (l Limits) CheckKeys(n int) error {
+	if l.MaxKeys > 0 && n > l.MaxKeys {
+		return &ErrMaxKeys{Max: l.MaxKeys}
+	}
+	return nil
+}
+
+// CheckTotalBytes returns an error if the given input size exceeds MaxTotalBytes.
+func (l Limits) CheckTotalBytes(n int) error {
+	if l.MaxTotalBytes > 0 && n > l.MaxTotalBytes {
+		return &ErrMaxTotalBytes{Max: l.MaxTotalBytes}
+	}
+	return nil
+}
+
+// CheckAliases returns an error if the given number of alias expansions exceeds MaxAliases.
+func (l Limits) CheckAliases(n int) error {
+	if l.MaxAliases > 0 && n > l.MaxAliases {
+		return &ErrMaxAliases{Max: l.MaxAliases}
+	}
+	return nil
+}
+
+// Check walks a decoded object and returns an error if it exceeds MaxDepth or MaxKeys.
+// Check is used for formats that cannot be checked before decoding, e.g., BSON and TOML.
+// Structs are treated as scalars, since their depth is bounded by their type.
+func (l Limits) Check(obj interface{}) error {
+	if l.MaxDepth == 0 && l.MaxKeys == 0 {
+		return nil
+	}
+	return l.check(reflect.ValueOf(obj), 0)
+}
+
+func (l Limits) check(v reflect.Value, depth int) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return l.check(v.Elem(), depth)
+	case reflect.Map:
+		if err := l.CheckDepth(depth + 1); err != nil {
+			return err
+		}
+		if err := l.CheckKeys(v.Len()); err != nil {
+			return err
+		}
+		for _, k := range v.MapKeys() {
+			if err := l.check(v.MapIndex(k), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil // byte slices are scalars
+		}
+		if err := l.CheckDepth(depth + 1); err != nil {
+			return err
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := l.check(v.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
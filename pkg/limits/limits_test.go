@@ -0,0 +1,29 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package limits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	obj := map[string]interface{}{
+		"a": []interface{}{
+			map[string]interface{}{"b": "c"},
+		},
+		"d": []byte("e"),
+	}
+	assert.NoError(t, Limits{}.Check(obj))
+	assert.NoError(t, Limits{MaxDepth: 3, MaxKeys: 2}.Check(obj))
+	assert.Equal(t, &ErrMaxDepth{Max: 2}, Limits{MaxDepth: 2}.Check(obj))
+	assert.Equal(t, &ErrMaxKeys{Max: 1}, Limits{MaxKeys: 1}.Check(obj))
+	assert.NoError(t, Limits{MaxDepth: 1}.Check(map[string]string{"a": "b"}))
+	assert.NoError(t, Limits{MaxDepth: 1}.Check("a"))
+}
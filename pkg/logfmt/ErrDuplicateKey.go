@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"fmt"
+)
+
+// ErrDuplicateKey is used when a key is defined more than once on the same line.
+type ErrDuplicateKey struct {
+	Key string // the duplicate key
+}
+
+// Error returns the error formatted as a string.
+func (e ErrDuplicateKey) Error() string {
+	return fmt.Sprintf("duplicate key %q", e.Key)
+}
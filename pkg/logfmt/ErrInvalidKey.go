@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"fmt"
+)
+
+// ErrInvalidKey is used when a key cannot be written, since it is empty or contains a space, equal sign, double quote, or control character.
+type ErrInvalidKey struct {
+	Key string // the invalid key
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidKey) Error() string {
+	return fmt.Sprintf("invalid key %q", e.Key)
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"fmt"
+)
+
+// ErrSyntax is used when a line of logfmt cannot be parsed.
+type ErrSyntax struct {
+	Message  string // a description of the error
+	Position int    // the position of the byte in the line where the error occurred, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrSyntax) Error() string {
+	return fmt.Sprintf("logfmt syntax error at position %d: %s", e.Position, e.Message)
+}
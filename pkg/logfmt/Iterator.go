@@ -0,0 +1,143 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
+)
+
+// Iterator iterates trough a stream of bytes
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Scanner      scanner.Scanner // the scanner that splits the underlying stream of bytes
+	Type         reflect.Type    // the type to unmarshal for each line
+	Comment      string          // The comment line prefix.  Can be any string.
+	SkipBlanks   bool            // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments bool            // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Limit        int             // Limit the number of objects to read and return from the underlying stream.
+	Count        int             // The current count of the number of objects read.
+	Line         int             // The current line number.
+	Strict       bool            // Reject lines with duplicate keys or invalid UTF-8.
+	Limits       limits.Limits   // The resource limits for each line.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader              io.Reader
+	Type                reflect.Type   // the type to unmarshal for each line
+	SkipLines           int            // Skip a given number of lines at the beginning of the stream.
+	SkipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Comment             string         // The comment line prefix. Can be any string.
+	Limit               int            // Limit the number of objects to read and return from the underlying stream.
+	LineSeparator       string         // The line separator, which can be any sequence of bytes, e.g., "\n" or "\r\n".
+	LineSeparatorRegexp *regexp.Regexp // If not nil, split lines on matches of the regular expression rather than the line separator.
+	DropCR              bool           // Drop carriage returns at the end of lines.
+	Strict              bool           // Reject lines with duplicate keys or invalid UTF-8.
+	Limits              limits.Limits  // The resource limits for each line.  MaxTotalBytes and MaxAliases are not used.
+}
+
+// NewIterator returns a new logfmt Iterator base on the given input.
+func NewIterator(input *NewIteratorInput) *Iterator {
+
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
+	line := 0
+	for i := 0; i < input.SkipLines; i++ {
+		if !s.Scan() {
+			break
+		}
+		line++
+	}
+
+	return &Iterator{
+		Scanner:      s,
+		Type:         input.Type,
+		Comment:      input.Comment,
+		SkipBlanks:   input.SkipBlanks,
+		SkipComments: input.SkipComments,
+		Limit:        input.Limit,
+		Count:        0,
+		Line:         line,
+		Strict:       input.Strict,
+		Limits:       input.Limits,
+	}
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// If a blank line is found and SkipBlanks is false, then returns (nil, nil).
+// If a commented line is found and SkipComments is false, then returns (nil, nil).
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	// Increment Counter
+	it.Count++
+
+	if it.Scanner.Scan() {
+		it.Line++
+		line := strings.TrimSpace(it.Scanner.Text())
+		if len(line) == 0 {
+			if it.SkipBlanks {
+				return it.Next()
+			}
+			return nil, nil
+		}
+		if len(it.Comment) > 0 && strings.HasPrefix(line, it.Comment) {
+			if it.SkipComments {
+				return it.Next()
+			}
+			return nil, nil
+		}
+		if it.Strict {
+			err := Validate([]byte(line))
+			if err != nil {
+				return nil, errors.Wrapf(err, "error validating logfmt on line %d", it.Line)
+			}
+		}
+		if it.Type != nil {
+			obj, err := UnmarshalType([]byte(line), it.Type)
+			if err != nil {
+				return obj, errors.Wrapf(err, "error unmarshaling logfmt on line %d", it.Line)
+			}
+			if err := it.Limits.Check(obj); err != nil {
+				return nil, errors.Wrapf(err, "error checking limits of logfmt on line %d", it.Line)
+			}
+			return obj, nil
+		}
+		obj, err := Unmarshal([]byte(line))
+		if err != nil {
+			return obj, errors.Wrapf(err, "error unmarshaling logfmt on line %d", it.Line)
+		}
+		if err := it.Limits.Check(obj); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of logfmt on line %d", it.Line)
+		}
+		return obj, nil
+	}
+	if err := it.Scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", it.Line+1)
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,76 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterator(t *testing.T) {
+	text := `
+# comment
+level=info msg="hello world"
+level=debug ok
+`
+
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		Comment:       "#",
+		SkipBlanks:    true,
+		SkipComments:  true,
+		LineSeparator: "\n",
+	})
+	require.NotNil(t, it)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"level": "info", "msg": "hello world"}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"level": "debug", "ok": nil}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorType(t *testing.T) {
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader("a=1 b\n"),
+		Type:          reflect.TypeOf(map[string]string{}),
+		LineSeparator: "\n",
+	})
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": ""}, obj)
+}
+
+func TestIteratorStrict(t *testing.T) {
+	it := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader("a=1\na=1 a=2\n"),
+		LineSeparator: "\n",
+		Strict:        true,
+	})
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "1"}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, &ErrDuplicateKey{Key: "a"}, errors.Cause(err))
+	assert.Nil(t, obj)
+}
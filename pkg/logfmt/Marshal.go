@@ -0,0 +1,128 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/inspector"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// marshalPair writes a key-value pair to the buffer.
+// If the value is nil, then only writes the key.
+func marshalPair(out *bytes.Buffer, key interface{}, value interface{}, keySerializer stringify.Stringer, valueSerializer stringify.Stringer) error {
+	keyString, err := keySerializer(key)
+	if err != nil {
+		return errors.Wrap(err, "error serializing key")
+	}
+	if !isValidKey(keyString) {
+		return &ErrInvalidKey{Key: keyString}
+	}
+	if out.Len() > 0 {
+		out.WriteByte(' ')
+	}
+	out.WriteString(keyString)
+	if value == nil {
+		return nil
+	}
+	valueString, err := valueSerializer(value)
+	if err != nil {
+		return errors.Wrap(err, "error serializing value")
+	}
+	out.WriteByte('=')
+	out.WriteString(quote(valueString))
+	return nil
+}
+
+// Marshal formats an object into a line of logfmt.
+// The key and value serializers are used to render the key and value of each pair into strings.
+// Values are quoted if they contain a space, equal sign, double quote, or control character.
+// Nil values are written as a key without a value.
+// If keys is not empty, then writes the pairs in the order specifed by keys.
+// If expandKeys is true, then adds unknown keys to the end of the line.
+// If sorted and not reversed, then the keys are sorted in alphabetical order.
+// If sorted and reversed, then the keys are sorted in reverse alphabetical order.
+func Marshal(object interface{}, keys []interface{}, expandKeys bool, keySerializer stringify.Stringer, valueSerializer stringify.Stringer, sorted bool, reversed bool) ([]byte, error) {
+
+	if keySerializer == nil {
+		return make([]byte, 0), ErrMissingKeySerializer
+	}
+
+	if valueSerializer == nil {
+		return make([]byte, 0), ErrMissingValueSerializer
+	}
+
+	objectValue := reflect.ValueOf(object)
+	for objectValue.Kind() == reflect.Ptr || objectValue.Kind() == reflect.Interface {
+		objectValue = objectValue.Elem()
+	}
+
+	if !objectValue.IsValid() {
+		return make([]byte, 0), &ErrInvalidKind{Value: nil, Expected: []reflect.Kind{reflect.Map, reflect.Struct}}
+	}
+
+	out := &bytes.Buffer{}
+
+	switch objectValue.Kind() {
+	case reflect.Map:
+		allKeys := inspector.GetKeysFromValue(objectValue, sorted, reversed)
+		if len(keys) > 0 {
+			allKeys = keys
+			if expandKeys {
+				knownKeys := map[interface{}]struct{}{}
+				for _, k := range keys {
+					knownKeys[k] = struct{}{}
+				}
+				allKeys = append(append(make([]interface{}, 0, len(keys)), keys...), inspector.GetUnknownKeysFromValue(objectValue, knownKeys, sorted, reversed)...)
+			}
+		}
+		for _, key := range allKeys {
+			value := objectValue.MapIndex(reflect.ValueOf(key))
+			if !value.IsValid() {
+				continue
+			}
+			err := marshalPair(out, key, value.Interface(), keySerializer, valueSerializer)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, "error serializing pair")
+			}
+		}
+		return out.Bytes(), nil
+	case reflect.Struct:
+		fieldNames := inspector.GetFieldNamesFromValue(objectValue, sorted, reversed)
+		if len(keys) > 0 {
+			fieldNames = make([]string, 0, len(keys))
+			knownFieldNames := map[string]struct{}{}
+			for _, k := range keys {
+				if str, ok := k.(string); ok {
+					fieldNames = append(fieldNames, str)
+					knownFieldNames[str] = struct{}{}
+				}
+			}
+			if expandKeys {
+				fieldNames = append(fieldNames, inspector.GetUnknownFieldNamesFromValue(objectValue, knownFieldNames, sorted, reversed)...)
+			}
+		}
+		for _, fieldName := range fieldNames {
+			value := objectValue.FieldByName(fieldName)
+			if !value.IsValid() || !value.CanInterface() {
+				continue
+			}
+			err := marshalPair(out, fieldName, value.Interface(), keySerializer, valueSerializer)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, "error serializing pair")
+			}
+		}
+		return out.Bytes(), nil
+	}
+
+	return make([]byte, 0), &ErrInvalidKind{Value: objectValue.Type(), Expected: []reflect.Kind{reflect.Map, reflect.Struct}}
+}
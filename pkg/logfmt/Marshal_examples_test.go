@@ -0,0 +1,31 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"fmt"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// This examples shows that you can marshal a map into a line of logfmt.
+func ExampleMarshal() {
+	obj := map[string]interface{}{
+		"level": "info",
+		"msg":   "hello world",
+		"ok":    nil,
+	}
+	keySerializer := stringify.NewStringer("", false, false, false)
+	valueSerializer := stringify.NewStringer("", false, false, false)
+	b, err := Marshal(obj, nil, false, keySerializer, valueSerializer, true, false)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(b))
+	// Output: level=info msg="hello world" ok
+}
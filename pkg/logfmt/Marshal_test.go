@@ -0,0 +1,66 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestMarshal(t *testing.T) {
+	obj := map[string]interface{}{
+		"level": "info",
+		"msg":   "hello \"wide\" world\n",
+		"empty": "",
+		"ok":    nil,
+		"n":     1,
+		"eq":    "a=b",
+		"ctrl":  "\x01",
+	}
+	keySerializer := stringify.NewStringer("", false, false, false)
+	valueSerializer := stringify.NewStringer("", false, false, false)
+	b, err := Marshal(obj, []interface{}{"level", "msg"}, true, keySerializer, valueSerializer, true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, `level=info msg="hello \"wide\" world\n" ctrl="\u0001" empty= eq="a=b" n=1 ok`, string(b))
+
+	out, err := Unmarshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"level": "info",
+		"msg":   "hello \"wide\" world\n",
+		"empty": "",
+		"ok":    nil,
+		"n":     "1",
+		"eq":    "a=b",
+		"ctrl":  "\x01",
+	}, out)
+}
+
+func TestMarshalStruct(t *testing.T) {
+	obj := struct {
+		A string
+		B int
+		c string
+	}{A: "x y", B: 2}
+	keySerializer := stringify.NewStringer("", false, false, false)
+	valueSerializer := stringify.NewStringer("", false, false, false)
+	b, err := Marshal(&obj, nil, false, keySerializer, valueSerializer, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `B=2 A="x y"`, string(b))
+}
+
+func TestMarshalInvalidKey(t *testing.T) {
+	keySerializer := stringify.NewStringer("", false, false, false)
+	valueSerializer := stringify.NewStringer("", false, false, false)
+	_, err := Marshal(map[string]interface{}{"a b": 1}, nil, false, keySerializer, valueSerializer, false, false)
+	assert.Equal(t, &ErrInvalidKey{Key: "a b"}, errors.Cause(err))
+}
@@ -0,0 +1,65 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input for the Read function.
+type ReadInput struct {
+	Type                reflect.Type // the output type
+	Reader              io.Reader    // the underlying reader
+	SkipLines           int
+	SkipBlanks          bool
+	SkipComments        bool
+	Comment             string         // the comment prefix
+	LineSeparator       string         // the line separator, which can be any sequence of bytes
+	LineSeparatorRegexp *regexp.Regexp // if not nil, split lines on matches of the regular expression
+	DropCR              bool           // drop carriage return
+	Limit               int
+	Strict              bool          // reject lines with duplicate keys or invalid UTF-8
+	Limits              limits.Limits // the resource limits for each line
+}
+
+// Read reads the lines of logfmt from the input Reader into the given type.
+// If no type is given, returns a slice of type []map[string]interface{}.
+func Read(input *ReadInput) (interface{}, error) {
+	inputType := reflect.TypeOf([]map[string]interface{}{})
+	if input.Type != nil {
+		inputType = input.Type
+	}
+	it := NewIterator(&NewIteratorInput{
+		Reader:              input.Reader,
+		Type:                inputType.Elem(),
+		SkipLines:           input.SkipLines,
+		SkipBlanks:          input.SkipBlanks,
+		SkipComments:        input.SkipComments,
+		Comment:             input.Comment,
+		Limit:               input.Limit,
+		LineSeparator:       input.LineSeparator,
+		LineSeparatorRegexp: input.LineSeparatorRegexp,
+		DropCR:              input.DropCR,
+		Strict:              input.Strict,
+		Limits:              input.Limits,
+	})
+	output := reflect.MakeSlice(inputType, 0, 0).Interface()
+	w := pipe.NewSliceWriterWithValues(output)
+	err := pipe.NewBuilder().Input(it).Output(w).Run()
+	if err != nil {
+		return w.Values(), errors.Wrap(err, "error reading logfmt")
+	}
+	return w.Values(), nil
+}
@@ -0,0 +1,29 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Reader:        strings.NewReader("a=1 b=\"x y\"\n\nc\n"),
+		LineSeparator: "\n",
+		SkipBlanks:    true,
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"a": "1", "b": "x y"},
+		map[string]interface{}{"c": nil},
+	}, out)
+}
@@ -0,0 +1,32 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+// Unmarshal parses a line of logfmt into a map of type map[string]interface{}.
+// Keys without values are returned as nil and all other values are returned as strings.
+// If no input is given, then returns ErrEmptyInput.
+func Unmarshal(b []byte) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	obj := map[string]interface{}{}
+	err := parse(b, func(key string, value string, hasValue bool) error {
+		if hasValue {
+			obj[key] = value
+		} else {
+			obj[key] = nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,50 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"reflect"
+)
+
+// UnmarshalType parses a line of logfmt into a map of the given type.
+// Keys without values are set to the zero value of the map's element type.
+// If no input is given, then returns ErrEmptyInput.
+func UnmarshalType(b []byte, outputType reflect.Type) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	// If the kind of the output type is interface{}, then simply use Unmarshal.
+	if outputType.Kind() == reflect.Interface {
+		return Unmarshal(b)
+	}
+
+	if outputType.Kind() != reflect.Map {
+		return nil, &ErrInvalidKind{Value: outputType, Expected: []reflect.Kind{reflect.Map}}
+	}
+
+	elem := outputType.Elem()
+	if elem.Kind() != reflect.String && elem.Kind() != reflect.Interface {
+		return nil, &ErrInvalidKind{Value: elem, Expected: []reflect.Kind{reflect.String, reflect.Interface}}
+	}
+
+	m := reflect.MakeMap(outputType)
+	err := parse(b, func(key string, value string, hasValue bool) error {
+		if hasValue {
+			m.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value).Convert(elem))
+		} else {
+			m.SetMapIndex(reflect.ValueOf(key), reflect.Zero(elem))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.Interface(), nil
+}
@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalType(t *testing.T) {
+	obj, err := UnmarshalType([]byte(`a=1 b="x y" c`), reflect.TypeOf(map[string]string{}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "x y", "c": ""}, obj)
+}
+
+func TestUnmarshalTypeInvalidKind(t *testing.T) {
+	_, err := UnmarshalType([]byte(`a=1`), reflect.TypeOf(map[string]int{}))
+	assert.IsType(t, &ErrInvalidKind{}, err)
+	_, err = UnmarshalType([]byte(`a=1`), reflect.TypeOf(""))
+	assert.IsType(t, &ErrInvalidKind{}, err)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"fmt"
+)
+
+// This examples shows that you can unmarshal a line of logfmt into a map.
+func ExampleUnmarshal() {
+	obj, err := Unmarshal([]byte(`level=info msg="hello world" ok`))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: map[level:info msg:hello world ok:<nil>]
+}
@@ -0,0 +1,49 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshal(t *testing.T) {
+	in := `level=info msg="hello \"wide\" world\n" path=/a/b empty= ok  unicode="caf\u00e9 \ud83d\ude00" url=http://x/?a`
+	obj, err := Unmarshal([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"level":   "info",
+		"msg":     "hello \"wide\" world\n",
+		"path":    "/a/b",
+		"empty":   "",
+		"ok":      nil,
+		"unicode": "café 😀",
+		"url":     "http://x/?a",
+	}, obj)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	testCases := []struct {
+		In  string
+		Err error
+	}{
+		{In: "", Err: ErrEmptyInput},
+		{In: "=a", Err: &ErrSyntax{Message: "unexpected '='", Position: 1}},
+		{In: "a=\"b", Err: &ErrSyntax{Message: "unterminated quoted value", Position: 3}},
+		{In: "a=\"b\"c", Err: &ErrSyntax{Message: "unexpected 'c'", Position: 6}},
+		{In: "a=b=c", Err: &ErrSyntax{Message: "unexpected '='", Position: 4}},
+		{In: "a\"b", Err: &ErrSyntax{Message: "unexpected '\"'", Position: 2}},
+		{In: "a=\"\\x\"", Err: &ErrSyntax{Message: "invalid quoted value", Position: 3}},
+	}
+	for _, testCase := range testCases {
+		obj, err := Unmarshal([]byte(testCase.In))
+		assert.Equal(t, testCase.Err, err, testCase.In)
+		assert.Nil(t, obj, testCase.In)
+	}
+}
@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"unicode/utf8"
+)
+
+// Validate returns an error if the line of logfmt contains invalid UTF-8, a syntax error, or a duplicate key.
+func Validate(b []byte) error {
+	if !utf8.Valid(b) {
+		return ErrInvalidUTF8
+	}
+	keys := map[string]struct{}{}
+	return parse(b, func(key string, value string, hasValue bool) error {
+		if _, ok := keys[key]; ok {
+			return &ErrDuplicateKey{Key: key}
+		}
+		keys[key] = struct{}{}
+		return nil
+	})
+}
@@ -0,0 +1,20 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate([]byte(`a=1 b="2" c`)))
+	assert.Equal(t, &ErrDuplicateKey{Key: "a"}, Validate([]byte(`a=1 b=2 a`)))
+	assert.Equal(t, ErrInvalidUTF8, Validate([]byte("a=\xff")))
+}
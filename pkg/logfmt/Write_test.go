@@ -0,0 +1,32 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWrite(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:          buf,
+		LineSeparator:   "\n",
+		Object:          []map[string]interface{}{{"a": 1}, {"b": "x y"}, {"c": 3}},
+		KeySerializer:   stringify.NewStringer("", false, false, false),
+		ValueSerializer: stringify.NewStringer("", false, false, false),
+		Sorted:          true,
+		Limit:           2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a=1\nb=\"x y\"\n", buf.String())
+}
@@ -0,0 +1,39 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriter(t *testing.T) {
+	objects := []interface{}{
+		map[string]interface{}{"level": "info", "msg": "hello world"},
+		map[string]interface{}{"level": "debug", "ok": nil},
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0))
+	keySerializer := stringify.NewStringer("", false, false, false)
+	valueSerializer := stringify.NewStringer("", false, false, false)
+
+	w := NewWriter(buf, []interface{}{"level"}, true, "\n", keySerializer, valueSerializer, true, false)
+	assert.NotNil(t, w)
+
+	err := w.WriteObjects(objects)
+	assert.NoError(t, err)
+
+	err = w.Flush()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "level=info msg=\"hello world\"\nlevel=debug ok\n", buf.String())
+}
@@ -0,0 +1,37 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package logfmt provides a simple API for reading and writing to lines of logfmt, a format for structured logs.
+// logfmt also supports iterators for efficiently reading through a stream.
+//
+// Each line is a series of key-value pairs separated by whitespace, e.g., level=info msg="hello world" ok.
+// Keys are any sequence of printable characters except for space, equal sign, and double quote.
+// Values are either bare or double-quoted with JSON-style escapes.
+// A key without a value, e.g., "ok", is read as nil and nil values are written as a key without a value.
+// See the examples below for usage.
+//
+//  - https://brandur.org/logfmt
+//  - https://github.com/go-logfmt/logfmt
+package logfmt
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]interface{}{})
+)
+
+var (
+	ErrEmptyInput             = errors.New("empty input")
+	ErrMissingLineSeparator   = errors.New("missing line separator")
+	ErrMissingKeySerializer   = errors.New("missing key serializer")
+	ErrMissingValueSerializer = errors.New("missing value serializer")
+	ErrInvalidUTF8            = errors.New("invalid utf-8")
+)
@@ -0,0 +1,144 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package logfmt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// unquote returns the value of a double-quoted string with JSON-style escapes.
+// The given string must begin and end with a double quote.
+func unquote(s string) (string, bool) {
+	s = s[1 : len(s)-1]
+	if strings.IndexByte(s, '\\') == -1 {
+		return s, true
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", false
+		}
+		switch s[i] {
+		case '"', '\\', '/':
+			b.WriteByte(s[i])
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", false
+			}
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", false
+			}
+			i += 4
+			if utf16.IsSurrogate(rune(r)) && i+6 < len(s) && s[i+1] == '\\' && s[i+2] == 'u' {
+				if r2, err := strconv.ParseUint(s[i+3:i+7], 16, 16); err == nil {
+					if dec := utf16.DecodeRune(rune(r), rune(r2)); dec != utf8.RuneError {
+						b.WriteRune(dec)
+						i += 6
+						continue
+					}
+				}
+			}
+			b.WriteRune(rune(r))
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// parse parses a line of logfmt and calls the given function for each key-value pair in order.
+// If a key has no value, then calls the function with hasValue set to false.
+func parse(b []byte, fn func(key string, value string, hasValue bool) error) error {
+	n := len(b)
+	i := 0
+	for {
+		for i < n && b[i] <= ' ' {
+			i++
+		}
+		if i >= n {
+			return nil
+		}
+		start := i
+		for i < n && b[i] > ' ' && b[i] != '=' && b[i] != '"' {
+			i++
+		}
+		if i == start {
+			return &ErrSyntax{Message: fmt.Sprintf("unexpected %q", b[i]), Position: i + 1}
+		}
+		key := string(b[start:i])
+		if i >= n || b[i] <= ' ' {
+			if err := fn(key, "", false); err != nil {
+				return err
+			}
+			continue
+		}
+		if b[i] == '"' {
+			return &ErrSyntax{Message: fmt.Sprintf("unexpected %q", b[i]), Position: i + 1}
+		}
+		i++ // skip equal sign
+		if i >= n || b[i] <= ' ' {
+			if err := fn(key, "", true); err != nil {
+				return err
+			}
+			continue
+		}
+		start = i
+		if b[i] == '"' {
+			for i++; i < n && b[i] != '"'; i++ {
+				if b[i] == '\\' {
+					i++
+				}
+			}
+			if i >= n {
+				return &ErrSyntax{Message: "unterminated quoted value", Position: start + 1}
+			}
+			i++ // skip closing quote
+			value, ok := unquote(string(b[start:i]))
+			if !ok {
+				return &ErrSyntax{Message: "invalid quoted value", Position: start + 1}
+			}
+			if i < n && b[i] > ' ' {
+				return &ErrSyntax{Message: fmt.Sprintf("unexpected %q", b[i]), Position: i + 1}
+			}
+			if err := fn(key, value, true); err != nil {
+				return err
+			}
+			continue
+		}
+		for i < n && b[i] > ' ' {
+			if b[i] == '=' || b[i] == '"' {
+				return &ErrSyntax{Message: fmt.Sprintf("unexpected %q", b[i]), Position: i + 1}
+			}
+			i++
+		}
+		if err := fn(key, string(b[start:i]), true); err != nil {
+			return err
+		}
+	}
+}
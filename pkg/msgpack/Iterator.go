@@ -0,0 +1,95 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates trough a stream of concatenated MessagePack messages
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type    reflect.Type   // the type to unmarshal for each message
+	Reader  *bufio.Reader  // the buffered reader used to detect the end of the stream
+	Decoder *codec.Decoder // the decoder that reads from the buffered reader
+	Limit   int            // Limit the number of objects to read and return from the underlying stream.
+	Count   int            // The current count of the number of objects read.
+	Limits  limits.Limits  // The maximum depth and number of keys of each object.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader io.Reader
+	Type   reflect.Type  // the type to unmarshal for each message.  If nil, then decodes into an empty interface.
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Limits limits.Limits // The maximum depth and number of keys of each object.  Only MaxDepth and MaxKeys are used.
+}
+
+// NewIterator returns a new MessagePack iterator base on the given input.
+func NewIterator(input *NewIteratorInput) *Iterator {
+	r := bufio.NewReader(input.Reader)
+	return &Iterator{
+		Type:    input.Type,
+		Reader:  r,
+		Decoder: codec.NewDecoder(r, newHandle()),
+		Limit:   input.Limit,
+		Count:   0,
+		Limits:  input.Limits,
+	}
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	// The decoder does not return io.EOF at the end of the stream, so check for the end before decoding.
+	if _, err := it.Reader.Peek(1); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrap(err, "error reading from underlying reader")
+	}
+
+	// Increment Counter
+	it.Count++
+
+	var obj interface{}
+	if it.Type != nil {
+		ptr := reflect.New(it.Type)
+		if it.Type.Kind() == reflect.Map {
+			ptr.Elem().Set(reflect.MakeMap(it.Type))
+		}
+		if err := it.Decoder.Decode(ptr.Interface()); err != nil {
+			return nil, errors.Wrapf(err, "error decoding MessagePack message %d", it.Count)
+		}
+		obj = ptr.Elem().Interface()
+	} else {
+		if err := it.Decoder.Decode(&obj); err != nil {
+			return nil, errors.Wrapf(err, "error decoding MessagePack message %d", it.Count)
+		}
+	}
+
+	if err := it.Limits.Check(obj); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of MessagePack message %d", it.Count)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,31 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// Marshal formats an object into a slice of bytes of MessagePack.
+// Numbers decoded as json.Number, *big.Int, or *big.Float are written as int64, uint64, or float64 values.
+// If a number cannot be represented exactly, then returns number.ErrInexact.
+func Marshal(obj interface{}) ([]byte, error) {
+	obj, err := number.ToNative(obj, nil)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, "error converting numbers")
+	}
+	b := make([]byte, 0)
+	err = codec.NewEncoderBytes(&b, newHandle()).Encode(obj)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, "error marshaling MessagePack")
+	}
+	return b, nil
+}
@@ -0,0 +1,67 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+func TestMarshalMap(t *testing.T) {
+	in := map[string]interface{}{"a": 1.0, "b": "x", "c": []byte("y")}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	assert.NotNil(t, b)
+	returned, err := UnmarshalType(b, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, in, returned) // check roundtrip
+}
+
+func TestMarshalNumbers(t *testing.T) {
+	in := map[string]interface{}{"a": json.Number("1"), "b": big.NewInt(-2), "c": big.NewFloat(1.5), "d": json.Number("18446744073709551615")}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	returned, err := UnmarshalType(b, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": int64(1), "b": int64(-2), "c": 1.5, "d": uint64(18446744073709551615)}, returned)
+
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	b, err = Marshal(map[string]interface{}{"id": i})
+	assert.Error(t, err)
+	assert.IsType(t, &number.ErrInexact{}, errors.Cause(err))
+	assert.Empty(t, b)
+}
+
+func TestMarshalStruct(t *testing.T) {
+	in := struct {
+		A string
+		B string
+		C string
+	}{A: "1", B: "2", C: "3"}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	returned, err := UnmarshalType(b, reflect.TypeOf(in))
+	assert.NoError(t, err)
+	assert.Equal(t, in, returned) // check roundtrip
+}
+
+func TestMarshalExtension(t *testing.T) {
+	in := map[string]interface{}{"a": RawExt{Tag: 5, Data: []byte{0x01, 0x02}}}
+	b, err := Marshal(in)
+	assert.NoError(t, err)
+	returned, err := UnmarshalType(b, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, in, returned) // check roundtrip
+}
@@ -0,0 +1,43 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	// {"a": "x"}, {"b": "y"}
+	in := []byte{0x81, 0xa1, 0x61, 0xa1, 0x78, 0x81, 0xa1, 0x62, 0xa1, 0x79}
+	expected := []map[string]string{
+		map[string]string{"a": "x"},
+		map[string]string{"b": "y"},
+	}
+	obj, err := Read(&ReadInput{
+		Type:   reflect.TypeOf([]map[string]string{}),
+		Reader: bytes.NewReader(in),
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestReadNoType(t *testing.T) {
+	// "a", 1
+	in := []byte{0xa1, 0x61, 0x01}
+	obj, err := Read(&ReadInput{
+		Reader: bytes.NewReader(in),
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", int64(1)}, obj)
+}
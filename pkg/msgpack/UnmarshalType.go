@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+)
+
+// UnmarshalType parses a slice of bytes into an object of a given type.
+// If no input is given, then returns ErrEmptyInput.
+func UnmarshalType(b []byte, outputType reflect.Type) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	ptr := reflect.New(outputType)
+	if outputType.Kind() == reflect.Map {
+		ptr.Elem().Set(reflect.MakeMap(outputType))
+	}
+	err := codec.NewDecoderBytes(b, newHandle()).Decode(ptr.Interface())
+	if err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling MessagePack")
+	}
+
+	return ptr.Elem().Interface(), nil
+}
@@ -0,0 +1,48 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTypeEmpty(t *testing.T) {
+	obj, err := UnmarshalType([]byte{}, reflect.TypeOf(map[string]string{}))
+	assert.Equal(t, err, ErrEmptyInput)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshalTypeMapStringInterface(t *testing.T) {
+	// {"a": 1, "b": "x"}
+	in := []byte{0x82, 0xa1, 0x61, 0x01, 0xa1, 0x62, 0xa1, 0x78}
+	expected := map[string]interface{}{"a": int64(1), "b": "x"}
+	obj, err := UnmarshalType(in, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalTypeMapStringString(t *testing.T) {
+	// {"a": "x"}
+	in := []byte{0x81, 0xa1, 0x61, 0xa1, 0x78}
+	expected := map[string]string{"a": "x"}
+	obj, err := UnmarshalType(in, reflect.TypeOf(map[string]string{}))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalTypeSlice(t *testing.T) {
+	// [1, 2, 3]
+	in := []byte{0x93, 0x01, 0x02, 0x03}
+	expected := []int{1, 2, 3}
+	obj, err := UnmarshalType(in, reflect.TypeOf([]int{}))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
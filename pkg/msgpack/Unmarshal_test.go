@@ -0,0 +1,55 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalEmpty(t *testing.T) {
+	obj, err := Unmarshal([]byte{})
+	assert.Equal(t, err, ErrEmptyInput)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	// {"a": 1, 2: "b"}
+	in := []byte{0x82, 0xa1, 0x61, 0x01, 0x02, 0xa1, 0x62}
+	expected := map[interface{}]interface{}{"a": int64(1), int64(2): "b"}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalArray(t *testing.T) {
+	// ["a", true, nil, 1.5]
+	in := []byte{0x94, 0xa1, 0x61, 0xc3, 0xc0, 0xcb, 0x3f, 0xf8, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}
+	expected := []interface{}{"a", true, nil, 1.5}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalExtension(t *testing.T) {
+	// fixext 2 with type 5
+	in := []byte{0xd5, 0x05, 0x01, 0x02}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, RawExt{Tag: 5, Data: []byte{0x01, 0x02}}, obj)
+	b, err := Marshal(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, in, b) // check roundtrip
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	obj, err := Unmarshal([]byte{0x82, 0xa1, 0x61})
+	assert.Error(t, err)
+	assert.Nil(t, obj)
+}
@@ -0,0 +1,100 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as concatenated MessagePack messages.
+type Writer struct {
+	writer        io.Writer      // writer for the underlying stream
+	encoder       *codec.Encoder // MessagePack encoder
+	keySerializer stringify.Stringer
+}
+
+// NewWriter returns a writer for formating and writing objets to the underlying writer as concatenated MessagePack messages.
+// If keySerializer is not nil, then map keys are converted to strings using the key serializer before writing.
+func NewWriter(w io.Writer, keySerializer stringify.Stringer) *Writer {
+	return &Writer{
+		writer:        w,
+		encoder:       codec.NewEncoder(w, newHandle()),
+		keySerializer: keySerializer,
+	}
+}
+
+// WriteObject formats and writes a single object to the underlying writer as a MessagePack message.
+// Numbers decoded as json.Number, *big.Int, or *big.Float are written as int64, uint64, or float64 values.
+// If a number cannot be represented exactly, then returns number.ErrInexact.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.keySerializer != nil {
+		o, err := stringify.StringifyMapKeys(obj, w.keySerializer)
+		if err != nil {
+			return errors.Wrap(err, "error stringify map keys")
+		}
+		obj = o
+	}
+	obj, err := number.ToNative(obj, nil)
+	if err != nil {
+		return errors.Wrap(err, "error converting numbers")
+	}
+	err = w.encoder.Encode(obj)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush flushes the underlying writer, if it has a Flush method.
+// This writer itself does no buffering.
+func (w *Writer) Flush() error {
+	if flusher, ok := w.writer.(interface{ Flush() error }); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
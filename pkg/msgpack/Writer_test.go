@@ -0,0 +1,52 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package msgpack
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriter(t *testing.T) {
+	objects := []interface{}{
+		map[interface{}]interface{}{"a": "x", 1: "y"},
+		map[string]interface{}{"b": RawExt{Tag: 5, Data: []byte{0x01}}},
+	}
+
+	buf := new(bytes.Buffer)
+
+	w := NewWriter(buf, stringify.NewStringer("", false, false, false))
+
+	err := w.WriteObjects(objects)
+	require.NoError(t, err)
+
+	err = w.Flush()
+	require.NoError(t, err)
+
+	it := NewIterator(&NewIteratorInput{
+		Reader: buf,
+		Limit:  -1,
+	})
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[interface{}]interface{}{"a": "x", "1": "y"}, obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[interface{}]interface{}{"b": RawExt{Tag: 5, Data: []byte{0x01}}}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
@@ -0,0 +1,42 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package msgpack provides an API for MessagePack serialization.  This package wraps the ugorji codec package.
+//	- https://msgpack.org/
+//	- https://godoc.org/github.com/ugorji/go/codec
+//
+// Extension types that are not registered are decoded as RawExt values, which preserve the extension type and data,
+// so that they are written back unchanged.
+package msgpack
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]interface{}{})
+)
+
+var (
+	ErrEmptyInput = errors.New("empty input")
+)
+
+// RawExt is an extension type and its undecoded data.
+type RawExt = codec.RawExt
+
+// newHandle returns a new MessagePack handle.
+// Strings are written with the str type, byte slices with the bin type, and maps are decoded as map[interface{}]interface{}.
+func newHandle() *codec.MsgpackHandle {
+	h := &codec.MsgpackHandle{}
+	h.WriteExt = true
+	h.RawToString = false
+	h.MapType = reflect.TypeOf(map[interface{}]interface{}{})
+	return h
+}
@@ -0,0 +1,120 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// Convert converts the numbers in the object into the types for the given mode.
+// Maps and slices are converted in place, recursively.
+// json.Number values are parsed with Parse.
+// Numbers that were already decoded as integers or floats are converted without changing their values.
+// If the mode is empty, then returns the object as is.
+func Convert(obj interface{}, mode string) (interface{}, error) {
+	if len(mode) == 0 {
+		return obj, nil
+	}
+	switch mode {
+	case ModeFloat, ModeNumber, ModeInt64, ModeBig:
+	default:
+		return nil, &ErrInvalidMode{Mode: mode}
+	}
+	return convert(obj, mode)
+}
+
+func convert(obj interface{}, mode string) (interface{}, error) {
+	switch v := obj.(type) {
+	case nil, string, bool:
+		return obj, nil
+	case json.Number:
+		return Parse(v.String(), mode)
+	case float32:
+		return convertFloat(float64(v), mode), nil
+	case float64:
+		return convertFloat(v, mode), nil
+	case *big.Int, *big.Float:
+		return obj, nil
+	case map[string]interface{}:
+		for key, value := range v {
+			c, err := convert(value, mode)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = c
+		}
+		return v, nil
+	case []interface{}:
+		for i, value := range v {
+			c, err := convert(value, mode)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = c
+		}
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(obj)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Parse(strconv.FormatInt(rv.Int(), 10), mode)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Parse(strconv.FormatUint(rv.Uint(), 10), mode)
+	case reflect.Map:
+		if rv.Type().Elem().Kind() != reflect.Interface {
+			return obj, nil
+		}
+		for _, key := range rv.MapKeys() {
+			c, err := convert(rv.MapIndex(key).Interface(), mode)
+			if err != nil {
+				return nil, err
+			}
+			if c == nil {
+				rv.SetMapIndex(key, reflect.Zero(rv.Type().Elem()))
+			} else {
+				rv.SetMapIndex(key, reflect.ValueOf(c))
+			}
+		}
+		return obj, nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Interface {
+			return obj, nil
+		}
+		for i := 0; i < rv.Len(); i++ {
+			c, err := convert(rv.Index(i).Interface(), mode)
+			if err != nil {
+				return nil, err
+			}
+			if c != nil {
+				rv.Index(i).Set(reflect.ValueOf(c))
+			}
+		}
+		return obj, nil
+	}
+	return obj, nil
+}
+
+// convertFloat converts a float that was already decoded into the type for the given mode.
+// NaN and infinity are not numbers in JSON and cannot be represented as a big.Float, so they are returned as is.
+func convertFloat(f float64, mode string) interface{} {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return f
+	}
+	switch mode {
+	case ModeNumber:
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+	case ModeBig:
+		return new(big.Float).SetFloat64(f)
+	}
+	return f
+}
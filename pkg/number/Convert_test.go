@@ -0,0 +1,54 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	in := map[string]interface{}{
+		"id":     json.Number("9007199254740993"),
+		"count":  10,
+		"ratio":  0.5,
+		"name":   "a",
+		"values": []interface{}{json.Number("1"), json.Number("2.5")},
+	}
+	out, err := Convert(in, ModeInt64)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":     int64(9007199254740993),
+		"count":  int64(10),
+		"ratio":  0.5,
+		"name":   "a",
+		"values": []interface{}{int64(1), 2.5},
+	}, out)
+}
+
+func TestConvertNumber(t *testing.T) {
+	out, err := Convert(map[interface{}]interface{}{"a": 1, "b": 1.25}, ModeNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, map[interface{}]interface{}{"a": json.Number("1"), "b": json.Number("1.25")}, out)
+}
+
+func TestConvertBig(t *testing.T) {
+	out, err := Convert([]interface{}{int64(1)}, ModeBig)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(1)}, out)
+}
+
+func TestConvertEmptyMode(t *testing.T) {
+	in := []interface{}{json.Number("1")}
+	out, err := Convert(in, "")
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"fmt"
+)
+
+// ErrInexact is used when a number cannot be represented exactly by an int64, uint64, or float64.
+type ErrInexact struct {
+	Value string // the text of the number
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInexact) Error() string {
+	return fmt.Sprintf("number %s cannot be represented exactly as an int64, uint64, or float64", e.Value)
+}
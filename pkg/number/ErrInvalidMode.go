@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidMode is used when a number mode is not supported.
+type ErrInvalidMode struct {
+	Mode string // the invalid mode
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidMode) Error() string {
+	return fmt.Sprintf("invalid number mode %q, expecting one of %s", e.Mode, strings.Join(Modes, ", "))
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"fmt"
+)
+
+// ErrOutOfRange is used when an integer cannot be represented by the type of the number mode.
+type ErrOutOfRange struct {
+	Value string // the text of the number
+	Mode  string // the number mode
+}
+
+// Error returns the error formatted as a string.
+func (e ErrOutOfRange) Error() string {
+	return fmt.Sprintf("number %s is out of range for number mode %q", e.Value, e.Mode)
+}
@@ -0,0 +1,42 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+)
+
+// Format returns the exact text of the number, if the object is a json.Number, *big.Int, or *big.Float.
+// If decimal is true, then numbers with exponents are formatted without exponents.
+// Returns false as the second value if the object is not one of those types.
+func Format(obj interface{}, decimal bool) (string, bool) {
+	switch v := obj.(type) {
+	case json.Number:
+		if decimal && !IsInteger(v.String()) {
+			if f, _, err := big.ParseFloat(v.String(), 10, uint(len(v))*4+64, big.ToNearestEven); err == nil {
+				return f.Text('f', -1), true
+			}
+		}
+		return v.String(), true
+	case *big.Int:
+		if v == nil {
+			return "", false
+		}
+		return v.String(), true
+	case *big.Float:
+		if v == nil {
+			return "", false
+		}
+		if decimal {
+			return v.Text('f', -1), true
+		}
+		return v.Text('g', -1), true
+	}
+	return "", false
+}
@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	str, ok := Format(json.Number("9007199254740993"), false)
+	assert.True(t, ok)
+	assert.Equal(t, "9007199254740993", str)
+
+	str, ok = Format(json.Number("1.5e3"), true)
+	assert.True(t, ok)
+	assert.Equal(t, "1500", str)
+
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	str, ok = Format(i, false)
+	assert.True(t, ok)
+	assert.Equal(t, "92233720368547758070", str)
+
+	f, _, _ := big.ParseFloat("3.14159265358979323846264338327950288", 10, 256, big.ToNearestEven)
+	str, ok = Format(f, false)
+	assert.True(t, ok)
+	assert.Equal(t, "3.14159265358979323846264338327950288", str)
+
+	str, ok = Format(1.5, false)
+	assert.False(t, ok)
+	assert.Equal(t, "", str)
+}
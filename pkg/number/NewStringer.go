@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// NewStringer returns a stringer that formats json.Number, *big.Int, and *big.Float values exactly
+// and passes all other values to the given stringer.
+// If decimal is true, then numbers with exponents are formatted without exponents.
+func NewStringer(stringer stringify.Stringer, decimal bool) stringify.Stringer {
+	return func(object interface{}) (string, error) {
+		if str, ok := Format(object, decimal); ok {
+			return str, nil
+		}
+		return stringer(object)
+	}
+}
@@ -0,0 +1,56 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var (
+	decimalExpression = regexp.MustCompile(`^([0-9]*)(?:\.([0-9]*))?([eE][-+]?[0-9]+)?$`)
+)
+
+// Normalize returns the text of a YAML or TOML number literal as a JSON number, so that it can be parsed with Parse.
+// Underscores and a leading plus sign are removed, hexadecimal, octal, and binary integers are converted into decimal,
+// and a missing integer or fractional part is filled in, e.g., ".5" becomes "0.5".
+// Returns false if the text is not a finite number, e.g., "inf" or "nan".
+func Normalize(str string) (string, bool) {
+	s := strings.Replace(str, "_", "", -1)
+	sign := ""
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		sign = "-"
+		s = s[1:]
+	}
+	if len(s) > 1 && s[0] == '0' {
+		// Integers with a base prefix or a leading zero are parsed as hexadecimal, octal, or binary.
+		if i, ok := new(big.Int).SetString(s, 0); ok {
+			if len(sign) > 0 {
+				i.Neg(i)
+			}
+			return i.String(), true
+		}
+	}
+	m := decimalExpression.FindStringSubmatch(s)
+	if m == nil || len(m[1])+len(m[2]) == 0 {
+		return "", false
+	}
+	integer := strings.TrimLeft(m[1], "0")
+	if len(integer) == 0 {
+		integer = "0"
+	}
+	text := sign + integer
+	if len(m[2]) > 0 {
+		text += "." + m[2]
+	}
+	return text + m[3], true
+}
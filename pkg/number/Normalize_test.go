@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"92233720368547758070": "92233720368547758070",
+		"+1_000":               "1000",
+		"-0x1F":                "-31",
+		"0o17":                 "15",
+		"0b101":                "5",
+		".5":                   "0.5",
+		"-1.":                  "-1",
+		"6.02e+23":             "6.02e+23",
+		"0":                    "0",
+		"0.0":                  "0.0",
+	}
+	for in, expected := range cases {
+		out, ok := Normalize(in)
+		assert.True(t, ok, in)
+		assert.Equal(t, expected, out, in)
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	for _, in := range []string{"", ".", "inf", "-.inf", "nan", "1979-05-27", "07:32:00", "abc"} {
+		_, ok := Normalize(in)
+		assert.False(t, ok, in)
+	}
+}
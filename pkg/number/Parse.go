@@ -0,0 +1,72 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IsInteger returns true if the text of the number does not include a fraction or exponent.
+func IsInteger(str string) bool {
+	return !strings.ContainsAny(str, ".eE")
+}
+
+// Parse parses the text of a number into the type for the given mode.
+// If an integer cannot be represented as an int64 in int64 mode, then returns ErrOutOfRange.
+func Parse(str string, mode string) (interface{}, error) {
+	switch mode {
+	case ModeFloat:
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing number %q", str)
+		}
+		return f, nil
+	case ModeNumber:
+		return json.Number(str), nil
+	case ModeInt64:
+		if IsInteger(str) {
+			i, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+					return nil, &ErrOutOfRange{Value: str, Mode: mode}
+				}
+				return nil, errors.Wrapf(err, "error parsing number %q", str)
+			}
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing number %q", str)
+		}
+		return f, nil
+	case ModeBig:
+		if IsInteger(str) {
+			i, ok := new(big.Int).SetString(str, 10)
+			if !ok {
+				return nil, errors.Errorf("error parsing number %q", str)
+			}
+			return i, nil
+		}
+		// Use enough bits of precision to represent every decimal digit of the number.
+		prec := uint(len(str)) * 4
+		if prec < 64 {
+			prec = 64
+		}
+		f, _, err := big.ParseFloat(str, 10, prec, big.ToNearestEven)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing number %q", str)
+		}
+		return f, nil
+	}
+	return nil, &ErrInvalidMode{Mode: mode}
+}
@@ -0,0 +1,60 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFloat(t *testing.T) {
+	obj, err := Parse("9007199254740993", ModeFloat)
+	assert.NoError(t, err)
+	assert.Equal(t, 9007199254740992.0, obj)
+}
+
+func TestParseNumber(t *testing.T) {
+	obj, err := Parse("9007199254740993", ModeNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("9007199254740993"), obj)
+}
+
+func TestParseInt64(t *testing.T) {
+	obj, err := Parse("9007199254740993", ModeInt64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), obj)
+
+	obj, err = Parse("1.5", ModeInt64)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, obj)
+
+	obj, err = Parse("92233720368547758070", ModeInt64)
+	assert.IsType(t, &ErrOutOfRange{}, err)
+	assert.Nil(t, obj)
+}
+
+func TestParseBig(t *testing.T) {
+	obj, err := Parse("92233720368547758070", ModeBig)
+	assert.NoError(t, err)
+	expected, _ := new(big.Int).SetString("92233720368547758070", 10)
+	assert.Equal(t, expected, obj)
+
+	obj, err = Parse("3.14159265358979323846264338327950288", ModeBig)
+	assert.NoError(t, err)
+	assert.IsType(t, &big.Float{}, obj)
+	assert.Equal(t, "3.14159265358979323846264338327950288", obj.(*big.Float).Text('g', -1))
+}
+
+func TestParseInvalidMode(t *testing.T) {
+	obj, err := Parse("1", "double")
+	assert.IsType(t, &ErrInvalidMode{}, err)
+	assert.Nil(t, obj)
+}
@@ -0,0 +1,161 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"reflect"
+)
+
+// Replace returns the object with its values replaced using the given function, including values nested within maps and slices.
+// The function returns the replacement and true if the value is replaced.
+// Maps and slices are copied if any of their values are replaced, so the object given is not modified.
+func Replace(obj interface{}, f func(value interface{}) (interface{}, bool)) interface{} {
+	o, _ := replace(obj, f)
+	return o
+}
+
+// replace returns the object with its values replaced and true if any value was replaced.
+func replace(obj interface{}, f func(value interface{}) (interface{}, bool)) (interface{}, bool) {
+	if r, ok := f(obj); ok {
+		return r, true
+	}
+	switch v := obj.(type) {
+	case nil, string, bool:
+		return obj, false
+	case map[string]interface{}:
+		var m map[string]interface{}
+		for key, value := range v {
+			r, ok := replace(value, f)
+			if !ok {
+				continue
+			}
+			if m == nil {
+				m = make(map[string]interface{}, len(v))
+				for k, x := range v {
+					m[k] = x
+				}
+			}
+			m[key] = r
+		}
+		if m == nil {
+			return obj, false
+		}
+		return m, true
+	case map[interface{}]interface{}:
+		var m map[interface{}]interface{}
+		for key, value := range v {
+			r, ok := replace(value, f)
+			if !ok {
+				continue
+			}
+			if m == nil {
+				m = make(map[interface{}]interface{}, len(v))
+				for k, x := range v {
+					m[k] = x
+				}
+			}
+			m[key] = r
+		}
+		if m == nil {
+			return obj, false
+		}
+		return m, true
+	case []interface{}:
+		var s []interface{}
+		for i, value := range v {
+			r, ok := replace(value, f)
+			if !ok {
+				continue
+			}
+			if s == nil {
+				s = append(make([]interface{}, 0, len(v)), v...)
+			}
+			s[i] = r
+		}
+		if s == nil {
+			return obj, false
+		}
+		return s, true
+	}
+
+	rv := reflect.ValueOf(obj)
+	switch rv.Kind() {
+	case reflect.Map:
+		if !canReplace(rv.Type().Elem()) {
+			return obj, false
+		}
+		var m reflect.Value
+		for _, key := range rv.MapKeys() {
+			r, ok := replace(rv.MapIndex(key).Interface(), f)
+			if !ok || !isAssignable(r, rv.Type().Elem()) {
+				continue
+			}
+			if !m.IsValid() {
+				m = reflect.MakeMapWithSize(rv.Type(), rv.Len())
+				for _, k := range rv.MapKeys() {
+					m.SetMapIndex(k, rv.MapIndex(k))
+				}
+			}
+			m.SetMapIndex(key, valueOf(r, rv.Type().Elem()))
+		}
+		if !m.IsValid() {
+			return obj, false
+		}
+		return m.Interface(), true
+	case reflect.Slice:
+		if !canReplace(rv.Type().Elem()) {
+			return obj, false
+		}
+		var s reflect.Value
+		for i := 0; i < rv.Len(); i++ {
+			r, ok := replace(rv.Index(i).Interface(), f)
+			if !ok || !isAssignable(r, rv.Type().Elem()) {
+				continue
+			}
+			if !s.IsValid() {
+				s = reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+				reflect.Copy(s, rv)
+			}
+			s.Index(i).Set(valueOf(r, rv.Type().Elem()))
+		}
+		if !s.IsValid() {
+			return obj, false
+		}
+		return s.Interface(), true
+	}
+	return obj, false
+}
+
+// canReplace returns true if values of the given type can contain replaceable values.
+func canReplace(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Slice:
+		return true
+	}
+	return false
+}
+
+// isAssignable returns true if the value can be stored in a map or slice with the given element type.
+func isAssignable(value interface{}, t reflect.Type) bool {
+	if value == nil {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Map, reflect.Slice:
+			return true
+		}
+		return false
+	}
+	return reflect.TypeOf(value).AssignableTo(t)
+}
+
+// valueOf returns the reflected value, or the zero value of the given type if the value is nil.
+func valueOf(value interface{}, t reflect.Type) reflect.Value {
+	if value == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(value)
+}
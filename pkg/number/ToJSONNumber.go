@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+)
+
+// ToJSONNumber returns the object with every *big.Int and *big.Float replaced by a json.Number with the same value.
+// *big.Int and *big.Float only implement encoding.TextMarshaler, so encoders write them as quoted strings,
+// while json.Number is written as a bare number.
+// Maps and slices are copied if they contain a replaced number, so the object given is not modified.
+func ToJSONNumber(obj interface{}) interface{} {
+	return Replace(obj, func(value interface{}) (interface{}, bool) {
+		switch v := value.(type) {
+		case *big.Int:
+			if v == nil {
+				return nil, false
+			}
+			return json.Number(v.String()), true
+		case *big.Float:
+			if v == nil || v.IsInf() {
+				return nil, false
+			}
+			return json.Number(v.Text('g', -1)), true
+		}
+		return nil, false
+	})
+}
@@ -0,0 +1,49 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToJSONNumber(t *testing.T) {
+	f, _, err := big.ParseFloat("1.5", 10, 64, big.ToNearestEven)
+	assert.NoError(t, err)
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	in := map[string]interface{}{
+		"f":      f,
+		"id":     i,
+		"name":   "a",
+		"values": []interface{}{big.NewInt(1), 2.5},
+	}
+	out := ToJSONNumber(in)
+	assert.Equal(t, map[string]interface{}{
+		"f":      json.Number("1.5"),
+		"id":     json.Number("92233720368547758070"),
+		"name":   "a",
+		"values": []interface{}{json.Number("1"), 2.5},
+	}, out)
+	// the input is not modified
+	assert.Equal(t, i, in["id"])
+}
+
+func TestToJSONNumberTyped(t *testing.T) {
+	in := []map[string]interface{}{{"a": big.NewInt(1)}, {"b": "x"}}
+	out := ToJSONNumber(in)
+	assert.Equal(t, []map[string]interface{}{{"a": json.Number("1")}, {"b": "x"}}, out)
+}
+
+func TestToJSONNumberUnchanged(t *testing.T) {
+	in := map[string]interface{}{"a": 1.0, "b": []interface{}{"x"}}
+	out := ToJSONNumber(in)
+	assert.Equal(t, in, out)
+}
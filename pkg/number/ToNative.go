@@ -0,0 +1,106 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ToNative returns the object with the json.Number, *big.Int, and *big.Float values replaced by int64, uint64, or float64 values,
+// including values nested within maps and slices.  This is used by encoders that only support the native number types.
+// If a number cannot be represented exactly by a native type, then the fallback function is called with the text of the number.
+// If the fallback is nil, then returns ErrInexact.
+// The object given is not modified.
+func ToNative(obj interface{}, fallback func(str string) (interface{}, error)) (interface{}, error) {
+	var err error
+	out := Replace(obj, func(value interface{}) (interface{}, bool) {
+		if err != nil {
+			return nil, false
+		}
+		str := ""
+		switch v := value.(type) {
+		case json.Number:
+			str = string(v)
+		case *big.Int:
+			if v == nil {
+				return nil, false
+			}
+			str = v.String()
+		case *big.Float:
+			if v == nil {
+				return nil, false
+			}
+			if v.IsInf() {
+				return math.Inf(v.Sign()), true
+			}
+			str = v.Text('g', -1)
+		default:
+			return nil, false
+		}
+		n, ok, e := toNative(str)
+		if e != nil {
+			err = e
+			return nil, false
+		}
+		if ok {
+			return n, true
+		}
+		if fallback == nil {
+			err = &ErrInexact{Value: str}
+			return nil, false
+		}
+		n, e = fallback(str)
+		if e != nil {
+			err = e
+			return nil, false
+		}
+		return n, true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// toNative parses the text of a number into an int64, uint64, or float64
+// and returns true if the value is exactly the number in the text.
+func toNative(str string) (interface{}, bool, error) {
+	if IsInteger(str) {
+		if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return i, true, nil
+		}
+		if u, err := strconv.ParseUint(str, 10, 64); err == nil {
+			return u, true, nil
+		}
+		if _, ok := new(big.Int).SetString(str, 10); !ok {
+			return nil, false, errors.Errorf("error parsing number %q", str)
+		}
+		return nil, false, nil
+	}
+	r, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil, false, errors.Errorf("error parsing number %q", str)
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrapf(err, "error parsing number %q", str)
+	}
+	// The float is exact if its shortest decimal text is the same number as the original text.
+	if s, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64)); !ok || s.Cmp(r) != 0 {
+		return nil, false, nil
+	}
+	return f, true, nil
+}
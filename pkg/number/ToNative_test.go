@@ -0,0 +1,58 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToNative(t *testing.T) {
+	f, _, err := big.ParseFloat("1.5", 10, 64, big.ToNearestEven)
+	assert.NoError(t, err)
+	in := map[string]interface{}{
+		"f":      f,
+		"i":      big.NewInt(-1),
+		"u":      json.Number("18446744073709551615"),
+		"name":   "a",
+		"values": []interface{}{json.Number("0.1"), 2.5},
+	}
+	out, err := ToNative(in, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"f":      1.5,
+		"i":      int64(-1),
+		"u":      uint64(18446744073709551615),
+		"name":   "a",
+		"values": []interface{}{0.1, 2.5},
+	}, out)
+	// the input is not modified
+	assert.Equal(t, json.Number("18446744073709551615"), in["u"])
+}
+
+func TestToNativeInexact(t *testing.T) {
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	out, err := ToNative(map[string]interface{}{"id": i}, nil)
+	assert.Equal(t, &ErrInexact{Value: "92233720368547758070"}, err)
+	assert.Nil(t, out)
+
+	out, err = ToNative([]interface{}{json.Number("1.00000000000000000001")}, nil)
+	assert.Equal(t, &ErrInexact{Value: "1.00000000000000000001"}, err)
+	assert.Nil(t, out)
+}
+
+func TestToNativeFallback(t *testing.T) {
+	out, err := ToNative(map[string]interface{}{"id": json.Number("92233720368547758070")}, func(str string) (interface{}, error) {
+		return "x" + str, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "x92233720368547758070"}, out)
+}
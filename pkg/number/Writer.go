@@ -0,0 +1,63 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package number
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+)
+
+// Writer converts the *big.Int and *big.Float values in each object into json.Number before writing it to the underlying pipe.Writer,
+// e.g., a JSON Lines writer, so that the numbers are written without quotes.
+type Writer struct {
+	writer pipe.Writer // the underlying writer
+}
+
+// NewWriter returns a writer that converts the big numbers in each object into json.Number before writing it to the underlying writer.
+func NewWriter(w pipe.Writer) *Writer {
+	return &Writer{
+		writer: w,
+	}
+}
+
+// WriteObject converts the big numbers in a single object and writes it to the underlying writer.
+func (w *Writer) WriteObject(obj interface{}) error {
+	return w.writer.WriteObject(ToJSONNumber(obj))
+}
+
+// WriteObjects converts the big numbers in the given objects and writes them to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush flushes the underlying writer.
+func (w *Writer) Flush() error {
+	return w.writer.Flush()
+}
+
+// Close closes the underlying writer.
+func (w *Writer) Close() error {
+	return w.writer.Close()
+}
@@ -0,0 +1,32 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package number provides functions for decoding and formatting numbers without losing precision.
+// Decoders that support a number mode use this package to convert the numbers they decode.
+//
+//	- float => float64
+//	- number => json.Number, keeping the original text of the number
+//	- int64 => int64 for integers and float64 for other numbers
+//	- big => *big.Int for integers and *big.Float for other numbers
+package number
+
+const (
+	ModeFloat  = "float"  // decode all numbers as float64
+	ModeNumber = "number" // decode all numbers as json.Number
+	ModeInt64  = "int64"  // decode integers as int64 and other numbers as float64
+	ModeBig    = "big"    // decode integers as *big.Int and other numbers as *big.Float
+)
+
+var (
+	// Modes is a list of the supported number modes.
+	Modes = []string{
+		ModeFloat,
+		ModeNumber,
+		ModeInt64,
+		ModeBig,
+	}
+)
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"fmt"
+)
+
+// ErrInvalidColumnName is used when a column name cannot be used in a parquet-go schema tag,
+// since it is empty or contains a comma or equal sign.
+type ErrInvalidColumnName struct {
+	Name string // the name of the column
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidColumnName) Error() string {
+	return fmt.Sprintf("invalid column name %q", e.Name)
+}
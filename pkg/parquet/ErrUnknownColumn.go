@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"fmt"
+)
+
+// ErrUnknownColumn is used when a projected column is not in the schema of the file.
+type ErrUnknownColumn struct {
+	Name string // the name of the column
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownColumn) Error() string {
+	return fmt.Sprintf("unknown column %q", e.Name)
+}
@@ -0,0 +1,168 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+)
+
+// inferred is the set of types observed for the values of a column.
+type inferred struct {
+	boolean bool
+	long    bool
+	double  bool
+	time    bool
+	str     bool
+}
+
+// isNumeric returns true if the string only contains the characters of a decimal number.
+// This excludes values like "Inf" and "NaN" that strconv.ParseFloat accepts.
+func isNumeric(str string) bool {
+	return len(strings.Trim(str, "0123456789+-.eE")) == 0
+}
+
+// observe adds the type of the value to the inferred types.
+func (t *inferred) observe(value interface{}) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return
+	}
+	switch x := v.Interface().(type) {
+	case time.Time:
+		t.time = true
+		return
+	case stdjson.Number:
+		if _, err := x.Int64(); err == nil {
+			t.long = true
+		} else {
+			t.double = true
+		}
+		return
+	case []byte:
+		t.str = true
+		return
+	case string:
+		str := strings.TrimSpace(x)
+		switch {
+		case len(str) == 0:
+		case str == "true" || str == "false":
+			t.boolean = true
+		case len(str) > 1 && str[0] == '0' && str[1] != '.':
+			// Keep leading zeros, e.g., in postal codes.
+			t.str = true
+		case !isNumeric(str):
+			t.str = true
+		default:
+			if _, err := strconv.ParseInt(str, 10, 64); err == nil {
+				t.long = true
+			} else if _, err := strconv.ParseFloat(str, 64); err == nil {
+				t.double = true
+			} else {
+				t.str = true
+			}
+		}
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		t.boolean = true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		t.long = true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		t.long = true
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			t.long = true
+		} else {
+			t.double = true
+		}
+	default:
+		t.str = true
+	}
+}
+
+// column returns the physical and converted type for the inferred types.
+func (t *inferred) column() (string, string) {
+	switch {
+	case t.str:
+	case t.boolean && !t.long && !t.double && !t.time:
+		return "BOOLEAN", ""
+	case t.time && !t.boolean && !t.long && !t.double:
+		return "INT64", "TIMESTAMP_MILLIS"
+	case t.long && !t.double && !t.boolean && !t.time:
+		return "INT64", ""
+	case t.double && !t.boolean && !t.time:
+		return "DOUBLE", ""
+	}
+	return "BYTE_ARRAY", "UTF8"
+}
+
+// InferSchema returns a parquet-go JSON schema with an optional column for each name in the header,
+// with the type of each column inferred from the values of the objects.
+// If the header is empty, then the header is created from the keys of the objects using the sv package,
+// with the keys of the first object sorted alphabetically, followed by new keys in later objects.
+// Columns whose values are all integers, numbers, or booleans, including strings such as "30",
+// become INT64, DOUBLE, or BOOLEAN columns.  All other columns become UTF8 strings.
+func InferSchema(header []interface{}, objects []interface{}) (string, error) {
+	records := make([]map[string]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		record, err := toRecord(obj, nil)
+		if err != nil {
+			return "", err
+		}
+		records = append(records, record)
+	}
+
+	if len(header) == 0 && len(records) > 0 {
+		h, knownKeys := sv.CreateHeaderAndKnownKeys(records[0], true, false)
+		header = h
+		for _, record := range records[1:] {
+			keys := make([]string, 0)
+			for k := range record {
+				if _, ok := knownKeys[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				header = append(header, k)
+				knownKeys[k] = struct{}{}
+			}
+		}
+	}
+
+	fields := make([]*field, 0, len(header))
+	for _, h := range header {
+		name := fmt.Sprint(h)
+		if err := checkName(name); err != nil {
+			return "", err
+		}
+		t := &inferred{}
+		for _, record := range records {
+			t.observe(record[name])
+		}
+		kind, convertedType := t.column()
+		fields = append(fields, &field{Tag: formatTag(name, kind, convertedType, "OPTIONAL")})
+	}
+	return formatSchema(fields)
+}
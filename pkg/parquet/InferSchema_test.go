@@ -0,0 +1,53 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferSchema(t *testing.T) {
+	schema, err := InferSchema(nil, []interface{}{
+		map[string]interface{}{"a": "1", "b": "x", "c": "1.5", "d": "true"},
+		map[string]interface{}{"a": "", "b": "2", "c": "2", "d": "false", "e": "007"},
+		map[string]interface{}{"a": 3, "b": nil, "c": 3, "f": []interface{}{1}},
+	})
+	require.NoError(t, err)
+	columns, err := parseColumns(schema)
+	require.NoError(t, err)
+	require.Equal(t, []column{
+		{name: "a", kind: "INT64"},
+		{name: "b", kind: "BYTE_ARRAY", convertedType: "UTF8"},
+		{name: "c", kind: "DOUBLE"},
+		{name: "d", kind: "BOOLEAN"},
+		{name: "e", kind: "BYTE_ARRAY", convertedType: "UTF8"},
+		{name: "f", kind: "BYTE_ARRAY", convertedType: "UTF8"},
+	}, columns)
+}
+
+func TestInferSchemaHeader(t *testing.T) {
+	schema, err := InferSchema([]interface{}{"b", "a"}, []interface{}{
+		map[string]interface{}{"a": "1", "b": "x", "c": "y"},
+	})
+	require.NoError(t, err)
+	columns, err := parseColumns(schema)
+	require.NoError(t, err)
+	require.Equal(t, []column{
+		{name: "b", kind: "BYTE_ARRAY", convertedType: "UTF8"},
+		{name: "a", kind: "INT64"},
+	}, columns)
+}
+
+func TestInferSchemaInvalid(t *testing.T) {
+	_, err := InferSchema(nil, []interface{}{
+		map[string]interface{}{"a,b": "1"},
+	})
+	require.Equal(t, &ErrInvalidColumnName{Name: "a,b"}, err)
+}
@@ -0,0 +1,143 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates trough the rows of a Parquet file
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type      reflect.Type          // the type of each object.  If nil, then returns each row as a map[string]interface{}.
+	Reader    *reader.ParquetReader // the reader for the row groups of the file
+	Limit     int                   // Limit the number of objects to read and return from the underlying file.
+	Count     int                   // The current count of the number of objects read.
+	Limits    limits.Limits         // The maximum depth and number of keys of each object.
+	converter *converter            // converts rows into maps
+	rows      []interface{}         // the rows read from the file, but not yet returned
+	read      int64                 // the number of rows read from the file
+	stopped   bool                  // true if the reader was stopped
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader  io.Reader
+	Type    reflect.Type  // the type of each object, e.g., map[string]interface{}.  If nil, then returns each row as a map[string]interface{}.
+	Columns []string      // the names of the top-level columns to read.  If empty, then reads all columns.
+	Limit   int           // Limit the number of objects to read and return from the underlying file.
+	Limits  limits.Limits // The maximum depth and number of keys of each object.  Only MaxDepth and MaxKeys are used.
+}
+
+// NewIterator returns a new Parquet iterator base on the given input.
+// NewIterator reads the footer of the file and returns an error if the footer is invalid or a column is not in the file.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+	pf, err := openFile(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr *reader.ParquetReader
+	if len(input.Columns) > 0 {
+		footer := &reader.ParquetReader{PFile: pf}
+		if err := footer.ReadFooter(); err != nil {
+			return nil, errors.Wrap(err, "error reading footer of parquet file")
+		}
+		schema, err := project(footer.Footer.Schema, input.Columns)
+		if err != nil {
+			return nil, errors.Wrap(err, "error projecting columns")
+		}
+		pr, err = reader.NewParquetReader(pf, schema, parallelism)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating parquet reader")
+		}
+	} else {
+		pr, err = reader.NewParquetReader(pf, nil, parallelism)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating parquet reader")
+		}
+	}
+
+	return &Iterator{
+		Type:      input.Type,
+		Reader:    pr,
+		Limit:     input.Limit,
+		Count:     0,
+		Limits:    input.Limits,
+		converter: newConverter(pr.SchemaHandler),
+		rows:      make([]interface{}, 0),
+		read:      0,
+	}, nil
+}
+
+// stop stops the underlying reader, closing the files opened for each column.
+func (it *Iterator) stop() {
+	if !it.stopped {
+		it.Reader.ReadStop()
+		it.stopped = true
+	}
+}
+
+// Next reads from the underlying file and returns the next object and error, if any.
+// Rows are read in batches of DefaultBatchSize.
+// When the file is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		it.stop()
+		return nil, io.EOF
+	}
+
+	if len(it.rows) == 0 {
+		remaining := it.Reader.GetNumRows() - it.read
+		if remaining <= 0 {
+			it.stop()
+			return nil, io.EOF
+		}
+		n := int64(DefaultBatchSize)
+		if remaining < n {
+			n = remaining
+		}
+		rows, err := it.Reader.ReadByNumber(int(n))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading rows %d to %d", it.read+1, it.read+n)
+		}
+		if len(rows) == 0 {
+			it.stop()
+			return nil, io.EOF
+		}
+		it.rows = rows
+		it.read += n
+	}
+
+	row := it.rows[0]
+	it.rows = it.rows[1:]
+
+	// Increment Counter
+	it.Count++
+
+	obj := it.converter.row(reflect.ValueOf(row))
+
+	if it.Type != nil && !reflect.TypeOf(obj).AssignableTo(it.Type) {
+		return nil, errors.Errorf("error reading row %d: cannot read %T into %s", it.Count, obj, it.Type)
+	}
+
+	if err := it.Limits.Check(obj); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of row %d", it.Count)
+	}
+
+	return obj, nil
+}
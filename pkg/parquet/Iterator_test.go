@@ -0,0 +1,105 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func testFile(t *testing.T) *bytes.Reader {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: []map[string]interface{}{
+			map[string]interface{}{"a": "x", "b": 1, "c": true},
+			map[string]interface{}{"a": "y", "b": 2, "c": false},
+			map[string]interface{}{"a": "z", "b": 3, "c": nil},
+		},
+	})
+	require.NoError(t, err)
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestIterator(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]interface{}{}),
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "x", "b": int64(1), "c": true}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "y", "b": int64(2), "c": false}, obj)
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorColumns(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:  testFile(t),
+		Columns: []string{"c", "a"},
+	})
+	require.NoError(t, err)
+	expected := []interface{}{
+		map[string]interface{}{"a": "x", "c": true},
+		map[string]interface{}{"a": "y", "c": false},
+		map[string]interface{}{"a": "z", "c": nil},
+	}
+	for _, e := range expected {
+		obj, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, e, obj)
+	}
+	_, err = it.Next()
+	require.Equal(t, io.EOF, err)
+
+	_, err = NewIterator(&NewIteratorInput{
+		Reader:  testFile(t),
+		Columns: []string{"d"},
+	})
+	require.Error(t, err)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Limits: limits.Limits{MaxKeys: 1},
+	})
+	require.NoError(t, err)
+	_, err = it.Next()
+	require.Error(t, err)
+}
+
+func TestIteratorType(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]string{}),
+	})
+	require.NoError(t, err)
+	_, err = it.Next()
+	require.Error(t, err)
+}
+
+func TestIteratorInvalid(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader("{\"a\": 1}\n"),
+	})
+	require.Error(t, err)
+}
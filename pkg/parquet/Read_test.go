@@ -0,0 +1,29 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Type:    reflect.TypeOf([]interface{}{}),
+		Reader:  testFile(t),
+		Columns: []string{"b"},
+		Limit:   2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"b": int64(1)},
+		map[string]interface{}{"b": int64(2)},
+	}, out)
+}
@@ -0,0 +1,82 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// leafType returns the physical type, converted type, and repetition type for a Go type.
+// Types without a matching parquet type, e.g., maps, slices, and structs, are written as JSON strings.
+func leafType(t reflect.Type) (string, string, string) {
+	repetitionType := "REQUIRED"
+	if t.Kind() == reflect.Ptr {
+		repetitionType = "OPTIONAL"
+		t = t.Elem()
+	}
+	if t == timeType {
+		return "INT64", "TIMESTAMP_MILLIS", repetitionType
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "BOOLEAN", "", repetitionType
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return "INT32", "", repetitionType
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return "INT64", "", repetitionType
+	case reflect.Float32:
+		return "FLOAT", "", repetitionType
+	case reflect.Float64:
+		return "DOUBLE", "", repetitionType
+	case reflect.String:
+		return "BYTE_ARRAY", "UTF8", repetitionType
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "BYTE_ARRAY", "", repetitionType
+		}
+	}
+	return "BYTE_ARRAY", "UTF8", "OPTIONAL"
+}
+
+// SchemaFromType returns the parquet-go JSON schema for the struct type, with a column for each exported field.
+// The name of each column is taken from the parquet tag, the json tag, or the name of the field.
+// If the parquet tag of a field includes a primitive type, e.g., `parquet:"name=id, type=INT64"`, then the tag is used as is.
+// Otherwise, pointers become optional columns and time.Time becomes a TIMESTAMP_MILLIS column.
+func SchemaFromType(t reflect.Type) (string, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", errors.Errorf("type %s is not a struct", t)
+	}
+	fields := make([]*field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, ok := fieldName(sf)
+		if !ok {
+			continue
+		}
+		if err := checkName(name); err != nil {
+			return "", err
+		}
+		if tag, ok := sf.Tag.Lookup("parquet"); ok {
+			switch strings.ToUpper(parseTag(tag)["type"]) {
+			case "", "LIST", "MAP":
+			default:
+				fields = append(fields, &field{Tag: tag})
+				continue
+			}
+		}
+		kind, convertedType, repetitionType := leafType(sf.Type)
+		fields = append(fields, &field{Tag: formatTag(name, kind, convertedType, repetitionType)})
+	}
+	return formatSchema(fields)
+}
@@ -0,0 +1,60 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaFromType(t *testing.T) {
+	in := struct {
+		Name     string            `json:"name"`
+		Count    int               `parquet:"name=count"`
+		Ratio    *float64          `json:"ratio"`
+		Small    int16             `json:"small"`
+		Valid    bool              `json:"valid"`
+		Created  time.Time         `json:"created"`
+		Data     []byte            `json:"data"`
+		Tags     map[string]string `json:"tags"`
+		Ignored  string            `json:"-"`
+		internal string
+	}{}
+	schema, err := SchemaFromType(reflect.TypeOf(in))
+	require.NoError(t, err)
+	columns, err := parseColumns(schema)
+	require.NoError(t, err)
+	require.Equal(t, []column{
+		{name: "name", kind: "BYTE_ARRAY", convertedType: "UTF8"},
+		{name: "count", kind: "INT64"},
+		{name: "ratio", kind: "DOUBLE"},
+		{name: "small", kind: "INT32"},
+		{name: "valid", kind: "BOOLEAN"},
+		{name: "created", kind: "INT64", convertedType: "TIMESTAMP_MILLIS"},
+		{name: "data", kind: "BYTE_ARRAY"},
+		{name: "tags", kind: "BYTE_ARRAY", convertedType: "UTF8"},
+	}, columns)
+}
+
+func TestSchemaFromTypeInvalid(t *testing.T) {
+	_, err := SchemaFromType(reflect.TypeOf(""))
+	require.Error(t, err)
+
+	_, err = SchemaFromType(reflect.TypeOf(struct {
+		A string `json:"a,b=c"`
+	}{}))
+	require.NoError(t, err)
+
+	_, err = SchemaFromType(reflect.TypeOf(struct {
+		A string `json:"a=b"`
+	}{}))
+	require.Error(t, err)
+}
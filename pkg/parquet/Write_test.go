@@ -0,0 +1,37 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Header: []interface{}{"b", "a"},
+		Codec:  CodecZstd,
+		Object: []interface{}{
+			map[string]interface{}{"a": "1", "b": "x"},
+			map[string]interface{}{"a": "2", "b": "y"},
+			map[string]interface{}{"a": "3", "b": "z"},
+		},
+		Limit: 2,
+	})
+	require.NoError(t, err)
+	out, err := Read(&ReadInput{Reader: bytes.NewReader(buf.Bytes())})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"a": int64(1), "b": "x"},
+		map[string]interface{}{"a": int64(2), "b": "y"},
+	}, out)
+}
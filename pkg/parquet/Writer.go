@@ -0,0 +1,222 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	stdjson "encoding/json"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as a Parquet file.
+// If no schema is given and the objects are maps, then the writer buffers the first objects to infer the schema.
+// Rows are written in row groups, and the footer is written when the writer is flushed.
+type Writer struct {
+	writer        io.Writer // writer for the underlying stream
+	schema        string    // the schema in the JSON format used by parquet-go.  If empty, the schema is created from the objects.
+	header        []interface{}
+	codec         parquet.CompressionCodec
+	sampleSize    int // the number of objects used to infer the schema
+	keySerializer stringify.Stringer
+	columns       map[string]column  // the top-level columns of the schema by name
+	jsonWriter    *writer.JSONWriter // the writer for the parquet file, created once the schema is known
+	objects       []interface{}      // the objects buffered to infer the schema
+	closed        bool               // true if the footer has been written
+}
+
+// NewWriter returns a writer for formatting and writing objects to the underlying writer as a Parquet file.
+// If the schema is blank, then the schema is created from the type of the first object if a struct,
+// or inferred from the header and the first objects written, up to sampleSize.
+// If sampleSize is less than 1, then uses DefaultSampleSize.
+// If the codec is blank, then uses DefaultCodec.
+func NewWriter(w io.Writer, schema string, header []interface{}, codec string, sampleSize int, keySerializer stringify.Stringer) (*Writer, error) {
+	if len(codec) == 0 {
+		codec = DefaultCodec
+	}
+	if !stringSliceContains(Codecs, codec) {
+		return nil, &ErrInvalidCodec{Value: codec}
+	}
+	compressionCodec, err := parquet.CompressionCodecFromString(strings.ToUpper(codec))
+	if err != nil {
+		return nil, &ErrInvalidCodec{Value: codec}
+	}
+	if sampleSize < 1 {
+		sampleSize = DefaultSampleSize
+	}
+	pw := &Writer{
+		writer:        w,
+		schema:        schema,
+		header:        header,
+		codec:         compressionCodec,
+		sampleSize:    sampleSize,
+		keySerializer: keySerializer,
+		objects:       make([]interface{}, 0),
+	}
+	if len(schema) > 0 {
+		if err := pw.open(); err != nil {
+			return nil, err
+		}
+	}
+	return pw, nil
+}
+
+// open creates the schema, if not given, and writes the header of the Parquet file.
+func (w *Writer) open() error {
+	if len(w.schema) == 0 {
+		if len(w.objects) > 0 {
+			if t := reflect.TypeOf(w.objects[0]); t != nil && (t.Kind() == reflect.Struct || (t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct)) {
+				schema, err := SchemaFromType(t)
+				if err != nil {
+					return errors.Wrap(err, "error creating schema from type")
+				}
+				w.schema = schema
+			}
+		}
+		if len(w.schema) == 0 {
+			schema, err := InferSchema(w.header, w.objects)
+			if err != nil {
+				return errors.Wrap(err, "error inferring schema")
+			}
+			w.schema = schema
+		}
+	}
+	columns, err := parseColumns(w.schema)
+	if err != nil {
+		return err
+	}
+	w.columns = map[string]column{}
+	for _, c := range columns {
+		w.columns[c.name] = c
+	}
+	jw, err := writer.NewJSONWriter(w.schema, writerfile.NewWriterFile(w.writer), parallelism)
+	if err != nil {
+		return errors.Wrap(err, "error creating parquet writer")
+	}
+	jw.CompressionType = w.codec
+	w.jsonWriter = jw
+	return nil
+}
+
+// write converts the object into a row and writes it to the parquet writer.
+func (w *Writer) write(obj interface{}) error {
+	record, err := toRecord(obj, w.keySerializer)
+	if err != nil {
+		return err
+	}
+	record, err = coerce(record, w.columns)
+	if err != nil {
+		return err
+	}
+	b, err := stdjson.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling row")
+	}
+	if err := w.jsonWriter.Write(string(b)); err != nil {
+		return errors.Wrap(err, "error writing row")
+	}
+	return nil
+}
+
+// writeBuffered writes the objects buffered to infer the schema.
+func (w *Writer) writeBuffered() error {
+	for _, obj := range w.objects {
+		if err := w.write(obj); err != nil {
+			return err
+		}
+	}
+	w.objects = w.objects[:0]
+	return nil
+}
+
+// WriteObject writes the object as a row, or buffers the object if the schema is not yet known.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.closed {
+		return ErrClosed
+	}
+	if w.jsonWriter != nil {
+		return w.write(obj)
+	}
+	w.objects = append(w.objects, obj)
+	t := reflect.TypeOf(obj)
+	isStruct := t != nil && (t.Kind() == reflect.Struct || (t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct))
+	if !isStruct && len(w.objects) < w.sampleSize {
+		return nil
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	return w.writeBuffered()
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the remaining row group and the footer of the Parquet file,
+// and then flushes the underlying writer, if it has a Flush method.
+// Since the footer ends the file, no more objects can be written after the writer is flushed.
+// If no schema was given and no objects were written, then nothing is written.
+func (w *Writer) Flush() error {
+	if !w.closed {
+		if w.jsonWriter == nil && (len(w.schema) > 0 || len(w.objects) > 0) {
+			if err := w.open(); err != nil {
+				return err
+			}
+			if err := w.writeBuffered(); err != nil {
+				return err
+			}
+		}
+		if w.jsonWriter != nil {
+			if err := w.jsonWriter.WriteStop(); err != nil {
+				return errors.Wrap(err, "error writing footer")
+			}
+		}
+		w.closed = true
+	}
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
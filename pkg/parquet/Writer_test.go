@@ -0,0 +1,104 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriter(t *testing.T) {
+	for _, codec := range Codecs {
+		buf := new(bytes.Buffer)
+		w, err := NewWriter(buf, "", nil, codec, 2, stringify.NewStringer("", false, false, false))
+		require.NoError(t, err)
+		require.NoError(t, w.WriteObject(map[string]interface{}{"a": "x", "b": "1"}))
+		require.NoError(t, w.WriteObject(map[string]interface{}{"a": "y", "c": []interface{}{true}}))
+		require.NoError(t, w.WriteObject(map[string]interface{}{"b": 3}))
+		require.NoError(t, w.Flush())
+		require.Equal(t, ErrClosed, w.WriteObject(map[string]interface{}{"a": "z"}))
+
+		out, err := Read(&ReadInput{Reader: bytes.NewReader(buf.Bytes())})
+		require.NoError(t, err)
+		require.Equal(t, []map[string]interface{}{
+			map[string]interface{}{"a": "x", "b": int64(1), "c": nil},
+			map[string]interface{}{"a": "y", "b": nil, "c": "[true]"},
+			map[string]interface{}{"a": nil, "b": int64(3), "c": nil},
+		}, out)
+	}
+}
+
+func TestWriterStruct(t *testing.T) {
+	type place struct {
+		Name       string    `json:"name"`
+		Population int       `json:"population"`
+		Area       *float64  `json:"area"`
+		Founded    time.Time `json:"founded"`
+	}
+	area := 1.5
+	founded := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "", nil, CodecGzip, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteObject(place{Name: "a", Population: 30, Area: &area, Founded: founded}))
+	require.NoError(t, w.WriteObject(&place{Name: "b"}))
+	require.NoError(t, w.Flush())
+
+	out, err := Read(&ReadInput{Reader: bytes.NewReader(buf.Bytes())})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"name": "a", "population": int64(30), "area": 1.5, "founded": founded},
+		map[string]interface{}{"name": "b", "population": int64(0), "area": nil, "founded": time.Time{}},
+	}, out)
+}
+
+func TestWriterSchema(t *testing.T) {
+	schema := `{
+		"Tag": "name=parquet_go_root",
+		"Fields": [
+			{"Tag": "name=name, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=REQUIRED"},
+			{"Tag": "name=population, type=INT64, repetitiontype=OPTIONAL"},
+			{"Tag": "name=area, type=DOUBLE, repetitiontype=OPTIONAL"}
+		]
+	}`
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, schema, nil, "", 0, nil)
+	require.NoError(t, err)
+	// Strings are converted into the types given by the schema.
+	require.NoError(t, w.WriteObject(map[string]string{"name": "a", "population": "30", "area": "1.5"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"name": "b", "population": ""}))
+	require.NoError(t, w.Flush())
+
+	out, err := Read(&ReadInput{Reader: bytes.NewReader(buf.Bytes())})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"name": "a", "population": int64(30), "area": 1.5},
+		map[string]interface{}{"name": "b", "population": nil, "area": nil},
+	}, out)
+}
+
+func TestWriterInvalid(t *testing.T) {
+	_, err := NewWriter(new(bytes.Buffer), "", nil, "deflate", 0, nil)
+	require.Equal(t, &ErrInvalidCodec{Value: "deflate"}, err)
+
+	_, err = NewWriter(new(bytes.Buffer), `{"Tag": "name=root", "Fields": [{"Tag": "name=a, type=UNKNOWN"}]}`, nil, "", 0, nil)
+	require.Error(t, err)
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "", nil, "", 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	require.Equal(t, 0, buf.Len())
+}
@@ -0,0 +1,156 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/types"
+)
+
+// converter converts the rows read by parquet-go, which are structs created at runtime, into maps.
+type converter struct {
+	handler  *schema.SchemaHandler
+	children [][]int // the indices of the children of each element of the schema
+}
+
+func newConverter(handler *schema.SchemaHandler) *converter {
+	elements := handler.SchemaElements
+	children := make([][]int, len(elements))
+	stack := make([][2]int, 0) // the index of the element and the number of children remaining
+	for i, e := range elements {
+		for len(stack) > 0 && stack[len(stack)-1][1] == 0 {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			parent := stack[len(stack)-1][0]
+			children[parent] = append(children[parent], i)
+			stack[len(stack)-1][1]--
+		}
+		if n := int(e.GetNumChildren()); n > 0 {
+			stack = append(stack, [2]int{i, n})
+		}
+	}
+	return &converter{handler: handler, children: children}
+}
+
+// isList returns true if the element is a list with the three-level structure created by parquet-go.
+func (c *converter) isList(i int) bool {
+	e := c.handler.SchemaElements[i]
+	return e.IsSetConvertedType() && e.GetConvertedType() == parquet.ConvertedType_LIST &&
+		len(c.children[i]) == 1 && len(c.children[c.children[i][0]]) == 1
+}
+
+// isMap returns true if the element is a map with the key_value structure created by parquet-go.
+func (c *converter) isMap(i int) bool {
+	e := c.handler.SchemaElements[i]
+	return e.IsSetConvertedType() && e.GetConvertedType() == parquet.ConvertedType_MAP &&
+		len(c.children[i]) == 1 && len(c.children[c.children[i][0]]) == 2
+}
+
+// row converts a row into a map of column names to values.
+func (c *converter) row(v reflect.Value) map[string]interface{} {
+	return c.group(v, 0)
+}
+
+func (c *converter) group(v reflect.Value, i int) map[string]interface{} {
+	m := make(map[string]interface{}, len(c.children[i]))
+	for j, child := range c.children[i] {
+		m[c.handler.Infos[child].ExName] = c.value(v.Field(j), child)
+	}
+	return m
+}
+
+func (c *converter) value(v reflect.Value, i int) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	e := c.handler.SchemaElements[i]
+	switch {
+	case e.GetNumChildren() == 0:
+		if v.Kind() == reflect.Slice && e.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+			if v.IsNil() {
+				return nil
+			}
+			out := make([]interface{}, 0, v.Len())
+			for j := 0; j < v.Len(); j++ {
+				out = append(out, c.leaf(v.Index(j), e))
+			}
+			return out
+		}
+		return c.leaf(v, e)
+	case c.isList(i) && v.Kind() == reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		element := c.children[c.children[i][0]][0]
+		out := make([]interface{}, 0, v.Len())
+		for j := 0; j < v.Len(); j++ {
+			out = append(out, c.value(v.Index(j), element))
+		}
+		return out
+	case c.isMap(i) && v.Kind() == reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		value := c.children[c.children[i][0]][1]
+		out := make(map[string]interface{}, v.Len())
+		it := v.MapRange()
+		for it.Next() {
+			out[fmt.Sprint(it.Key().Interface())] = c.value(it.Value(), value)
+		}
+		return out
+	case v.Kind() == reflect.Slice:
+		// A repeated group
+		if v.IsNil() {
+			return nil
+		}
+		out := make([]interface{}, 0, v.Len())
+		for j := 0; j < v.Len(); j++ {
+			out = append(out, c.group(v.Index(j), i))
+		}
+		return out
+	case v.Kind() == reflect.Struct:
+		return c.group(v, i)
+	}
+	return v.Interface()
+}
+
+// leaf converts the value of a primitive column, converting timestamps into time.Time.
+func (c *converter) leaf(v reflect.Value, e *parquet.SchemaElement) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	x := v.Interface()
+	if e.GetType() == parquet.Type_INT96 {
+		if s, ok := x.(string); ok && len(s) == 12 {
+			return types.INT96ToTime(s).UTC()
+		}
+	}
+	if e.IsSetConvertedType() {
+		if i, ok := x.(int64); ok {
+			switch e.GetConvertedType() {
+			case parquet.ConvertedType_TIMESTAMP_MILLIS:
+				return time.Unix(i/1000, (i%1000)*int64(time.Millisecond)).UTC()
+			case parquet.ConvertedType_TIMESTAMP_MICROS:
+				return time.Unix(i/1000000, (i%1000000)*int64(time.Microsecond)).UTC()
+			}
+		}
+	}
+	return x
+}
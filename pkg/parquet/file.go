@@ -0,0 +1,83 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+// openFile returns a parquet-go file for the reader.
+// Regular files are reopened by name, so each column can be read independently.
+// Other readers are read into memory.
+func openFile(r io.Reader) (source.ParquetFile, error) {
+	if f, ok := r.(*os.File); ok {
+		if fi, err := f.Stat(); err == nil && fi.Mode().IsRegular() {
+			pf, err := local.NewLocalFileReader(f.Name())
+			if err != nil {
+				return nil, errors.Wrapf(err, "error opening file %q", f.Name())
+			}
+			return pf, nil
+		}
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading parquet file into memory")
+	}
+	return buffer.NewBufferFileFromBytes(b), nil
+}
+
+// subtree returns the index after the end of the subtree that starts at the given index of the schema.
+func subtree(schema []*parquet.SchemaElement, i int) int {
+	remaining := 1
+	for remaining > 0 && i < len(schema) {
+		remaining += int(schema[i].GetNumChildren()) - 1
+		i++
+	}
+	return i
+}
+
+// project returns a copy of the schema that only includes the given top-level columns.
+func project(schema []*parquet.SchemaElement, columns []string) ([]*parquet.SchemaElement, error) {
+	ranges := map[string][2]int{}
+	names := make([]string, 0)
+	for i := 1; i < len(schema); {
+		end := subtree(schema, i)
+		ranges[schema[i].GetName()] = [2]int{i, end}
+		names = append(names, schema[i].GetName())
+		i = end
+	}
+	for _, c := range columns {
+		if _, ok := ranges[c]; !ok {
+			return nil, &ErrUnknownColumn{Name: c}
+		}
+	}
+	root := *schema[0]
+	numChildren := int32(0)
+	projected := []*parquet.SchemaElement{&root}
+	for _, name := range names {
+		if !stringSliceContains(columns, name) {
+			continue
+		}
+		numChildren++
+		r := ranges[name]
+		for i := r[0]; i < r[1]; i++ {
+			element := *schema[i]
+			projected = append(projected, &element)
+		}
+	}
+	root.NumChildren = &numChildren
+	return projected, nil
+}
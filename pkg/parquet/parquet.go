@@ -0,0 +1,75 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package parquet provides a simple API for reading and writing Apache Parquet files.
+// parquet also supports iterators and writers for efficiently processing a stream.
+// This package wraps the xitongsys parquet-go package.
+//
+// When reading, each row is returned as a map keyed by the column names in the file.
+// The columns to read can be limited with a projection, so the column chunks of other columns are never read.
+// Parquet files store their metadata at the end of the file, so reading requires a seekable file.
+// If the reader is an *os.File, then the file is reopened by name for each column,
+// otherwise the input is read into memory.
+//
+// When writing, the schema can be given in the JSON format used by parquet-go, e.g.,
+//	{"Tag": "name=root", "Fields": [{"Tag": "name=id, type=INT64, repetitiontype=REQUIRED"}]}
+// Otherwise, the schema is created from the type of the objects if they are structs,
+// or inferred from the first objects written if they are maps.
+// When inferring a schema, each key becomes an optional column,
+// and strings that are all integers, numbers, or booleans become INT64, DOUBLE, or BOOLEAN columns.
+// Nested maps and slices are written as JSON strings.
+//
+// References:
+//	- https://parquet.apache.org/documentation/latest/
+//	- https://godoc.org/github.com/xitongsys/parquet-go
+//
+package parquet
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	CodecUncompressed = "uncompressed" // pages are not compressed
+	CodecSnappy       = "snappy"       // pages are compressed with snappy
+	CodecGzip         = "gzip"         // pages are compressed with gzip
+	CodecLZ4          = "lz4"          // pages are compressed with lz4
+	CodecZstd         = "zstd"         // pages are compressed with zstandard
+)
+
+const (
+	DefaultCodec      = CodecSnappy
+	DefaultSampleSize = 100  // the default number of objects used to infer the schema
+	DefaultBatchSize  = 1000 // the number of rows read from the file at a time
+	DefaultRootName   = "parquet_go_root"
+)
+
+// parallelism is the number of goroutines used by parquet-go to read and write columns.
+const parallelism = 1
+
+var (
+	Codecs = []string{
+		CodecUncompressed,
+		CodecSnappy,
+		CodecGzip,
+		CodecLZ4,
+		CodecZstd,
+	}
+)
+
+var (
+	ErrClosed = errors.New("writer is closed")
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
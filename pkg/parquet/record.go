@@ -0,0 +1,144 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// fieldName returns the column name for the struct field and true,
+// or false if the field is unexported or ignored.
+// The name is taken from the parquet tag, then the json tag, and then the name of the field.
+func fieldName(sf reflect.StructField) (string, bool) {
+	if len(sf.PkgPath) > 0 {
+		return "", false
+	}
+	if tag, ok := sf.Tag.Lookup("parquet"); ok {
+		if name, ok := parseTag(tag)["name"]; ok && len(name) > 0 {
+			return name, true
+		}
+	}
+	if tag, ok := sf.Tag.Lookup("json"); ok {
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			return "", false
+		}
+		if len(name) > 0 {
+			return name, true
+		}
+	}
+	return sf.Name, true
+}
+
+// toRecord converts the map or struct into a map of column names to values.
+func toRecord(obj interface{}, keySerializer stringify.Stringer) (map[string]interface{}, error) {
+	v := reflect.ValueOf(obj)
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, errors.New("object is nil")
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		record := make(map[string]interface{}, v.Len())
+		it := v.MapRange()
+		for it.Next() {
+			k := fmt.Sprint(it.Key().Interface())
+			if keySerializer != nil {
+				str, err := keySerializer(it.Key().Interface())
+				if err != nil {
+					return nil, errors.Wrap(err, "error serializing key")
+				}
+				k = str
+			}
+			record[k] = it.Value().Interface()
+		}
+		return record, nil
+	case reflect.Struct:
+		t := v.Type()
+		record := make(map[string]interface{}, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			if name, ok := fieldName(t.Field(i)); ok {
+				record[name] = v.Field(i).Interface()
+			}
+		}
+		return record, nil
+	}
+	return nil, errors.Errorf("object of type %T is not a map or struct", obj)
+}
+
+// coerce converts the values of the record into values parquet-go can write to the columns.
+// Times are converted into milliseconds for INT64 columns, nested values are converted into JSON for string columns,
+// and blank strings are converted to null for other columns.
+func coerce(record map[string]interface{}, columns map[string]column) (map[string]interface{}, error) {
+	for k, value := range record {
+		c, ok := columns[k]
+		if !ok {
+			continue
+		}
+		v := reflect.ValueOf(value)
+		for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+			if v.IsNil() {
+				v = reflect.Value{}
+				break
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			record[k] = nil
+			continue
+		}
+		value = v.Interface()
+		if t, ok := value.(time.Time); ok {
+			if c.kind == "INT64" {
+				record[k] = t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+			} else {
+				record[k] = t.Format(time.RFC3339Nano)
+			}
+			continue
+		}
+		switch c.kind {
+		case "BYTE_ARRAY", "FIXED_LEN_BYTE_ARRAY":
+			if b, ok := value.([]byte); ok {
+				record[k] = string(b)
+				continue
+			}
+			switch v.Kind() {
+			case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+				b, err := stdjson.Marshal(value)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error marshaling value for column %q", k)
+				}
+				record[k] = string(b)
+				continue
+			}
+		case "BOOLEAN", "INT32", "INT64", "INT96", "FLOAT", "DOUBLE":
+			if str, ok := value.(string); ok {
+				if str = strings.TrimSpace(str); len(str) == 0 {
+					record[k] = nil
+				} else {
+					record[k] = str
+				}
+				continue
+			}
+		}
+		record[k] = value
+	}
+	return record, nil
+}
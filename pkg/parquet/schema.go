@@ -0,0 +1,86 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package parquet
+
+import (
+	stdjson "encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// field is an item of a schema in the JSON format used by parquet-go.
+type field struct {
+	Tag    string   `json:"Tag"`
+	Fields []*field `json:"Fields,omitempty"`
+}
+
+// column is a top-level column of a schema.
+type column struct {
+	name          string // the name of the column
+	kind          string // the physical type, e.g., INT64, or a group type, e.g., LIST.  Empty for structs.
+	convertedType string // the converted type, e.g., UTF8.
+}
+
+// parseTag parses a parquet-go tag, e.g., "name=a, type=INT64", into a map of lower case keys to values.
+func parseTag(tag string) map[string]string {
+	m := map[string]string{}
+	for _, part := range strings.Split(tag, ",") {
+		if i := strings.Index(part, "="); i >= 0 {
+			m[strings.ToLower(strings.TrimSpace(part[0:i]))] = strings.TrimSpace(part[i+1:])
+		}
+	}
+	return m
+}
+
+// formatTag formats a tag for a leaf column.
+func formatTag(name string, kind string, convertedType string, repetitionType string) string {
+	tag := "name=" + name + ", type=" + kind
+	if len(convertedType) > 0 {
+		tag += ", convertedtype=" + convertedType
+	}
+	return tag + ", repetitiontype=" + repetitionType
+}
+
+// checkName returns an error if the name cannot be used in a tag.
+func checkName(name string) error {
+	if len(name) == 0 || strings.ContainsAny(name, ",=") {
+		return &ErrInvalidColumnName{Name: name}
+	}
+	return nil
+}
+
+// formatSchema formats the fields as a schema in the JSON format used by parquet-go.
+func formatSchema(fields []*field) (string, error) {
+	b, err := stdjson.Marshal(&field{
+		Tag:    "name=" + DefaultRootName + ", repetitiontype=REQUIRED",
+		Fields: fields,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling schema")
+	}
+	return string(b), nil
+}
+
+// parseColumns returns the top-level columns of the schema.
+func parseColumns(schema string) ([]column, error) {
+	root := &field{}
+	if err := stdjson.Unmarshal([]byte(schema), root); err != nil {
+		return nil, errors.Wrap(err, "error parsing schema")
+	}
+	columns := make([]column, 0, len(root.Fields))
+	for _, f := range root.Fields {
+		tag := parseTag(f.Tag)
+		columns = append(columns, column{
+			name:          tag["name"],
+			kind:          strings.ToUpper(tag["type"]),
+			convertedType: strings.ToUpper(tag["convertedtype"]),
+		})
+	}
+	return columns, nil
+}
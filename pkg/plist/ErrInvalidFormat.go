@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"fmt"
+)
+
+// ErrInvalidFormat is used when the format of a property list is not one of the supported formats.
+type ErrInvalidFormat struct {
+	Value string // the invalid format
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidFormat) Error() string {
+	return fmt.Sprintf("invalid format %q, expecting one of %q", e.Value, Formats)
+}
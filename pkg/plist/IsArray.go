@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// IsArray returns true if the root of the XML or binary property list is an array.
+// IsArray only inspects the root element, so it is cheaper than parsing the property list.
+func IsArray(b []byte) bool {
+	if bytes.HasPrefix(b, []byte("bplist00")) {
+		return isBinaryArray(b)
+	}
+	i := bytes.Index(b, []byte("<plist"))
+	if i == -1 {
+		return false
+	}
+	b = b[i:]
+	i = bytes.IndexByte(b, '>')
+	if i == -1 {
+		return false
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(b[i+1:]), []byte("<array"))
+}
+
+// isBinaryArray returns true if the root object of the binary property list is an array.
+// The trailer at the end of the file includes the index of the root object and the location of the offset table.
+func isBinaryArray(b []byte) bool {
+	if len(b) < 8+32 {
+		return false
+	}
+	trailer := b[len(b)-32:]
+	offsetSize := int(trailer[6])
+	if offsetSize < 1 || offsetSize > 8 {
+		return false
+	}
+	root := binary.BigEndian.Uint64(trailer[16:24])
+	offsetTable := binary.BigEndian.Uint64(trailer[24:32])
+	start := offsetTable + root*uint64(offsetSize)
+	if start < offsetTable || start+uint64(offsetSize) > uint64(len(b)) {
+		return false
+	}
+	offset := uint64(0)
+	for _, x := range b[start : start+uint64(offsetSize)] {
+		offset = offset<<8 | uint64(x)
+	}
+	if offset >= uint64(len(b)) {
+		return false
+	}
+	// Arrays have a marker of 0xA0 with the length of the array in the low bits.
+	return b[offset]&0xF0 == 0xA0
+}
@@ -0,0 +1,31 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsArray(t *testing.T) {
+	assert.True(t, IsArray([]byte(`<?xml version="1.0"?><plist version="1.0">
+  <array><string>a</string></array></plist>`)))
+	assert.False(t, IsArray([]byte(`<plist version="1.0"><dict><key>a</key><array/></dict></plist>`)))
+	assert.False(t, IsArray([]byte(`{"a": 1}`)))
+}
+
+func TestIsArrayBinary(t *testing.T) {
+	b, err := Marshal([]interface{}{"a", map[string]interface{}{"b": 1}}, FormatBinary, false)
+	assert.NoError(t, err)
+	assert.True(t, IsArray(b))
+	b, err = Marshal(map[string]interface{}{"b": []interface{}{1}}, FormatBinary, false)
+	assert.NoError(t, err)
+	assert.False(t, IsArray(b))
+	assert.False(t, IsArray(b[0:20]))
+}
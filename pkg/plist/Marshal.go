@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"github.com/pkg/errors"
+	"howett.net/plist"
+)
+
+// Marshal formats an object as a property list in the given format, either "xml" or "binary".
+// If the format is blank, then uses DefaultFormat.
+// If pretty is true, then XML is indented with tabs, as written by Apple tools.
+// XML property lists end with a new line.
+func Marshal(obj interface{}, format string, pretty bool) ([]byte, error) {
+	if len(format) == 0 {
+		format = DefaultFormat
+	}
+	switch format {
+	case FormatBinary:
+		b, err := plist.Marshal(obj, plist.BinaryFormat)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error marshaling binary property list")
+		}
+		return b, nil
+	case FormatXML:
+		var b []byte
+		var err error
+		if pretty {
+			b, err = plist.MarshalIndent(obj, plist.XMLFormat, "\t")
+		} else {
+			b, err = plist.Marshal(obj, plist.XMLFormat)
+		}
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error marshaling XML property list")
+		}
+		return append(b, '\n'), nil
+	}
+	return make([]byte, 0), &ErrInvalidFormat{Value: format}
+}
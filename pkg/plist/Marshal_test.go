@@ -0,0 +1,53 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalXML(t *testing.T) {
+	in := map[string]interface{}{"a": 1, "b": nil}
+	b, err := Marshal(in, FormatXML, false)
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0"><dict><key>a</key><integer>1</integer></dict></plist>
+`, string(b))
+}
+
+func TestMarshalXMLPretty(t *testing.T) {
+	in := map[string]interface{}{"d": time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), "e": []byte("hi")}
+	b, err := Marshal(in, "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+	<dict>
+		<key>d</key>
+		<date>2020-01-02T03:04:05Z</date>
+		<key>e</key>
+		<data>aGk=</data>
+	</dict>
+</plist>
+`, string(b))
+}
+
+func TestMarshalBinary(t *testing.T) {
+	b, err := Marshal([]interface{}{"a"}, FormatBinary, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "bplist00", string(b[0:8]))
+}
+
+func TestMarshalInvalidFormat(t *testing.T) {
+	_, err := Marshal(map[string]interface{}{}, "openstep", false)
+	assert.Equal(t, &ErrInvalidFormat{Value: "openstep"}, err)
+}
@@ -0,0 +1,30 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"github.com/pkg/errors"
+	"howett.net/plist"
+)
+
+// Unmarshal parses a property list in any format into an object.
+// If no input is given, then returns ErrEmptyInput.
+func Unmarshal(b []byte) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	var obj interface{}
+	_, err := plist.Unmarshal(b, &obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling property list")
+	}
+
+	return normalize(obj), nil
+}
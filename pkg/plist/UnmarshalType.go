@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"howett.net/plist"
+)
+
+// UnmarshalType parses a property list in any format into an object of the given type.
+// If no input is given, then returns ErrEmptyInput.
+func UnmarshalType(b []byte, outputType reflect.Type) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	ptr := reflect.New(outputType)
+	if outputType.Kind() == reflect.Map {
+		ptr.Elem().Set(reflect.MakeMap(outputType))
+	}
+	_, err := plist.Unmarshal(b, ptr.Interface())
+	if err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling property list")
+	}
+
+	return normalize(ptr.Elem().Interface()), nil
+}
@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTypeMap(t *testing.T) {
+	in := []byte(`<plist version="1.0"><dict><key>a</key><integer>1</integer></dict></plist>`)
+	obj, err := UnmarshalType(in, reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": int64(1)}, obj)
+}
+
+func TestUnmarshalTypeSlice(t *testing.T) {
+	in := []byte(`<plist version="1.0"><array><string>a</string><integer>2</integer></array></plist>`)
+	obj, err := UnmarshalType(in, reflect.TypeOf([]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", int64(2)}, obj)
+}
+
+func TestUnmarshalTypeMismatch(t *testing.T) {
+	in := []byte(`<plist version="1.0"><array><string>a</string></array></plist>`)
+	_, err := UnmarshalType(in, reflect.TypeOf(map[string]interface{}{}))
+	assert.Error(t, err)
+}
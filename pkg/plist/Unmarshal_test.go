@@ -0,0 +1,71 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalEmpty(t *testing.T) {
+	obj, err := Unmarshal([]byte{})
+	assert.Equal(t, err, ErrEmptyInput)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	in := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>com.example.agent</string>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>StartInterval</key>
+	<integer>300</integer>
+	<key>Offset</key>
+	<integer>-1</integer>
+	<key>Ratio</key>
+	<real>0.5</real>
+	<key>Updated</key>
+	<date>2020-01-02T03:04:05Z</date>
+	<key>Token</key>
+	<data>aGk=</data>
+	<key>ProgramArguments</key>
+	<array>
+		<string>/usr/local/bin/agent</string>
+		<string>--verbose</string>
+	</array>
+</dict>
+</plist>
+`)
+	expected := map[string]interface{}{
+		"Label":            "com.example.agent",
+		"RunAtLoad":        true,
+		"StartInterval":    int64(300),
+		"Offset":           int64(-1),
+		"Ratio":            0.5,
+		"Updated":          time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		"Token":            []byte("hi"),
+		"ProgramArguments": []interface{}{"/usr/local/bin/agent", "--verbose"},
+	}
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	in, err := Marshal(map[string]interface{}{"a": 1, "b": []interface{}{"x", 2.5}}, FormatBinary, false)
+	assert.NoError(t, err)
+	obj, err := Unmarshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": int64(1), "b": []interface{}{"x", 2.5}}, obj)
+}
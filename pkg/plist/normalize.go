@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package plist
+
+import (
+	"math"
+)
+
+// normalize converts the integers and reals decoded from a property list into int64 and float64 values,
+// so the values match the types returned by the other formats.
+// Integers greater than the maximum int64 are returned as uint64.
+func normalize(obj interface{}) interface{} {
+	switch x := obj.(type) {
+	case uint64:
+		if x <= math.MaxInt64 {
+			return int64(x)
+		}
+	case float32:
+		return float64(x)
+	case map[string]interface{}:
+		for k, v := range x {
+			x[k] = normalize(v)
+		}
+	case []interface{}:
+		for i, v := range x {
+			x[i] = normalize(v)
+		}
+	}
+	return obj
+}
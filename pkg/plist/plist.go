@@ -0,0 +1,48 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package plist provides an API for reading and writing Apple property lists in the XML and binary formats.
+// This package wraps the howett.net plist package.
+//	- https://developer.apple.com/library/archive/documentation/Cocoa/Conceptual/PropertyLists/
+//	- https://godoc.org/howett.net/plist
+//
+// When reading, the format is detected automatically, including the older OpenStep and GNUstep text formats.
+// Values are decoded into Go types as follows.
+//	- dict => map[string]interface{}
+//	- array => []interface{}
+//	- integer => int64, or uint64 if greater than the maximum int64
+//	- real => float64
+//	- date => time.Time
+//	- data => []byte
+//
+// When writing, maps must have string keys and nil values are skipped, since property lists have no null value.
+package plist
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	FormatBinary = "binary" // binary property list (bplist00)
+	FormatXML    = "xml"    // XML property list
+
+	DefaultFormat = FormatXML
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]interface{}{})
+	Formats     = []string{
+		FormatBinary,
+		FormatXML,
+	}
+)
+
+var (
+	ErrEmptyInput = errors.New("empty input")
+)
@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package properties
+
+import (
+	"fmt"
+)
+
+// ErrDuplicateKey is used when a property is defined more than once.
+type ErrDuplicateKey struct {
+	Key      string // the duplicate key
+	Line     int    // the line of the duplicate property, starting at 1
+	Previous int    // the line where the property was first defined, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrDuplicateKey) Error() string {
+	return fmt.Sprintf("duplicate key %q at line %d, first defined at line %d", e.Key, e.Line, e.Previous)
+}
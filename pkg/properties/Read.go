@@ -11,11 +11,13 @@ import (
 	"reflect"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-simple-serializer/pkg/escaper"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
 )
 
 // Read parses properties from the given reader and returns a map of the properties, and error if any.
@@ -45,17 +47,35 @@ func Read(input *ReadInput) (interface{}, error) {
 	}
 
 	m := reflect.MakeMap(inputType)
-	s := scanner.New(input.Reader, input.LineSeparator, input.DropCR)
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
 	property := ""
+	keys := map[string]int{} // the line where each key was first defined, if strict
+	lineNumber := 0
+	propertyLine := 0 // the line where the current property starts
 	for s.Scan() {
+		lineNumber++
 		line := s.Text()
+		if input.Strict && !utf8.ValidString(line) {
+			return nil, errors.Wrapf(ErrInvalidUTF8, "invalid byte on line %d", lineNumber)
+		}
 		if input.Trim {
 			line = strings.TrimSpace(line)
 		}
 		if len(line) > 0 && (len(input.Comment) == 0 || !strings.HasPrefix(line, input.Comment)) {
+			if len(property) == 0 {
+				propertyLine = lineNumber
+			}
 			// If the line ends with a backslash and input.UnescapeNewLine is set to true.
 			if line[len(line)-1] == '\\' && input.UnescapeNewLine {
 				property += strings.TrimLeftFunc(line, unicode.IsSpace) // include backslash since we unescape later.
+				// Properties continued over multiple lines are limited as a whole.
+				if err := input.Limits.CheckRecordBytes(len(property)); err != nil {
+					return nil, errors.Wrapf(err, "error checking limits of property on line %d", propertyLine)
+				}
 			} else {
 				property += strings.TrimLeftFunc(line, unicode.IsSpace)
 				propertyName := ""
@@ -78,13 +98,26 @@ func Read(input *ReadInput) (interface{}, error) {
 				if len(propertyName) == 0 {
 					return nil, errors.New("error deserializing properties for property " + property)
 				}
+				key := e.Unescape(strings.TrimSpace(propertyName))
+				if input.Strict {
+					if previous, ok := keys[key]; ok {
+						return nil, &ErrDuplicateKey{Key: key, Line: propertyLine, Previous: previous}
+					}
+					keys[key] = propertyLine
+				}
 				m.SetMapIndex(
-					reflect.ValueOf(e.Unescape(strings.TrimSpace(propertyName))),
+					reflect.ValueOf(key),
 					reflect.ValueOf(e.Unescape(strings.TrimSpace(propertyValue))),
 				)
+				if err := input.Limits.CheckKeys(m.Len()); err != nil {
+					return nil, errors.Wrapf(err, "error checking limits of property on line %d", propertyLine)
+				}
 				property = ""
 			}
 		}
 	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", lineNumber+1)
+	}
 	return m.Interface(), nil
 }
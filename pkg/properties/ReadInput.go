@@ -10,19 +10,25 @@ package properties
 import (
 	"io"
 	"reflect"
+	"regexp"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // ReadInput provides the input for the Read function.
 type ReadInput struct {
-	Type            reflect.Type // the output type
-	Reader          io.Reader    // the underlying reader
-	LineSeparator   byte         // the newline byte
-	DropCR          bool         // drop carriage return
-	Comment         string       // the comment prefix
-	Trim            bool         // trim lines
-	EscapePrefix    string       // escape prefix
-	UnescapeSpace   bool         // unescape spaces
-	UnescapeEqual   bool         // unescape =
-	UnescapeColon   bool         // unescape :
-	UnescapeNewLine bool         // unescape \n
+	Type                reflect.Type   // the output type
+	Reader              io.Reader      // the underlying reader
+	LineSeparator       string         // the line separator, which can be any sequence of bytes
+	LineSeparatorRegexp *regexp.Regexp // if not nil, split lines on matches of the regular expression
+	DropCR              bool           // drop carriage return
+	Comment             string         // the comment prefix
+	Trim                bool           // trim lines
+	EscapePrefix        string         // escape prefix
+	UnescapeSpace       bool           // unescape spaces
+	UnescapeEqual       bool           // unescape =
+	UnescapeColon       bool           // unescape :
+	UnescapeNewLine     bool           // unescape \n
+	Strict              bool           // reject duplicate keys and invalid UTF-8
+	Limits              limits.Limits  // the maximum size of each property and number of properties
 }
@@ -26,7 +26,7 @@ func ExampleRead_map() {
 	out, err := Read(&ReadInput{
 		Type:            reflect.TypeOf(map[string]string{}),
 		Reader:          strings.NewReader(in),
-		LineSeparator:   []byte("\n")[0],
+		LineSeparator:   "\n",
 		Comment:         "",
 		Trim:            true,
 		UnescapeSpace:   false,
@@ -56,7 +56,7 @@ func ExampleRead_comment() {
 	out, err := Read(&ReadInput{
 		Type:            reflect.TypeOf(map[string]string{}),
 		Reader:          strings.NewReader(in),
-		LineSeparator:   []byte("\n")[0],
+		LineSeparator:   "\n",
 		Comment:         "#",
 		Trim:            true,
 		UnescapeSpace:   false,
@@ -79,7 +79,7 @@ func ExampleRead_semicolon() {
 	out, err := Read(&ReadInput{
 		Type:            reflect.TypeOf(map[string]string{}),
 		Reader:          strings.NewReader(in),
-		LineSeparator:   []byte(";")[0],
+		LineSeparator:   ";",
 		Comment:         "",
 		Trim:            true,
 		UnescapeSpace:   false,
@@ -27,7 +27,7 @@ func TestRead(t *testing.T) {
 	out, err := Read(&ReadInput{
 		Type:            reflect.TypeOf(map[string]string{}),
 		Reader:          strings.NewReader(in),
-		LineSeparator:   []byte("\n")[0],
+		LineSeparator:   "\n",
 		Comment:         "",
 		Trim:            true,
 		UnescapeSpace:   false,
@@ -38,3 +38,17 @@ func TestRead(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, map[string]string{"a": "1", "b": "2", "c": "true", "d": "nil", "e": ""}, out)
 }
+
+func TestReadStrict(t *testing.T) {
+	in := "a=1\nb=2\n# comment\na=3\n"
+
+	out, err := Read(&ReadInput{
+		Type:          reflect.TypeOf(map[string]string{}),
+		Reader:        strings.NewReader(in),
+		LineSeparator: "\n",
+		Comment:       "#",
+		Strict:        true,
+	})
+	assert.Equal(t, &ErrDuplicateKey{Key: "a", Line: 4, Previous: 1}, err)
+	assert.Nil(t, out)
+}
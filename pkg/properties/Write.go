@@ -17,6 +17,7 @@ import (
 
 	"github.com/spatialcurrent/go-simple-serializer/pkg/escaper"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/inspector"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
 
@@ -76,12 +77,12 @@ func Write(input *WriteInput) error {
 
 	keySerializer := input.KeySerializer
 	if keySerializer == nil {
-		keySerializer = stringify.NewStringer("", false, false, false)
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 	}
 
 	valueSerializer := input.ValueSerializer
 	if valueSerializer == nil {
-		valueSerializer = stringify.NewStringer("", false, false, false)
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 	}
 
 	outputWriter := input.Writer
@@ -20,4 +20,5 @@ import (
 var (
 	ErrMissingLineSeparator     = errors.New("missing line separator")
 	ErrMissingKeyValueSeparator = errors.New("missing key-value separator")
+	ErrInvalidUTF8              = errors.New("invalid utf-8")
 )
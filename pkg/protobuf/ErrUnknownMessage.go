@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"fmt"
+)
+
+// ErrUnknownMessage is used when a message is not in the descriptor set.
+type ErrUnknownMessage struct {
+	Name string // the full name of the message
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownMessage) Error() string {
+	return fmt.Sprintf("unknown message %q", e.Name)
+}
@@ -0,0 +1,98 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates trough a stream of length-delimited messages
+// returning a new object on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Type        reflect.Type  // the type of each object.  If nil, then returns map[string]interface{}.
+	MessageType *MessageType  // the type of each message
+	Reader      *bufio.Reader // the buffered underlying reader
+	Limit       int           // Limit the number of objects to read and return from the underlying stream.
+	Count       int           // The current count of the number of objects read.
+	Limits      limits.Limits // The maximum size, depth, and number of keys of each object.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader      io.Reader
+	Type        reflect.Type  // the type of each object.  If nil, then returns map[string]interface{}.
+	MessageType *MessageType  // the type of each message
+	Limit       int           // Limit the number of objects to read and return from the underlying stream.
+	Limits      limits.Limits // The maximum size, depth, and number of keys of each object.  Only MaxRecordBytes, MaxDepth, and MaxKeys are used.
+}
+
+// NewIterator returns a new iterator for a stream of length-delimited messages based on the given input.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+	if input.MessageType == nil {
+		return nil, ErrMissingMessageType
+	}
+	return &Iterator{
+		Type:        input.Type,
+		MessageType: input.MessageType,
+		Reader:      bufio.NewReader(input.Reader),
+		Limit:       input.Limit,
+		Count:       0,
+		Limits:      input.Limits,
+	}, nil
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// When the input stream is exhausted, returns (nil, io.EOF).
+// If the stream ends in the middle of a message, then returns an error.
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	// Increment Counter
+	it.Count++
+
+	// A MaxSize of -1 disables the default maximum size of messages.
+	options := protodelim.UnmarshalOptions{MaxSize: -1}
+	if it.Limits.MaxRecordBytes > 0 {
+		options.MaxSize = int64(it.Limits.MaxRecordBytes)
+	}
+
+	m := it.MessageType.New()
+	err := options.UnmarshalFrom(it.Reader, m)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if _, ok := err.(*protodelim.SizeTooLargeError); ok {
+			return nil, errors.Wrapf(&limits.ErrMaxRecordBytes{Max: it.Limits.MaxRecordBytes}, "error reading protobuf message %d", it.Count)
+		}
+		return nil, errors.Wrapf(err, "error reading protobuf message %d", it.Count)
+	}
+
+	obj, err := toObject(it.MessageType, m, it.Type)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error converting protobuf message %d", it.Count)
+	}
+
+	if err := it.Limits.Check(obj); err != nil {
+		return nil, errors.Wrapf(err, "error checking limits of protobuf message %d", it.Count)
+	}
+
+	return obj, nil
+}
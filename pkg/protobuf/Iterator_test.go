@@ -0,0 +1,80 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestIterator(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:      bytes.NewReader([]byte{0x0b, 0x0a, 0x07, 'B', 'o', 'u', 'l', 'd', 'e', 'r', 0x20, 0x01, 0x02, 0x10, 0x05}),
+		MessageType: mt,
+	})
+	require.NoError(t, err)
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"name": "Boulder", "kind": "CITY"}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"population": "5"}, obj)
+	_, err = it.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestIteratorType(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:      bytes.NewReader([]byte{0x03, 0x0a, 0x01, 'A', 0x03, 0x0a, 0x01, 'B'}),
+		Type:        reflect.TypeOf(map[string]string{}),
+		MessageType: mt,
+		Limit:       1,
+	})
+	require.NoError(t, err)
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"name": "A"}, obj)
+	_, err = it.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestIteratorTruncated(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:      bytes.NewReader([]byte{0x0b, 0x0a, 0x07, 'B'}),
+		MessageType: mt,
+	})
+	require.NoError(t, err)
+	_, err = it.Next()
+	require.Error(t, err)
+}
+
+func TestIteratorMaxRecordBytes(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:      bytes.NewReader([]byte{0x0b, 0x0a, 0x07, 'B', 'o', 'u', 'l', 'd', 'e', 'r', 0x20, 0x01}),
+		MessageType: mt,
+		Limits:      limits.Limits{MaxRecordBytes: 4},
+	})
+	require.NoError(t, err)
+	_, err = it.Next()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "4")
+}
@@ -0,0 +1,95 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	// Register the well-known types, so descriptor sets created without --include_imports can import them.
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/apipb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/sourcecontextpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/typepb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// resolver resolves the imports of a file, first from the files in the descriptor set and then from the well-known types.
+type resolver struct {
+	files *protoregistry.Files
+}
+
+// FindFileByPath returns the file with the given path.
+func (r resolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+// FindDescriptorByName returns the descriptor with the given full name.
+func (r resolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := r.files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// LoadMessageType parses a serialized FileDescriptorSet and returns the message type with the given full name, e.g., "example.v1.Place".
+// The files in the set must be ordered so that each file comes after its imports, as written by protoc.
+func LoadMessageType(descriptorSet []byte, name string) (*MessageType, error) {
+
+	if len(descriptorSet) == 0 {
+		return nil, ErrMissingDescriptorSet
+	}
+
+	if len(name) == 0 {
+		return nil, ErrMissingMessage
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, set); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling descriptor set")
+	}
+
+	files := &protoregistry.Files{}
+	for _, fdp := range set.GetFile() {
+		if _, err := files.FindFileByPath(fdp.GetName()); err == nil {
+			continue
+		}
+		fd, err := protodesc.NewFile(fdp, resolver{files: files})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating descriptor for file %q", fdp.GetName())
+		}
+		if err := files.RegisterFile(fd); err != nil {
+			return nil, errors.Wrapf(err, "error registering file %q", fdp.GetName())
+		}
+	}
+
+	d, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, &ErrUnknownMessage{Name: name}
+	}
+
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, &ErrUnknownMessage{Name: name}
+	}
+
+	return &MessageType{Descriptor: md, Types: dynamicpb.NewTypes(files)}, nil
+}
@@ -0,0 +1,98 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testDescriptorSet returns a serialized descriptor set for the following file.
+//
+//	syntax = "proto3";
+//	package example;
+//	import "google/protobuf/timestamp.proto";
+//	message Place {
+//	  enum Kind { UNKNOWN = 0; CITY = 1; }
+//	  string name = 1;
+//	  int64 population = 2;
+//	  repeated string tags = 3;
+//	  Kind kind = 4;
+//	  google.protobuf.Timestamp updated = 5;
+//	}
+func testDescriptorSet(t *testing.T) []byte {
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, kind descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     kind.Enum(),
+			JsonName: proto.String(name),
+		}
+		if len(typeName) > 0 {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	set := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			&descriptorpb.FileDescriptorProto{
+				Name:       proto.String("example/place.proto"),
+				Package:    proto.String("example"),
+				Syntax:     proto.String("proto3"),
+				Dependency: []string{"google/protobuf/timestamp.proto"},
+				MessageType: []*descriptorpb.DescriptorProto{
+					&descriptorpb.DescriptorProto{
+						Name: proto.String("Place"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							field("name", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+							field("population", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+							field("tags", 3, descriptorpb.FieldDescriptorProto_LABEL_REPEATED, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+							field("kind", 4, optional, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".example.Place.Kind"),
+							field("updated", 5, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+						},
+						EnumType: []*descriptorpb.EnumDescriptorProto{
+							&descriptorpb.EnumDescriptorProto{
+								Name: proto.String("Kind"),
+								Value: []*descriptorpb.EnumValueDescriptorProto{
+									&descriptorpb.EnumValueDescriptorProto{Name: proto.String("UNKNOWN"), Number: proto.Int32(0)},
+									&descriptorpb.EnumValueDescriptorProto{Name: proto.String("CITY"), Number: proto.Int32(1)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	b, err := proto.Marshal(set)
+	require.NoError(t, err)
+	return b
+}
+
+func TestLoadMessageType(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	require.Equal(t, "example.Place", string(mt.Descriptor.FullName()))
+	require.Equal(t, 5, mt.Descriptor.Fields().Len())
+}
+
+func TestLoadMessageTypeUnknown(t *testing.T) {
+	_, err := LoadMessageType(testDescriptorSet(t), "example.Road")
+	require.Equal(t, &ErrUnknownMessage{Name: "example.Road"}, err)
+	_, err = LoadMessageType(testDescriptorSet(t), "example.Place.Kind")
+	require.Equal(t, &ErrUnknownMessage{Name: "example.Place.Kind"}, err)
+	_, err = LoadMessageType(testDescriptorSet(t), "")
+	require.Equal(t, ErrMissingMessage, err)
+	_, err = LoadMessageType(nil, "example.Place")
+	require.Equal(t, ErrMissingDescriptorSet, err)
+}
@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Marshal formats an object into a slice of bytes as a single message of the message type.
+func Marshal(obj interface{}, mt *MessageType) ([]byte, error) {
+	if mt == nil {
+		return make([]byte, 0), ErrMissingMessageType
+	}
+	m, err := fromObject(mt, obj, nil)
+	if err != nil {
+		return make([]byte, 0), err
+	}
+	b, err := marshalOptions.Marshal(m)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, "error marshaling protobuf")
+	}
+	return b, nil
+}
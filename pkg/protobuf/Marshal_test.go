@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	in := map[string]interface{}{
+		"name":       "Denver",
+		"population": 715522,
+		"tags":       []interface{}{"capital"},
+		"kind":       "CITY",
+		"updated":    "2020-01-02T03:04:05Z",
+	}
+	b, err := Marshal(in, mt)
+	require.NoError(t, err)
+	out, err := Unmarshal(b, mt)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"name":       "Denver",
+		"population": "715522",
+		"tags":       []interface{}{"capital"},
+		"kind":       "CITY",
+		"updated":    "2020-01-02T03:04:05Z",
+	}, out)
+}
+
+func TestMarshalUnknownField(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	_, err = Marshal(map[string]interface{}{"area": 1}, mt)
+	require.Error(t, err)
+}
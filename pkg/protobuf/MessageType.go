@@ -0,0 +1,35 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// MessageType is a message type loaded from a FileDescriptorSet.
+type MessageType struct {
+	Descriptor protoreflect.MessageDescriptor // the descriptor of the message
+	Types      *dynamicpb.Types               // the types in the descriptor set, used to resolve google.protobuf.Any values and extensions
+}
+
+// New returns a new empty dynamic message of the message type.
+func (mt *MessageType) New() *dynamicpb.Message {
+	return dynamicpb.NewMessage(mt.Descriptor)
+}
+
+// marshalOptions returns the options for formatting messages as JSON.
+func (mt *MessageType) marshalOptions() protojson.MarshalOptions {
+	return protojson.MarshalOptions{Resolver: mt.Types}
+}
+
+// unmarshalOptions returns the options for parsing messages from JSON.
+func (mt *MessageType) unmarshalOptions() protojson.UnmarshalOptions {
+	return protojson.UnmarshalOptions{Resolver: mt.Types}
+}
@@ -0,0 +1,55 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input parameters for the Read function.
+type ReadInput struct {
+	Type        reflect.Type // the output type.  If nil, then returns a slice of type []interface{}.
+	Reader      io.Reader    // the underlying reader
+	MessageType *MessageType // the type of each message
+	Limit       int
+	Limits      limits.Limits // the maximum size, depth, and number of keys of each object
+}
+
+// Read reads the length-delimited messages from the input reader into a slice of the type given.
+func Read(input *ReadInput) (interface{}, error) {
+
+	outputType := reflect.TypeOf([]interface{}{})
+	if input.Type != nil {
+		outputType = input.Type
+	}
+
+	it, err := NewIterator(&NewIteratorInput{
+		Type:        outputType.Elem(),
+		Reader:      input.Reader,
+		MessageType: input.MessageType,
+		Limit:       input.Limit,
+		Limits:      input.Limits,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating iterator")
+	}
+
+	output := reflect.MakeSlice(outputType, 0, 0).Interface()
+
+	w := pipe.NewSliceWriterWithValues(output)
+
+	err = pipe.NewBuilder().Input(it).Output(w).Run()
+
+	return w.Values(), err
+}
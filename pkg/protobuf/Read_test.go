@@ -0,0 +1,29 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	out, err := Read(&ReadInput{
+		Reader:      bytes.NewReader([]byte{0x03, 0x0a, 0x01, 'A', 0x03, 0x0a, 0x01, 'B'}),
+		MessageType: mt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"name": "A"},
+		map[string]interface{}{"name": "B"},
+	}, out)
+}
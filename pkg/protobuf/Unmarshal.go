@@ -0,0 +1,26 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Unmarshal parses a slice of bytes as a single message of the message type and returns it as a map[string]interface{}.
+// An empty slice of bytes is a valid message with all fields set to their default values.
+func Unmarshal(b []byte, mt *MessageType) (interface{}, error) {
+	if mt == nil {
+		return nil, ErrMissingMessageType
+	}
+	m := mt.New()
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling protobuf")
+	}
+	return toObject(mt, m, nil)
+}
@@ -0,0 +1,36 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	// name = "Boulder", kind = CITY
+	out, err := Unmarshal([]byte{0x0a, 0x07, 'B', 'o', 'u', 'l', 'd', 'e', 'r', 0x20, 0x01}, mt)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"name": "Boulder", "kind": "CITY"}, out)
+}
+
+func TestUnmarshalEmpty(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	out, err := Unmarshal([]byte{}, mt)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{}, out)
+}
+
+func TestUnmarshalMissingMessageType(t *testing.T) {
+	_, err := Unmarshal([]byte{}, nil)
+	require.Equal(t, ErrMissingMessageType, err)
+}
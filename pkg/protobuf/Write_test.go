@@ -0,0 +1,33 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	err = Write(&WriteInput{
+		Writer:      buf,
+		MessageType: mt,
+		Object: []interface{}{
+			map[string]interface{}{"name": "A"},
+			map[string]interface{}{"name": "B"},
+			map[string]interface{}{"name": "C"},
+		},
+		Limit: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x03, 0x0a, 0x01, 'A', 0x03, 0x0a, 0x01, 'B'}, buf.Bytes())
+}
@@ -0,0 +1,93 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as a stream of length-delimited messages.
+type Writer struct {
+	writer        io.Writer    // writer for the underlying stream
+	messageType   *MessageType // the type of each message
+	keySerializer stringify.Stringer
+}
+
+// NewWriter returns a writer for formating and writing objets to the underlying writer as a stream of length-delimited messages.
+// If keySerializer is not nil, then map keys are converted to strings using the key serializer before writing.
+func NewWriter(w io.Writer, messageType *MessageType, keySerializer stringify.Stringer) *Writer {
+	return &Writer{
+		writer:        w,
+		messageType:   messageType,
+		keySerializer: keySerializer,
+	}
+}
+
+// WriteObject formats and writes a single object to the underlying writer as a length-delimited message.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.messageType == nil {
+		return ErrMissingMessageType
+	}
+	m, err := fromObject(w.messageType, obj, w.keySerializer)
+	if err != nil {
+		return err
+	}
+	_, err = protodelim.MarshalOptions{MarshalOptions: marshalOptions}.MarshalTo(w.writer, m)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush flushes the underlying writer, if it has a Flush method.
+// This writer itself does no buffering.
+func (w *Writer) Flush() error {
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, mt, nil)
+	err = w.WriteObjects([]interface{}{
+		map[string]interface{}{"name": "Boulder", "kind": "CITY"},
+		map[string]interface{}{},
+	})
+	require.NoError(t, err)
+	err = w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0b, 0x0a, 0x07, 'B', 'o', 'u', 'l', 'd', 'e', 'r', 0x20, 0x01, 0x00}, buf.Bytes())
+}
+
+func TestWriterKeySerializer(t *testing.T) {
+	mt, err := LoadMessageType(testDescriptorSet(t), "example.Place")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, mt, func(obj interface{}) (string, error) { return obj.(string), nil })
+	err = w.WriteObject(map[interface{}]interface{}{"name": "A"})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x03, 0x0a, 0x01, 'A'}, buf.Bytes())
+}
@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package protobuf
+
+import (
+	stdjson "encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// toObject converts the message into an object of the given type using the proto3 JSON mapping.
+// If the type is nil, then returns a map[string]interface{}.
+func toObject(mt *MessageType, m proto.Message, t reflect.Type) (interface{}, error) {
+	b, err := mt.marshalOptions().Marshal(m)
+	if err != nil {
+		return nil, errors.Wrap(err, "error formatting message as JSON")
+	}
+	if t == nil {
+		t = DefaultType
+	}
+	ptr := reflect.New(t)
+	if t.Kind() == reflect.Map {
+		ptr.Elem().Set(reflect.MakeMap(t))
+	}
+	if err := stdjson.Unmarshal(b, ptr.Interface()); err != nil {
+		return nil, errors.Wrap(err, "error converting message")
+	}
+	return ptr.Elem().Interface(), nil
+}
+
+// fromObject converts the object into a new message using the proto3 JSON mapping.
+// If keySerializer is not nil, then map keys are converted to strings using the key serializer.
+func fromObject(mt *MessageType, obj interface{}, keySerializer stringify.Stringer) (proto.Message, error) {
+	if keySerializer != nil {
+		o, err := stringify.StringifyMapKeys(obj, keySerializer)
+		if err != nil {
+			return nil, errors.Wrap(err, "error stringifying map keys")
+		}
+		obj = o
+	}
+	b, err := stdjson.Marshal(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "error formatting object as JSON")
+	}
+	m := mt.New()
+	if err := mt.unmarshalOptions().Unmarshal(b, m); err != nil {
+		return nil, errors.Wrap(err, "error converting object to message")
+	}
+	return m, nil
+}
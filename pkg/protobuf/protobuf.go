@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package protobuf provides an API for Protocol Buffers serialization without generated Go code.
+// Message types are loaded at runtime from a FileDescriptorSet, e.g., created with "protoc --include_imports -o",
+// and messages are decoded and encoded using dynamic messages.  This package wraps the Go protobuf module.
+//	- https://protobuf.dev/
+//	- https://protobuf.dev/programming-guides/proto3/#json
+//	- https://godoc.org/google.golang.org/protobuf/types/dynamicpb
+//
+// Messages are converted to and from maps using the proto3 JSON mapping.
+// Field names are written in lowerCamelCase, 64-bit integers are written as strings, enums are written by name,
+// and well-known types, such as google.protobuf.Timestamp, are written in their JSON form.
+// When reading maps, both the lowerCamelCase names and the original field names are accepted.
+//
+// Streams of messages are length-delimited, with each message prefixed by its size as a varint,
+// as written by the writeDelimitedTo methods of the Java and C++ libraries.
+package protobuf
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]interface{}{})
+)
+
+var (
+	// Deterministic marshaling writes the fields of dynamic messages in a stable order, rather than in the order of a map.
+	marshalOptions = proto.MarshalOptions{Deterministic: true}
+)
+
+var (
+	ErrMissingDescriptorSet = errors.New("missing descriptor set")
+	ErrMissingMessage       = errors.New("missing message")
+	ErrMissingMessageType   = errors.New("missing message type")
+)
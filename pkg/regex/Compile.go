@@ -0,0 +1,72 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+var reference = regexp.MustCompile(`%\{(\w+)(?::(\w+))?\}`)
+
+// expand replaces the references to named patterns with the patterns from the library.
+// Returns an error if a pattern is unknown or references itself.
+func expand(pattern string, parents []string) (string, error) {
+	var err error
+	expanded := reference.ReplaceAllStringFunc(pattern, func(match string) string {
+		if err != nil {
+			return ""
+		}
+		submatches := reference.FindStringSubmatch(match)
+		name, field := submatches[1], submatches[2]
+		if stringSliceContains(parents, name) {
+			err = errors.Errorf("pattern %q references itself", name)
+			return ""
+		}
+		p, ok := Patterns[name]
+		if !ok {
+			err = &ErrUnknownPattern{Name: name}
+			return ""
+		}
+		p, err = expand(p, append(parents, name))
+		if err != nil {
+			return ""
+		}
+		if len(field) > 0 {
+			return "(?P<" + field + ">" + p + ")"
+		}
+		return "(?:" + p + ")"
+	})
+	if err != nil {
+		return "", err
+	}
+	return expanded, nil
+}
+
+// Compile expands the references to named patterns in the given pattern and compiles the result into a regular expression.
+// The regular expression must have at least one named capture group.
+func Compile(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) == 0 {
+		return nil, ErrMissingPattern
+	}
+	expanded, err := expand(pattern, []string{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error expanding pattern %q", pattern)
+	}
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error compiling pattern %q", pattern)
+	}
+	for _, name := range re.SubexpNames() {
+		if len(name) > 0 {
+			return re, nil
+		}
+	}
+	return nil, ErrMissingNamedGroups
+}
@@ -0,0 +1,50 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompile(t *testing.T) {
+	re, err := Compile(`%{IP:client} %{WORD:method} %{NOTSPACE}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "client", "method"}, re.SubexpNames())
+	assert.Equal(t, []string{"10.0.0.1 GET /index.html", "10.0.0.1", "GET"}, re.FindStringSubmatch("10.0.0.1 GET /index.html"))
+}
+
+func TestCompileMissingPattern(t *testing.T) {
+	_, err := Compile("")
+	assert.Equal(t, ErrMissingPattern, err)
+}
+
+func TestCompileMissingNamedGroups(t *testing.T) {
+	_, err := Compile(`(\w+) %{INT}`)
+	assert.Equal(t, ErrMissingNamedGroups, err)
+}
+
+func TestCompileUnknownPattern(t *testing.T) {
+	_, err := Compile(`%{FOO:foo}`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown pattern "FOO"`)
+}
+
+func TestCompileRecursivePattern(t *testing.T) {
+	Patterns["TEST_RECURSIVE"] = `a%{TEST_RECURSIVE}`
+	defer delete(Patterns, "TEST_RECURSIVE")
+	_, err := Compile(`%{TEST_RECURSIVE:a}`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `pattern "TEST_RECURSIVE" references itself`)
+}
+
+func TestCompileInvalid(t *testing.T) {
+	_, err := Compile(`(?P<a>[a-z`)
+	assert.Error(t, err)
+}
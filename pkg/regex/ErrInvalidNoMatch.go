@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidNoMatch is used when the no-match policy is not one of the supported policies.
+type ErrInvalidNoMatch struct {
+	Value string // the invalid policy
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidNoMatch) Error() string {
+	return fmt.Sprintf("invalid no-match policy %q, expecting one of %s", e.Value, strings.Join(NoMatchPolicies, ", "))
+}
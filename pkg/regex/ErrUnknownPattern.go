@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"fmt"
+)
+
+// ErrUnknownPattern is used when a pattern references a name that is not in the Patterns library.
+type ErrUnknownPattern struct {
+	Name string // the name of the unknown pattern
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownPattern) Error() string {
+	return fmt.Sprintf("unknown pattern %q", e.Name)
+}
@@ -0,0 +1,188 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
+)
+
+// Iterator iterates trough a stream of lines
+// returning a new map for each line that matches the regular expression on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Scanner      scanner.Scanner // the scanner that splits the underlying stream of bytes
+	Type         reflect.Type    // the type of map to return for each line
+	Pattern      *regexp.Regexp  // the regular expression with named capture groups
+	NoMatch      string          // the policy for lines that do not match, one of NoMatchPolicies.
+	RawField     string          // if the policy is raw, the field used for lines that do not match.
+	Comment      string          // The comment line prefix.  Can be any string.
+	SkipBlanks   bool            // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments bool            // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Limit        int             // Limit the number of objects to read and return from the underlying stream.
+	Count        int             // The current count of the number of objects read.
+	Line         int             // The current line number.
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader              io.Reader
+	Type                reflect.Type   // the type of map to return for each line, defaults to map[string]string.
+	Pattern             *regexp.Regexp // the regular expression with named capture groups, e.g., as returned by Compile.
+	NoMatch             string         // the policy for lines that do not match, one of NoMatchPolicies.  Defaults to skip.
+	RawField            string         // if the policy is raw, the field used for lines that do not match.  Defaults to DefaultRawField.
+	SkipLines           int            // Skip a given number of lines at the beginning of the stream.
+	SkipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Comment             string         // The comment line prefix. Can be any string.
+	Limit               int            // Limit the number of objects to read and return from the underlying stream.
+	LineSeparator       string         // The line separator, which can be any sequence of bytes, e.g., "\n" or "\r\n".
+	LineSeparatorRegexp *regexp.Regexp // If not nil, split lines on matches of the regular expression rather than the line separator.
+	DropCR              bool           // Drop carriage returns at the end of lines.
+	Limits              limits.Limits  // The maximum size of each line and number of capture groups.
+}
+
+// NewIterator returns a new regex Iterator based on the given input.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+
+	t := DefaultType
+	if input.Type != nil {
+		t = input.Type
+	}
+	if t.Kind() != reflect.Map {
+		return nil, &ErrInvalidKind{Value: t, Expected: []reflect.Kind{reflect.Map}}
+	}
+	if k := t.Key().Kind(); k != reflect.String && k != reflect.Interface {
+		return nil, errors.Errorf("type %q must have keys of kind string or interface", t)
+	}
+	if k := t.Elem().Kind(); k != reflect.String && k != reflect.Interface {
+		return nil, errors.Errorf("type %q must have values of kind string or interface", t)
+	}
+
+	if input.Pattern == nil {
+		return nil, ErrMissingPattern
+	}
+
+	if err := input.Limits.CheckKeys(input.Pattern.NumSubexp()); err != nil {
+		return nil, errors.Wrap(err, "error checking capture groups")
+	}
+
+	noMatch := NoMatchSkip
+	if len(input.NoMatch) > 0 {
+		if !stringSliceContains(NoMatchPolicies, input.NoMatch) {
+			return nil, &ErrInvalidNoMatch{Value: input.NoMatch}
+		}
+		noMatch = input.NoMatch
+	}
+
+	rawField := DefaultRawField
+	if len(input.RawField) > 0 {
+		rawField = input.RawField
+	}
+
+	if len(input.LineSeparator) == 0 && input.LineSeparatorRegexp == nil {
+		return nil, ErrMissingLineSeparator
+	}
+
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
+	line := 0
+	for i := 0; i < input.SkipLines; i++ {
+		if !s.Scan() {
+			break
+		}
+		line++
+	}
+
+	return &Iterator{
+		Scanner:      s,
+		Type:         t,
+		Pattern:      input.Pattern,
+		NoMatch:      noMatch,
+		RawField:     rawField,
+		Comment:      input.Comment,
+		SkipBlanks:   input.SkipBlanks,
+		SkipComments: input.SkipComments,
+		Limit:        input.Limit,
+		Count:        0,
+		Line:         line,
+	}, nil
+}
+
+// Next reads from the underlying reader and returns the next object and error, if any.
+// If a blank line is found and SkipBlanks is false, then returns (nil, nil).
+// If a commented line is found and SkipComments is false, then returns (nil, nil).
+// If a line does not match the regular expression, then the line is skipped, returned in the raw field, or returned as an error depending on the no-match policy.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	if it.Scanner.Scan() {
+		it.Line++
+		line := it.Scanner.Text()
+		if len(strings.TrimSpace(line)) == 0 {
+			if it.SkipBlanks {
+				return it.Next()
+			}
+			return nil, nil
+		}
+		if len(it.Comment) > 0 && strings.HasPrefix(line, it.Comment) {
+			if it.SkipComments {
+				return it.Next()
+			}
+			return nil, nil
+		}
+		m := reflect.MakeMap(it.Type)
+		if indices := it.Pattern.FindStringSubmatchIndex(line); indices != nil {
+			for i, name := range it.Pattern.SubexpNames() {
+				// Skip the whole match, unnamed groups, and groups that did not participate in the match.
+				if len(name) == 0 || indices[2*i] < 0 {
+					continue
+				}
+				m.SetMapIndex(
+					reflect.ValueOf(name).Convert(it.Type.Key()),
+					reflect.ValueOf(line[indices[2*i]:indices[2*i+1]]).Convert(it.Type.Elem()),
+				)
+			}
+		} else {
+			switch it.NoMatch {
+			case NoMatchError:
+				return nil, errors.Wrapf(ErrNoMatch, "error parsing line %d", it.Line)
+			case NoMatchRaw:
+				m.SetMapIndex(
+					reflect.ValueOf(it.RawField).Convert(it.Type.Key()),
+					reflect.ValueOf(line).Convert(it.Type.Elem()),
+				)
+			default:
+				return it.Next()
+			}
+		}
+		// Increment Counter
+		it.Count++
+		return m.Interface(), nil
+	}
+	if err := it.Scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", it.Line+1)
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,113 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	text := "alice=30\n\n# comment\nbob=4\nnot a match\ncarolyn=\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		Pattern:       MustCompile(`^(?P<name>\w+)=(?P<age>\d+)?$`),
+		SkipBlanks:    true,
+		SkipComments:  true,
+		Comment:       "#",
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "alice", "age": "30"}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "bob", "age": "4"}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "carolyn"}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorNoMatchRaw(t *testing.T) {
+	text := "alice=30\nnot a match\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		Type:          reflect.TypeOf(map[string]interface{}{}),
+		Pattern:       MustCompile(`^(?P<name>\w+)=(?P<age>\d+)$`),
+		NoMatch:       NoMatchRaw,
+		RawField:      "line",
+		LineSeparator: "\n",
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "alice", "age": "30"}, obj)
+
+	obj, err = it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"line": "not a match"}, obj)
+
+	obj, err = it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
+
+func TestIteratorNoMatchError(t *testing.T) {
+	text := "alice=30\nnot a match\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(text),
+		Pattern:       MustCompile(`^(?P<name>\w+)=(?P<age>\d+)$`),
+		NoMatch:       NoMatchError,
+		LineSeparator: "\n",
+		Limit:         2,
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "alice", "age": "30"}, obj)
+
+	obj, err = it.Next()
+	assert.Error(t, err)
+	assert.Equal(t, "error parsing line 2: line does not match pattern", err.Error())
+	assert.Nil(t, obj)
+}
+
+func TestIteratorInvalidNoMatch(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(""),
+		Pattern:       MustCompile(`(?P<a>a)`),
+		NoMatch:       "ignore",
+		LineSeparator: "\n",
+	})
+	assert.Equal(t, &ErrInvalidNoMatch{Value: "ignore"}, err)
+}
+
+func TestIteratorMissingPattern(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader:        strings.NewReader(""),
+		LineSeparator: "\n",
+	})
+	assert.Equal(t, ErrMissingPattern, err)
+}
@@ -0,0 +1,43 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+// Patterns is the library of named patterns that can be referenced as %{NAME} or %{NAME:field}.
+// The library includes building blocks, such as INT, IP, and QUOTEDSTRING,
+// and patterns for common log formats:
+//	- COMMONLOG - Common Log Format used by Apache and nginx
+//	- COMBINEDLOG - Combined Log Format used by Apache and nginx
+//	- SYSLOG3164 - BSD syslog messages (RFC 3164)
+//	- SYSLOG5424 - syslog messages (RFC 5424)
+//
+// Patterns can be added to the library before calling Compile.
+var Patterns = map[string]string{
+	"INT":               `[+-]?\d+`,
+	"NONNEGINT":         `\d+`,
+	"NUMBER":            `[+-]?(?:\d+(?:\.\d*)?|\.\d+)`,
+	"WORD":              `\w+`,
+	"NOTSPACE":          `\S+`,
+	"SPACE":             `\s*`,
+	"DATA":              `.*?`,
+	"GREEDYDATA":        `.*`,
+	"QUOTEDSTRING":      `"(?:[^"\\]|\\.)*"`,
+	"IPV4":              `(?:\d{1,3}\.){3}\d{1,3}`,
+	"IPV6":              `[0-9A-Fa-f]*:[0-9A-Fa-f:.]+`,
+	"IP":                `%{IPV6}|%{IPV4}`,
+	"HOSTNAME":          `[0-9A-Za-z](?:[0-9A-Za-z_-]*[0-9A-Za-z])?(?:\.[0-9A-Za-z](?:[0-9A-Za-z_-]*[0-9A-Za-z])?)*\.?`,
+	"IPORHOST":          `%{IP}|%{HOSTNAME}`,
+	"PROG":              `[^\s\[\]:]+`,
+	"HTTPDATE":          `\d{2}/\w{3}/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4}`,
+	"SYSLOGTIMESTAMP":   `\w{3} +\d{1,2} \d{2}:\d{2}:\d{2}`,
+	"TIMESTAMP_ISO8601": `\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}(?::\d{2}(?:\.\d+)?)?(?:Z|[+-]\d{2}:?\d{2})?`,
+	"SYSLOG5424SD":      `(?:\[(?:[^\]"\\]|\\.|"(?:[^"\\]|\\.)*")*\])+`,
+	"COMMONLOG":         `%{IPORHOST:remote_addr} %{NOTSPACE:ident} %{NOTSPACE:remote_user} \[%{HTTPDATE:time_local}\] "(?:%{WORD:method} %{NOTSPACE:path}(?: HTTP/%{NUMBER:http_version})?|%{DATA:raw_request})" %{NONNEGINT:status} (?:-|%{NONNEGINT:body_bytes_sent})`,
+	"COMBINEDLOG":       `%{COMMONLOG} "%{DATA:http_referer}" "%{DATA:http_user_agent}"`,
+	"SYSLOG3164":        `(?:<%{NONNEGINT:priority}>)?%{SYSLOGTIMESTAMP:timestamp} %{IPORHOST:hostname} %{PROG:program}(?:\[%{NONNEGINT:pid}\])?: %{GREEDYDATA:message}`,
+	"SYSLOG5424":        `<%{NONNEGINT:priority}>%{NONNEGINT:version} (?:-|%{TIMESTAMP_ISO8601:timestamp}) (?:-|%{NOTSPACE:hostname}) (?:-|%{NOTSPACE:app_name}) (?:-|%{NOTSPACE:proc_id}) (?:-|%{NOTSPACE:msg_id}) (?:-|%{SYSLOG5424SD:structured_data})(?: %{GREEDYDATA:message})?`,
+}
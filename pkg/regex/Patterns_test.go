@@ -0,0 +1,95 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func match(t *testing.T, pattern string, line string) map[string]string {
+	re, err := Compile(pattern)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	indices := re.FindStringSubmatchIndex(line)
+	if !assert.NotNil(t, indices, "pattern %q does not match line %q", pattern, line) {
+		return nil
+	}
+	m := map[string]string{}
+	for i, name := range re.SubexpNames() {
+		if len(name) > 0 && indices[2*i] >= 0 {
+			m[name] = line[indices[2*i]:indices[2*i+1]]
+		}
+	}
+	return m
+}
+
+func TestPatternsCommonLog(t *testing.T) {
+	line := `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`
+	assert.Equal(t, map[string]string{
+		"remote_addr":     "127.0.0.1",
+		"ident":           "-",
+		"remote_user":     "frank",
+		"time_local":      "10/Oct/2000:13:55:36 -0700",
+		"method":          "GET",
+		"path":            "/apache_pb.gif",
+		"http_version":    "1.0",
+		"status":          "200",
+		"body_bytes_sent": "2326",
+	}, match(t, `^%{COMMONLOG}$`, line))
+}
+
+func TestPatternsCombinedLog(t *testing.T) {
+	line := `2001:db8::1 - - [10/Oct/2000:13:55:36 -0700] "\x16\x03\x01" 400 - "-" "Mozilla/5.0 (X11; Linux x86_64)"`
+	assert.Equal(t, map[string]string{
+		"remote_addr":     "2001:db8::1",
+		"ident":           "-",
+		"remote_user":     "-",
+		"time_local":      "10/Oct/2000:13:55:36 -0700",
+		"raw_request":     `\x16\x03\x01`,
+		"status":          "400",
+		"http_referer":    "-",
+		"http_user_agent": "Mozilla/5.0 (X11; Linux x86_64)",
+	}, match(t, `^%{COMBINEDLOG}$`, line))
+}
+
+func TestPatternsSyslog3164(t *testing.T) {
+	line := `<34>Oct 11 22:14:15 mymachine su[230]: 'su root' failed for lonvick on /dev/pts/8`
+	assert.Equal(t, map[string]string{
+		"priority":  "34",
+		"timestamp": "Oct 11 22:14:15",
+		"hostname":  "mymachine",
+		"program":   "su",
+		"pid":       "230",
+		"message":   "'su root' failed for lonvick on /dev/pts/8",
+	}, match(t, `^%{SYSLOG3164}$`, line))
+}
+
+func TestPatternsSyslog5424(t *testing.T) {
+	line := `<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="Application"] An application event log entry`
+	assert.Equal(t, map[string]string{
+		"priority":        "165",
+		"version":         "1",
+		"timestamp":       "2003-10-11T22:14:15.003Z",
+		"hostname":        "mymachine.example.com",
+		"app_name":        "evntslog",
+		"msg_id":          "ID47",
+		"structured_data": `[exampleSDID@32473 iut="3" eventSource="Application"]`,
+		"message":         "An application event log entry",
+	}, match(t, `^%{SYSLOG5424}$`, line))
+}
+
+func TestPatternsSyslog5424NoMessage(t *testing.T) {
+	line := `<34>1 - - - - - -`
+	assert.Equal(t, map[string]string{
+		"priority": "34",
+		"version":  "1",
+	}, match(t, `^%{SYSLOG5424}$`, line))
+}
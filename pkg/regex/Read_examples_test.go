@@ -0,0 +1,62 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This example shows you can read lines using a regular expression with named capture groups.
+func ExampleRead_regexp() {
+	in := "GET /index.html 200\nPOST /login 302\n"
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		Pattern:       MustCompile(`^(?P<method>\w+) (?P<path>\S+) (?P<status>\d+)$`),
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: [map[method:GET path:/index.html status:200] map[method:POST path:/login status:302]]
+}
+
+// This example shows you can read web server access logs using a named pattern from the library.
+func ExampleRead_combined() {
+	in := `10.0.0.1 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.1" 200 512 "-" "curl/7.64.1"` + "\n"
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		Pattern:       MustCompile(`%{COMBINEDLOG}`),
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: [map[body_bytes_sent:512 http_referer:- http_user_agent:curl/7.64.1 http_version:1.1 ident:- method:GET path:/ remote_addr:10.0.0.1 remote_user:- status:200 time_local:10/Oct/2000:13:55:36 -0700]]
+}
+
+// This example shows you can keep lines that do not match the regular expression.
+func ExampleRead_raw() {
+	in := "a=1\nunexpected\n"
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(in),
+		Pattern:       MustCompile(`^(?P<key>\w+)=(?P<value>.*)$`),
+		NoMatch:       NoMatchRaw,
+		LineSeparator: "\n",
+		Limit:         -1,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(obj)
+	// Output: [map[key:a value:1] map[raw:unexpected]]
+}
@@ -0,0 +1,34 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package regex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	text := "skip me\nOct 11 22:14:15 mymachine su: 'su root' failed\r\nOct 11 22:14:16 mymachine sshd[42]: accepted\r\n"
+
+	obj, err := Read(&ReadInput{
+		Reader:        strings.NewReader(text),
+		Pattern:       MustCompile(`^%{SYSLOG3164}$`),
+		SkipLines:     1,
+		SkipBlanks:    true,
+		LineSeparator: "\n",
+		DropCR:        true,
+		Limit:         -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"timestamp": "Oct 11 22:14:15", "hostname": "mymachine", "program": "su", "message": "'su root' failed"},
+		{"timestamp": "Oct 11 22:14:16", "hostname": "mymachine", "program": "sshd", "pid": "42", "message": "accepted"},
+	}, obj)
+}
@@ -0,0 +1,71 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package regex provides a simple API for reading semi-structured text, such as web server access logs and syslog messages,
+// by applying a regular expression with named capture groups to each line.
+// regex also supports iterators for efficiently processing a stream.
+//
+// Each line that matches the regular expression is returned as a map from the name of each capture group to the matched text.
+// Capture groups that do not participate in the match are omitted from the map.
+//
+// Patterns can reference the named patterns in the Patterns library using grok-style syntax.
+// %{NAME} is replaced by the pattern with the given name, and %{NAME:field} is replaced by the pattern captured in a group named field, e.g.,
+//
+//	%{IP:client} %{WORD:method} %{NOTSPACE:path}
+//	%{COMBINEDLOG}
+//	%{SYSLOG5424}
+//
+// Lines that do not match the regular expression are handled by the no-match policy, which is one of:
+//	- skip - skip the line
+//	- error - return an error
+//	- raw - return a map with the whole line stored in the raw field
+//
+// See the examples below for usage.
+//
+// References:
+//	- https://httpd.apache.org/docs/current/logs.html#accesslog
+//	- https://tools.ietf.org/html/rfc3164
+//	- https://tools.ietf.org/html/rfc5424
+package regex
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	NoMatchSkip  = "skip"  // skip lines that do not match
+	NoMatchError = "error" // return an error for lines that do not match
+	NoMatchRaw   = "raw"   // return lines that do not match in the raw field
+)
+
+var (
+	DefaultType     = reflect.TypeOf(map[string]string{})
+	DefaultRawField = "raw"
+	NoMatchPolicies = []string{
+		NoMatchSkip,
+		NoMatchError,
+		NoMatchRaw,
+	}
+)
+
+var (
+	ErrMissingLineSeparator = errors.New("missing line separator")
+	ErrMissingNamedGroups   = errors.New("regular expression has no named capture groups")
+	ErrMissingPattern       = errors.New("missing pattern")
+	ErrNoMatch              = errors.New("line does not match pattern")
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package scanner
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// errorReader records the first error returned by the underlying reader, other than io.EOF.
+type errorReader struct {
+	reader io.Reader
+	err    error
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// NewWithSplitFunc returns a new Scanner that reads from the given reader and splits blocks using the given split function,
+// e.g., from the splitter package.  The scanner returns limits.ErrMaxRecordBytes when a block is longer than maxRecordBytes.
+// If maxRecordBytes is zero or less, then blocks are not limited.
+// If the underlying reader returns an error, e.g., limits.ErrMaxTotalBytes, then the partial block is dropped and the error is returned by Err().
+func NewWithSplitFunc(reader io.Reader, split bufio.SplitFunc, maxRecordBytes int) Scanner {
+	r := &errorReader{reader: reader}
+	split = limits.NewSplitFunc(split, maxRecordBytes)
+	scanner := bufio.NewScanner(r)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && r.err != nil {
+			// Only return complete blocks.
+			advance, token, err := split(data, false)
+			if err != nil || token != nil {
+				return advance, token, err
+			}
+			return 0, nil, r.err
+		}
+		return split(data, atEOF)
+	})
+	// The buffer must fit a block of the maximum size, a carriage return, and the separator.
+	if maxRecordBytes+2 > bufio.MaxScanTokenSize {
+		scanner.Buffer(make([]byte, 0, 4096), maxRecordBytes+2)
+	}
+	return scanner
+}
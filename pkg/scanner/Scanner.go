@@ -13,14 +13,13 @@
 package scanner
 
 import (
-	"bufio"
 	"io"
 
 	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
 )
 
 // Scanner is an interface compatible with bufio.Scanner that is used by iterators.
-// By using this interface, we can support streams separated by null bytes, new-line characters, or any separator.
+// By using this interface, we can support streams separated by null bytes, new-line characters, any sequence of bytes, or a regular expression.
 type Scanner interface {
 	Buffer(buf []byte, max int) // sets the initial buffer
 	Err() error                 // returns the current error
@@ -30,9 +29,8 @@ type Scanner interface {
 }
 
 // New returns a new Scanner that reads from the given reader,
-// splits on the given newLine byte, and drops carriage returns if indicated.
-func New(reader io.Reader, separator byte, dropCR bool) Scanner {
-	scanner := bufio.NewScanner(reader)
-	scanner.Split(splitter.ScanLines(separator, dropCR))
-	return scanner
+// splits on the given separator, and drops carriage returns if indicated.
+// The separator can be any sequence of bytes, e.g., "\n", "\r\n", or "||".
+func New(reader io.Reader, separator string, dropCR bool) Scanner {
+	return NewWithSplitFunc(reader, splitter.ScanSeparator([]byte(separator), dropCR), 0)
 }
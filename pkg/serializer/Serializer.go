@@ -13,20 +13,42 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"regexp"
 
 	"github.com/hashicorp/hcl"
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-fit/pkg/fit"
 
+	"github.com/spatialcurrent/go-simple-serializer/pkg/avro"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/bson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/cbor"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/dotenv"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/fixedwidth"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/geojson"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/gob"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/ini"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json5"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/jsonl"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/jsonseq"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/logfmt"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/msgpack"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/parquet"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/plist"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/properties"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/protobuf"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/regex"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sql"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/table"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/tags"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/toml"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/xlsx"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/xml"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/yaml"
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
@@ -38,35 +60,79 @@ const (
 )
 
 const (
+	FormatAvro       = "avro"       // Apache Avro object container files
 	FormatBSON       = "bson"       // Binary JSON
+	FormatCBOR       = "cbor"       // Concise Binary Object Representation
 	FormatCSV        = "csv"        // Comma-Separated Values
+	FormatDotEnv     = "dotenv"     // Environment file (KEY=value ...)
+	FormatEnv        = "env"        // Shell export statements (export KEY='value' ...)
+	FormatFixedWidth = "fixedwidth" // Fixed-width text
 	FormatFmt        = "fmt"        // Formatter
+	FormatGeoJSON    = "geojson"    // GeoJSON (RFC 7946)
+	FormatGeoJSONL   = "geojsonl"   // GeoJSON Lines
 	FormatGo         = "go"         // Native Golang print format
 	FormatGob        = "gob"        // Native Golang binary format
 	FormatHCL        = "hcl"        // HashiCorp Configuration Language
+	FormatHTML       = "html"       // HTML table
+	FormatINI        = "ini"        // INI
 	FormatJSON       = "json"       // JSON
+	FormatJSON5      = "json5"      // JSON5 (read only)
 	FormatJSONL      = "jsonl"      // JSON Lines
+	FormatJSONSeq    = "jsonseq"    // JSON text sequences (RFC 7464)
+	FormatLogfmt     = "logfmt"     // logfmt (level=info msg="..." ...)
+	FormatMarkdown   = "markdown"   // Markdown table
+	FormatMsgPack    = "msgpack"    // MessagePack
+	FormatParquet    = "parquet"    // Apache Parquet
+	FormatPlist      = "plist"      // Apple property list (XML or binary)
 	FormatProperties = "properties" // Properties
+	FormatProtobuf   = "protobuf"   // Protocol Buffers (length-delimited messages)
+	FormatRegex      = "regex"      // Lines parsed with a regular expression
+	FormatSQL        = "sql"        // SQL statements (INSERT INTO ... VALUES ...)
+	FormatTable      = "table"      // ASCII table
 	FormatTags       = "tags"       // Tags (a=b c=d ...)
 	FormatTOML       = "toml"       // TOML
 	FormatTSV        = "tsv"        // Tab-Separated Values
+	FormatXLSX       = "xlsx"       // Excel workbook
+	FormatXML        = "xml"        // XML
 	FormatYAML       = "yaml"       // YAML
 )
 
 var (
 	Formats = []string{
+		FormatAvro,
 		FormatBSON,
+		FormatCBOR,
 		FormatCSV,
+		FormatDotEnv,
+		FormatEnv,
+		FormatFixedWidth,
 		FormatFmt,
+		FormatGeoJSON,
+		FormatGeoJSONL,
 		FormatGo,
 		FormatGob,
 		FormatHCL,
+		FormatHTML,
+		FormatINI,
 		FormatJSON,
+		FormatJSON5,
 		FormatJSONL,
+		FormatJSONSeq,
+		FormatLogfmt,
+		FormatMarkdown,
+		FormatMsgPack,
+		FormatParquet,
+		FormatPlist,
 		FormatProperties,
+		FormatProtobuf,
+		FormatRegex,
+		FormatSQL,
+		FormatTable,
 		FormatTags,
 		FormatTOML,
 		FormatTSV,
+		FormatXLSX,
+		FormatXML,
 		FormatYAML,
 	}
 	ErrMissingKeyValueSeparator = errors.New("missing key-value separator")
@@ -85,17 +151,27 @@ type MarshalFunc func(object interface{}) ([]byte, error)
 var (
 	// UnmarshalFuncs contains a map of functions for unmarshaling formatted bytes into objects.
 	UnmarshalFuncs = map[string]UnmarshalFunc{
-		FormatBSON: bson.Unmarshal,
-		FormatJSON: json.Unmarshal,
-		FormatTOML: toml.Unmarshal,
-		FormatYAML: yaml.Unmarshal,
+		FormatBSON:    bson.Unmarshal,
+		FormatCBOR:    cbor.Unmarshal,
+		FormatJSON:    json.Unmarshal,
+		FormatJSON5:   json5.Unmarshal,
+		FormatMsgPack: msgpack.Unmarshal,
+		FormatPlist:   plist.Unmarshal,
+		FormatTOML:    toml.Unmarshal,
+		FormatXML:     xml.Unmarshal,
+		FormatYAML:    yaml.Unmarshal,
 	}
 	// UnmarshalTypeFuncs contains a map of functions for unmarshaling formatted bytes into objects.
 	UnmarshalTypeFuncs = map[string]UnmarshalTypeFunc{
-		FormatBSON: bson.UnmarshalType,
-		FormatJSON: json.UnmarshalType,
-		FormatTOML: toml.UnmarshalType,
-		FormatYAML: yaml.UnmarshalType,
+		FormatBSON:    bson.UnmarshalType,
+		FormatCBOR:    cbor.UnmarshalType,
+		FormatJSON:    json.UnmarshalType,
+		FormatJSON5:   json5.UnmarshalType,
+		FormatMsgPack: msgpack.UnmarshalType,
+		FormatPlist:   plist.UnmarshalType,
+		FormatTOML:    toml.UnmarshalType,
+		FormatXML:     xml.UnmarshalType,
+		FormatYAML:    yaml.UnmarshalType,
 	}
 	// MarshalTypeFuncs contains a map of functions for marshaling objects into formatted bytes.
 	MarshalFuncs = map[string]MarshalFunc{
@@ -106,37 +182,70 @@ var (
 
 // Serializer is a struct for serializing/deserializing objects.  This is the workhorse of the gss package.
 type Serializer struct {
-	format            string // one of gss.Formats
-	formatSpecifier   string
-	fit               bool
-	header            []interface{} // if formt as csv or tsv, the column names
-	comment           string        // the line comment prefix
-	lazyQuotes        bool          // if format is csv or tsv, allow LazyQuotes.
-	scannerBufferSize int           // the initial buffer size for the scanner.
-	skipLines         int           // if format is csv, tsv, or jsonl, the number of lines to skip before processing.
-	skipBlanks        bool          // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
-	skipComments      bool          // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
-	limit             int           // if format is a csv, tsv, or jsonl, then limit the number of items processed.
-	objectType        reflect.Type  // the type of the output object
-	pretty            bool          // pretty output
-	lineSeparator     string        // new line character, used by properties and jsonl
-	keyValueSeparator string
-	sorted            bool // sort output
-	reversed          bool // if sorted, sort in reverse alphabetical order
-	keySerializer     stringify.Stringer
-	valueSerializer   stringify.Stringer
-	escapePrefix      string
-	escapeSpace       bool
-	escapeNewLine     bool
-	escapeColon       bool
-	escapeEqual       bool
-	unescapeSpace     bool
-	unescapeNewLine   bool
-	unescapeColon     bool
-	unescapeEqual     bool
-	trim              bool
-	dropCR            bool
-	expandHeader      bool // dynamically expand header, requires caching output in memory
+	format              string // one of gss.Formats
+	formatSpecifier     string
+	fit                 bool
+	header              []interface{}  // if formt as csv or tsv, the column names
+	comment             string         // the line comment prefix
+	lazyQuotes          bool           // if format is csv or tsv, allow LazyQuotes.
+	scannerBufferSize   int            // the initial buffer size for the scanner.
+	skipLines           int            // if format is csv, tsv, or jsonl, the number of lines to skip before processing.
+	skipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	skipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	limit               int            // if format is a csv, tsv, or jsonl, then limit the number of items processed.
+	objectType          reflect.Type   // the type of the output object
+	pretty              bool           // pretty output
+	lineSeparator       string         // new line character, used by dotenv, env, ini, properties, jsonl, and logfmt
+	lineSeparatorRegexp *regexp.Regexp // if not nil, split lines on matches of the regular expression, used by dotenv, env, ini, properties, jsonl, logfmt, and tags
+	keyValueSeparator   string
+	sorted              bool // sort output
+	reversed            bool // if sorted, sort in reverse alphabetical order
+	keySerializer       stringify.Stringer
+	valueSerializer     stringify.Stringer
+	escapePrefix        string
+	escapeSpace         bool
+	escapeNewLine       bool
+	escapeColon         bool
+	escapeEqual         bool
+	unescapeSpace       bool
+	unescapeNewLine     bool
+	unescapeColon       bool
+	unescapeEqual       bool
+	trim                bool
+	dropCR              bool
+	expandHeader        bool          // dynamically expand header, requires caching output in memory
+	numberMode          string        // the mode for decoding numbers, one of number.Modes
+	strict              bool          // reject ambiguous input, e.g., duplicate keys
+	limits              limits.Limits // the resource limits enforced when deserializing
+	xmlPath             string        // the path to the elements to read from XML
+	borders             string        // the borders of an ASCII table, one of table.Borders
+	align               string        // the column alignment of a table, one of table.Alignments
+	maxWidth            int           // the maximum width of a table column, if less than 1, then unlimited.
+	wrap                bool          // wrap long values in a table column rather than truncating them
+	pattern             string        // the regular expression with named capture groups used to parse lines
+	noMatch             string        // the policy for lines that do not match the pattern, one of regex.NoMatchPolicies
+	schema              string        // the schema used when writing avro or parquet, formatted as JSON.  If empty, the schema is inferred.
+	codec               string        // the compression codec when writing avro or parquet, one of avro.Codecs or parquet.Codecs
+	sampleSize          int           // the number of objects used to infer the schema when writing avro or parquet
+	columns             []string      // the columns to read from a parquet file.  If empty, then reads all columns.
+	sheet               string        // the name or zero-based index of the sheet to read, or the name of the default sheet to write.
+	sheetKey            string        // the key of the value used as the name of the sheet for each object when writing xlsx
+	autoFilter          bool          // add an autofilter to the header row of each sheet when writing xlsx
+	table               string        // the name of the table when writing sql
+	dialect             string        // the SQL dialect, one of sql.Dialects
+	statement           string        // the statement used to write rows as sql, one of sql.Statements
+	batchSize           int           // the number of rows in each INSERT statement
+	createTable         bool          // write a CREATE TABLE statement before the rows when writing sql
+	descriptorSet       []byte        // the serialized FileDescriptorSet with the message type when reading or writing protobuf
+	message             string        // the full name of the message type when reading or writing protobuf
+	plistFormat         string        // the format of a property list when writing, one of plist.Formats
+	flatten             bool          // flatten GeoJSON features into records with the properties and the geometry as columns
+	longitude           string        // the name of the longitude column of points when converting between records and GeoJSON features
+	latitude            string        // the name of the latitude column of points when converting between records and GeoJSON features
+	wkt                 string        // the name of the column with the geometry as Well-Known Text when converting between records and GeoJSON features
+	extendedJSON        string        // the mode of MongoDB Extended JSON when writing json, jsonl, or jsonseq, one of bson.ExtendedJSONModes
+	encoding            string        // the text encoding of the input when reading or the output when writing text formats
+	bom                 bool          // write a byte order mark before the output of text formats
 }
 
 // New returns a new serializer with the given format.
@@ -163,6 +272,12 @@ func NewWithOptions(format string, options ...map[string]interface{}) (*Serializ
 				s = s.Comment(fmt.Sprint(value))
 			case "lineSeparator":
 				s = s.LineSeparator(fmt.Sprint(value))
+			case "lineSeparatorRegexp":
+				re, err := regexp.Compile(fmt.Sprint(value))
+				if err != nil {
+					return s, errors.Wrap(err, "error compiling line separator regular expression")
+				}
+				s = s.LineSeparatorRegexp(re)
 			case "keyValueSeparator":
 				s = s.KeyValueSeparator(fmt.Sprint(value))
 			case "scannerBufferSize":
@@ -235,6 +350,143 @@ func NewWithOptions(format string, options ...map[string]interface{}) (*Serializ
 				}
 			case "header":
 				s = s.Header(toInterfaceSlice(value))
+			case "useNumber":
+				s = s.UseNumber(fmt.Sprint(value))
+			case "strict":
+				switch v := value.(type) {
+				case bool:
+					s = s.Strict(v)
+				case int:
+					s = s.Strict(v > 0)
+				case float64:
+					s = s.Strict(v > 0.0)
+				}
+			case "limits":
+				if v, ok := value.(limits.Limits); ok {
+					s = s.Limits(v)
+				}
+			case "xmlPath":
+				s = s.XMLPath(fmt.Sprint(value))
+			case "pattern":
+				s = s.Pattern(fmt.Sprint(value))
+			case "noMatch":
+				s = s.NoMatch(fmt.Sprint(value))
+			case "schema":
+				s = s.Schema(fmt.Sprint(value))
+			case "codec":
+				s = s.Codec(fmt.Sprint(value))
+			case "columns":
+				switch v := value.(type) {
+				case []string:
+					s = s.Columns(v)
+				case []interface{}:
+					columns := make([]string, 0, len(v))
+					for _, c := range v {
+						columns = append(columns, fmt.Sprint(c))
+					}
+					s = s.Columns(columns)
+				}
+			case "sampleSize":
+				switch v := value.(type) {
+				case int:
+					s = s.SampleSize(v)
+				case float64:
+					s = s.SampleSize(int(v))
+				}
+			case "borders":
+				s = s.Borders(fmt.Sprint(value))
+			case "align":
+				s = s.Align(fmt.Sprint(value))
+			case "maxWidth":
+				switch v := value.(type) {
+				case int:
+					s = s.MaxWidth(v)
+				case float64:
+					s = s.MaxWidth(int(v))
+				}
+			case "wrap":
+				switch v := value.(type) {
+				case bool:
+					s = s.Wrap(v)
+				case int:
+					s = s.Wrap(v > 0)
+				case float64:
+					s = s.Wrap(v > 0.0)
+				}
+			case "sheet":
+				s = s.Sheet(fmt.Sprint(value))
+			case "sheetKey":
+				s = s.SheetKey(fmt.Sprint(value))
+			case "autoFilter":
+				switch v := value.(type) {
+				case bool:
+					s = s.AutoFilter(v)
+				case int:
+					s = s.AutoFilter(v > 0)
+				case float64:
+					s = s.AutoFilter(v > 0.0)
+				}
+			case "table":
+				s = s.Table(fmt.Sprint(value))
+			case "dialect":
+				s = s.Dialect(fmt.Sprint(value))
+			case "statement":
+				s = s.Statement(fmt.Sprint(value))
+			case "batchSize":
+				switch v := value.(type) {
+				case int:
+					s = s.BatchSize(v)
+				case float64:
+					s = s.BatchSize(int(v))
+				}
+			case "createTable":
+				switch v := value.(type) {
+				case bool:
+					s = s.CreateTable(v)
+				case int:
+					s = s.CreateTable(v > 0)
+				case float64:
+					s = s.CreateTable(v > 0.0)
+				}
+			case "descriptorSet":
+				switch v := value.(type) {
+				case []byte:
+					s = s.DescriptorSet(v)
+				case string:
+					s = s.DescriptorSet([]byte(v))
+				}
+			case "message":
+				s = s.Message(fmt.Sprint(value))
+			case "plistFormat":
+				s = s.PlistFormat(fmt.Sprint(value))
+			case "extendedJSON":
+				s = s.ExtendedJSON(fmt.Sprint(value))
+			case "encoding":
+				s = s.Encoding(fmt.Sprint(value))
+			case "bom":
+				switch v := value.(type) {
+				case bool:
+					s = s.BOM(v)
+				case int:
+					s = s.BOM(v > 0)
+				case float64:
+					s = s.BOM(v > 0.0)
+				}
+			case "flatten":
+				switch v := value.(type) {
+				case bool:
+					s = s.Flatten(v)
+				case int:
+					s = s.Flatten(v > 0)
+				case float64:
+					s = s.Flatten(v > 0.0)
+				}
+			case "longitude":
+				s = s.Longitude(fmt.Sprint(value))
+			case "latitude":
+				s = s.Latitude(fmt.Sprint(value))
+			case "wkt":
+				s = s.WKT(fmt.Sprint(value))
 			default:
 				return s, &ErrUnknownOption{Name: key}
 			}
@@ -317,6 +569,13 @@ func (s *Serializer) LineSeparator(lineSeparator string) *Serializer {
 	return s
 }
 
+// LineSeparatorRegexp sets the regular expression used to split lines when reading.
+// If not nil, then the line separator is only used when writing.
+func (s *Serializer) LineSeparatorRegexp(re *regexp.Regexp) *Serializer {
+	s.lineSeparatorRegexp = re
+	return s
+}
+
 // KeyValueSeparator sets the key-value separator of the serializer.
 func (s *Serializer) KeyValueSeparator(keyValueSeparator string) *Serializer {
 	s.keyValueSeparator = keyValueSeparator
@@ -348,7 +607,7 @@ func (s *Serializer) Type(t reflect.Type) *Serializer {
 	return s
 }
 
-// EscapePrefix sets the prefix for escaping text.  Used with the properties format.
+// EscapePrefix sets the prefix for escaping text.  Used with the ini and properties formats.
 // If the escape prefix is not set, then the serializer doesn't escape/unescape any text.
 func (s *Serializer) EscapePrefix(escapePrefix string) *Serializer {
 	s.escapePrefix = escapePrefix
@@ -415,13 +674,13 @@ func (s *Serializer) DropCR(dropCR bool) *Serializer {
 	return s
 }
 
-// KeySerializer sets the function for serializing keys as strings for the csv, tsv, and properties formats.
+// KeySerializer sets the function for serializing keys as strings for the csv, tsv, dotenv, env, ini, logfmt, and properties formats.
 func (s *Serializer) KeySerializer(keySerializer stringify.Stringer) *Serializer {
 	s.keySerializer = keySerializer
 	return s
 }
 
-// ValueSerializer sets the function for serializing values as strings for the csv, tsv, and properties formats.
+// ValueSerializer sets the function for serializing values as strings for the csv, tsv, dotenv, env, ini, logfmt, and properties formats.
 func (s *Serializer) ValueSerializer(valueSerializer stringify.Stringer) *Serializer {
 	s.valueSerializer = valueSerializer
 	return s
@@ -433,11 +692,361 @@ func (s *Serializer) LazyQuotes(lazyQuotes bool) *Serializer {
 	return s
 }
 
+// UseNumber sets the mode for decoding numbers when reading from bson, json, jsonl, jsonseq, toml, or yaml.
+// If the mode is empty, then each format decodes numbers into its default types.
+// See the number package for the supported modes.
+func (s *Serializer) UseNumber(mode string) *Serializer {
+	s.numberMode = mode
+	return s
+}
+
+// Strict enables/disables strict mode when reading from dotenv, env, ini, json, jsonl, jsonseq, logfmt, properties, tags, or yaml.
+// In strict mode, duplicate keys, unknown struct fields when a type is set,
+// trailing data after a JSON document, and invalid UTF-8 return an error.
+func (s *Serializer) Strict(strict bool) *Serializer {
+	s.strict = strict
+	return s
+}
+
+// Limits sets the resource limits enforced when deserializing.
+// For formats that are read line by line, e.g., jsonl and tags, MaxRecordBytes, MaxDepth, and MaxKeys apply to each line.
+// For other formats, they apply to the whole document.
+func (s *Serializer) Limits(l limits.Limits) *Serializer {
+	s.limits = l
+	return s
+}
+
+// XMLPath sets the path to the elements to read from XML, e.g., "/catalog/book".
+// If set, then deserializing XML returns a slice of the matching elements rather than the whole document.
+func (s *Serializer) XMLPath(path string) *Serializer {
+	s.xmlPath = path
+	return s
+}
+
+// Pattern sets the regular expression with named capture groups used to parse each line.
+// The pattern can reference the named patterns in the regex package, e.g., "%{COMBINEDLOG}".
+func (s *Serializer) Pattern(pattern string) *Serializer {
+	s.pattern = pattern
+	return s
+}
+
+// NoMatch sets the policy for lines that do not match the pattern, one of "skip", "error", or "raw".
+func (s *Serializer) NoMatch(noMatch string) *Serializer {
+	s.noMatch = noMatch
+	return s
+}
+
+// Schema sets the schema used when writing avro or parquet, formatted as JSON.
+// If not set, then the schema is inferred from the first objects written.
+func (s *Serializer) Schema(schema string) *Serializer {
+	s.schema = schema
+	return s
+}
+
+// Codec sets the compression codec when writing avro or parquet.
+// For avro, one of "null", "deflate", or "snappy".
+// For parquet, one of "uncompressed", "snappy", "gzip", "lz4", or "zstd".
+func (s *Serializer) Codec(codec string) *Serializer {
+	s.codec = codec
+	return s
+}
+
+// SampleSize sets the number of objects used to infer the schema when writing avro or parquet.
+// If less than 1, then uses the default sample size of the format.
+func (s *Serializer) SampleSize(sampleSize int) *Serializer {
+	s.sampleSize = sampleSize
+	return s
+}
+
+// Columns sets the columns to read from a parquet file.
+// If not set, then reads all columns.
+func (s *Serializer) Columns(columns []string) *Serializer {
+	s.columns = columns
+	return s
+}
+
+// Sheet sets the sheet of an Excel workbook.
+// When reading, the name or zero-based index of the sheet to read.  If not set, then reads the first sheet.
+// When writing, the name of the default sheet.  If not set, then uses "Sheet1".
+func (s *Serializer) Sheet(sheet string) *Serializer {
+	s.sheet = sheet
+	return s
+}
+
+// SheetKey sets the key of the value used as the name of the sheet for each object when writing an Excel workbook.
+// If not set, then all objects are written to the default sheet.
+func (s *Serializer) SheetKey(sheetKey string) *Serializer {
+	s.sheetKey = sheetKey
+	return s
+}
+
+// AutoFilter enables/disables adding an autofilter to the header row of each sheet when writing an Excel workbook.
+func (s *Serializer) AutoFilter(autoFilter bool) *Serializer {
+	s.autoFilter = autoFilter
+	return s
+}
+
+// Table sets the name of the table when writing SQL statements.
+// The name can be qualified by a schema, e.g., "public.places".
+func (s *Serializer) Table(table string) *Serializer {
+	s.table = table
+	return s
+}
+
+// Dialect sets the SQL dialect, one of "postgres", "mysql", or "sqlite".
+// If not set, then uses "postgres".
+func (s *Serializer) Dialect(dialect string) *Serializer {
+	s.dialect = dialect
+	return s
+}
+
+// Statement sets the statement used to write rows as SQL, either "insert" or "copy".
+// If not set, then uses "insert".  The "copy" statement is only supported by the "postgres" dialect.
+func (s *Serializer) Statement(statement string) *Serializer {
+	s.statement = statement
+	return s
+}
+
+// BatchSize sets the number of rows in each INSERT statement.
+// If not set, then uses 100.
+func (s *Serializer) BatchSize(batchSize int) *Serializer {
+	s.batchSize = batchSize
+	return s
+}
+
+// CreateTable enables/disables writing a CREATE TABLE statement with inferred column types before the rows when writing SQL statements.
+func (s *Serializer) CreateTable(createTable bool) *Serializer {
+	s.createTable = createTable
+	return s
+}
+
+// DescriptorSet sets the serialized FileDescriptorSet that includes the message type when reading or writing protobuf.
+// The descriptor set can be created with "protoc --include_imports -o".
+func (s *Serializer) DescriptorSet(descriptorSet []byte) *Serializer {
+	s.descriptorSet = descriptorSet
+	return s
+}
+
+// Message sets the full name of the message type when reading or writing protobuf, e.g., "example.v1.Place".
+func (s *Serializer) Message(message string) *Serializer {
+	s.message = message
+	return s
+}
+
+// PlistFormat sets the format of a property list when writing, either "xml" or "binary".
+// If not set, then writes XML.  When reading, the format is detected automatically.
+func (s *Serializer) PlistFormat(plistFormat string) *Serializer {
+	s.plistFormat = plistFormat
+	return s
+}
+
+// ExtendedJSON sets the mode of MongoDB Extended JSON, either "canonical" or "relaxed", used when writing json, jsonl, or jsonseq.
+// If set, then values without a native JSON type, e.g., ObjectId and Date, are written as Extended JSON wrapper objects.
+func (s *Serializer) ExtendedJSON(extendedJSON string) *Serializer {
+	s.extendedJSON = extendedJSON
+	return s
+}
+
+// Encoding sets the text encoding, one of encoding.InputEncodings when reading or encoding.OutputEncodings when writing.
+// When reading, text is transcoded into UTF-8 and any byte order mark is removed before decoding.
+// When writing, the output is transcoded from UTF-8.  Binary formats are not transcoded.
+func (s *Serializer) Encoding(encoding string) *Serializer {
+	s.encoding = encoding
+	return s
+}
+
+// BOM enables/disables writing a byte order mark before the output of text formats, e.g., for CSV files opened with Microsoft Excel.
+// If the encoding is not set, then the output is UTF-8.
+func (s *Serializer) BOM(bom bool) *Serializer {
+	s.bom = bom
+	return s
+}
+
+// Flatten enables/disables flattening GeoJSON features into records with the properties and the geometry as columns.
+// Points are written to the longitude and latitude columns and all other geometries are written to the WKT column.
+func (s *Serializer) Flatten(flatten bool) *Serializer {
+	s.flatten = flatten
+	return s
+}
+
+// Longitude sets the name of the longitude column of points when converting between records and GeoJSON features.
+func (s *Serializer) Longitude(longitude string) *Serializer {
+	s.longitude = longitude
+	return s
+}
+
+// Latitude sets the name of the latitude column of points when converting between records and GeoJSON features.
+func (s *Serializer) Latitude(latitude string) *Serializer {
+	s.latitude = latitude
+	return s
+}
+
+// WKT sets the name of the column with the geometry as Well-Known Text when converting between records and GeoJSON features.
+func (s *Serializer) WKT(wkt string) *Serializer {
+	s.wkt = wkt
+	return s
+}
+
+// Borders sets the borders of an ASCII table, one of "box", "plain", or "none".
+func (s *Serializer) Borders(borders string) *Serializer {
+	s.borders = borders
+	return s
+}
+
+// Align sets the column alignment of a table, one of "auto", "left", or "right".
+// If "auto", then columns that only contain numbers are right-aligned.
+func (s *Serializer) Align(align string) *Serializer {
+	s.align = align
+	return s
+}
+
+// MaxWidth sets the maximum width of a table column.  Longer values are truncated or wrapped.
+// If less than 1, then the width is unlimited.
+func (s *Serializer) MaxWidth(maxWidth int) *Serializer {
+	s.maxWidth = maxWidth
+	return s
+}
+
+// Wrap enables/disables wrapping long values onto multiple lines in an ASCII table, rather than truncating them.
+func (s *Serializer) Wrap(wrap bool) *Serializer {
+	s.wrap = wrap
+	return s
+}
+
 // Deserialize deserializes the input slice of bytes into an object and returns an error, if any.
-// Formats jsonl and tags return slices.  If the type is not set, then returns a slice of type []interface{}.
+// Formats avro, cbor, geojson, geojsonl, jsonl, jsonseq, msgpack, protobuf, and tags return slices, as does xml if the XML path is set, and bson if the input is a stream of documents.  If the type is not set, then returns a slice of type []interface{}.
 func (s *Serializer) Deserialize(b []byte) (interface{}, error) {
+	if err := s.limits.CheckTotalBytes(len(b)); err != nil {
+		return nil, err
+	}
+	if len(s.encoding) > 0 && !isBinary(s.format) {
+		d, err := encoding.Decode(b, s.encoding, s.strict)
+		if err != nil {
+			return nil, errors.Wrap(err, "error decoding input")
+		}
+		b = d
+	}
 	switch s.format {
-	case FormatBSON, FormatJSON, FormatTOML, FormatYAML:
+	case FormatJSON:
+		if err := json.CheckLimits(b, s.limits); err != nil {
+			return nil, errors.Wrap(err, "error checking limits of JSON")
+		}
+	case FormatJSON5:
+		// JSON5 is normalized into JSON, so that it is validated and decoded the same as JSON.
+		n, err := json5.Normalize(b)
+		if err != nil {
+			return nil, errors.Wrap(err, "error normalizing JSON5")
+		}
+		if err := json.CheckLimits(n, s.limits); err != nil {
+			return nil, errors.Wrap(err, "error checking limits of JSON5")
+		}
+		js := *s
+		js.format = FormatJSON
+		return js.deserialize(n)
+	case FormatYAML:
+		if err := yaml.CheckLimits(b, s.limits); err != nil {
+			return nil, errors.Wrap(err, "error checking limits of YAML")
+		}
+	case FormatBSON, FormatHCL, FormatPlist, FormatTOML, FormatXML:
+		if s.format == FormatBSON && s.isBSONStream(b) {
+			// Each document in a stream is checked by the iterator.
+			return s.deserialize(b)
+		}
+		if err := s.limits.CheckRecordBytes(len(b)); err != nil {
+			return nil, err
+		}
+		// These formats are checked after decoding, since the size of the document is already limited.
+		obj, err := s.deserialize(b)
+		if err != nil {
+			return obj, err
+		}
+		if err := s.limits.Check(obj); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of %s", s.format)
+		}
+		return obj, nil
+	}
+	return s.deserialize(b)
+}
+
+// isBSONStream returns true if the input should be read as a stream of concatenated BSON documents, such as created by mongodump.
+// The input is read as a stream if the type is a slice or if the type is not set and the input contains more than one document.
+func (s *Serializer) isBSONStream(b []byte) bool {
+	if s.objectType != nil {
+		return s.objectType.Kind() == reflect.Slice
+	}
+	return bson.IsStream(b)
+}
+
+func (s *Serializer) deserialize(b []byte) (interface{}, error) {
+	switch s.format {
+	case FormatXML:
+		if len(s.xmlPath) > 0 {
+			return xml.Read(&xml.ReadInput{
+				Reader: bytes.NewReader(b),
+				Path:   s.xmlPath,
+				Limit:  s.limit,
+				Limits: s.limits,
+			})
+		}
+		if s.objectType != nil {
+			return UnmarshalTypeFuncs[s.format](b, s.objectType)
+		}
+		return UnmarshalFuncs[s.format](b)
+	case FormatBSON, FormatJSON, FormatPlist, FormatTOML, FormatYAML:
+		if s.format == FormatBSON && s.isBSONStream(b) {
+			return bson.Read(&bson.ReadInput{
+				Type:   s.objectType,
+				Reader: bytes.NewReader(b),
+				Limit:  s.limit,
+				Limits: s.limits,
+			})
+		}
+		if s.strict {
+			switch s.format {
+			case FormatJSON:
+				err := json.Validate(b, s.objectType)
+				if err != nil {
+					return nil, errors.Wrap(err, "error validating JSON")
+				}
+			case FormatYAML:
+				err := yaml.Validate(b, s.objectType)
+				if err != nil {
+					return nil, errors.Wrap(err, "error validating YAML")
+				}
+			}
+		}
+		if len(s.numberMode) > 0 {
+			// JSON numbers are decoded as json.Number, so that no precision is lost before conversion.
+			if s.format == FormatJSON {
+				if s.objectType != nil {
+					return json.UnmarshalTypeNumber(b, s.objectType, s.numberMode)
+				}
+				return json.UnmarshalNumber(b, s.numberMode)
+			}
+			// YAML and TOML numbers and BSON decimals are parsed from their text, so that no precision is lost before conversion.
+			if s.objectType == nil || s.objectType.Kind() == reflect.Interface {
+				switch s.format {
+				case FormatBSON:
+					return bson.UnmarshalNumber(b, s.numberMode)
+				case FormatTOML:
+					return toml.UnmarshalNumber(b, s.numberMode)
+				case FormatYAML:
+					return yaml.UnmarshalNumber(b, s.numberMode)
+				}
+			}
+			// BSON and property list numbers are typed, so they are converted without losing precision.
+			var obj interface{}
+			var err error
+			if s.objectType != nil {
+				obj, err = UnmarshalTypeFuncs[s.format](b, s.objectType)
+			} else {
+				obj, err = UnmarshalFuncs[s.format](b)
+			}
+			if err != nil {
+				return obj, err
+			}
+			return number.Convert(obj, s.numberMode)
+		}
 		if s.objectType != nil {
 			return UnmarshalTypeFuncs[s.format](b, s.objectType)
 		}
@@ -456,55 +1065,145 @@ func (s *Serializer) Deserialize(b []byte) (interface{}, error) {
 			Comment:    s.comment,
 			LazyQuotes: s.lazyQuotes,
 			Limit:      s.limit,
+			Limits:     s.limits,
 		})
-	case FormatJSONL, FormatProperties, FormatTags:
-		if len(s.lineSeparator) == 0 {
+	case FormatDotEnv, FormatEnv, FormatFixedWidth, FormatINI, FormatJSONL, FormatLogfmt, FormatProperties, FormatRegex, FormatTags:
+		if len(s.lineSeparator) == 0 && s.lineSeparatorRegexp == nil {
 			return nil, ErrMissingLineSeparator
 		}
 		switch s.format {
+		case FormatFixedWidth:
+			columns, err := fixedwidth.ParseColumns(s.header)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing header")
+			}
+			return fixedwidth.Read(&fixedwidth.ReadInput{
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				Columns:             columns,
+				SkipLines:           s.skipLines,
+				SkipBlanks:          s.skipBlanks,
+				SkipComments:        s.skipComments,
+				Comment:             s.comment,
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Limit:               s.limit,
+				Limits:              s.limits,
+			})
+		case FormatDotEnv, FormatEnv:
+			return dotenv.Read(&dotenv.ReadInput{
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Strict:              s.strict,
+				Limits:              s.limits,
+			})
+		case FormatINI:
+			return ini.Read(&ini.ReadInput{
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				EscapePrefix:        s.escapePrefix,
+				UnescapeSpace:       s.unescapeSpace,
+				UnescapeEqual:       s.unescapeEqual,
+				UnescapeColon:       s.unescapeColon,
+				UnescapeNewLine:     s.unescapeNewLine,
+				Strict:              s.strict,
+				Limits:              s.limits,
+			})
 		case FormatJSONL:
 			return jsonl.Read(&jsonl.ReadInput{
-				Type:              s.objectType,
-				Reader:            bytes.NewReader(b),
-				ScannerBufferSize: s.scannerBufferSize,
-				LineSeparator:     []byte(s.lineSeparator)[0],
-				DropCR:            s.dropCR,
-				Comment:           s.comment,
-				SkipLines:         s.skipLines,
-				SkipBlanks:        s.skipBlanks,
-				SkipComments:      s.skipComments,
-				Limit:             s.limit,
-				Trim:              s.trim,
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				ScannerBufferSize:   s.scannerBufferSize,
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Comment:             s.comment,
+				SkipLines:           s.skipLines,
+				SkipBlanks:          s.skipBlanks,
+				SkipComments:        s.skipComments,
+				Limit:               s.limit,
+				Trim:                s.trim,
+				NumberMode:          s.numberMode,
+				Strict:              s.strict,
+				Limits:              s.limits,
+			})
+		case FormatLogfmt:
+			return logfmt.Read(&logfmt.ReadInput{
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Comment:             s.comment,
+				SkipLines:           s.skipLines,
+				SkipBlanks:          s.skipBlanks,
+				SkipComments:        s.skipComments,
+				Limit:               s.limit,
+				Strict:              s.strict,
+				Limits:              s.limits,
 			})
 		case FormatProperties:
 			return properties.Read(&properties.ReadInput{
-				Type:            s.objectType,
-				Reader:          bytes.NewReader(b),
-				LineSeparator:   []byte(s.lineSeparator)[0],
-				DropCR:          s.dropCR,
-				Comment:         s.comment,
-				Trim:            s.trim,
-				UnescapeSpace:   s.unescapeSpace,
-				UnescapeEqual:   s.unescapeEqual,
-				UnescapeColon:   s.unescapeColon,
-				UnescapeNewLine: s.unescapeNewLine,
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Comment:             s.comment,
+				Trim:                s.trim,
+				UnescapeSpace:       s.unescapeSpace,
+				UnescapeEqual:       s.unescapeEqual,
+				UnescapeColon:       s.unescapeColon,
+				UnescapeNewLine:     s.unescapeNewLine,
+				Strict:              s.strict,
+				Limits:              s.limits,
+			})
+		case FormatRegex:
+			re, err := regex.Compile(s.pattern)
+			if err != nil {
+				return nil, errors.Wrap(err, "error compiling pattern")
+			}
+			return regex.Read(&regex.ReadInput{
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				Pattern:             re,
+				NoMatch:             s.noMatch,
+				SkipLines:           s.skipLines,
+				SkipBlanks:          s.skipBlanks,
+				SkipComments:        s.skipComments,
+				Comment:             s.comment,
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Limit:               s.limit,
+				Limits:              s.limits,
 			})
 		case FormatTags:
 			if len(s.keyValueSeparator) == 0 {
 				return nil, ErrMissingKeyValueSeparator
 			}
 			return tags.Read(&tags.ReadInput{
-				Type:              s.objectType,
-				Reader:            bytes.NewReader(b),
-				Keys:              s.header,
-				KeyValueSeparator: s.keyValueSeparator,
-				LineSeparator:     []byte(s.lineSeparator)[0],
-				DropCR:            s.dropCR,
-				Comment:           s.comment,
-				SkipLines:         s.skipLines,
-				SkipBlanks:        s.skipBlanks,
-				SkipComments:      s.skipComments,
-				Limit:             s.limit,
+				Type:                s.objectType,
+				Reader:              bytes.NewReader(b),
+				Keys:                s.header,
+				KeyValueSeparator:   s.keyValueSeparator,
+				LineSeparator:       s.lineSeparator,
+				LineSeparatorRegexp: s.lineSeparatorRegexp,
+				DropCR:              s.dropCR,
+				Comment:             s.comment,
+				SkipLines:           s.skipLines,
+				SkipBlanks:          s.skipBlanks,
+				SkipComments:        s.skipComments,
+				Limit:               s.limit,
+				Strict:              s.strict,
+				Limits:              s.limits,
 			})
 		}
 	case FormatGob:
@@ -513,12 +1212,88 @@ func (s *Serializer) Deserialize(b []byte) (interface{}, error) {
 				Type:   s.objectType,
 				Reader: bytes.NewReader(bytes.TrimSpace(b)),
 				Limit:  s.limit,
+				Limits: s.limits,
 			})
 		}
 		return gob.Read(&gob.ReadInput{
 			Type:   s.objectType,
 			Reader: bytes.NewReader(b),
 			Limit:  s.limit,
+			Limits: s.limits,
+		})
+	case FormatAvro:
+		return avro.Read(&avro.ReadInput{
+			Type:   s.objectType,
+			Reader: bytes.NewReader(b),
+			Limit:  s.limit,
+			Limits: s.limits,
+		})
+	case FormatParquet:
+		return parquet.Read(&parquet.ReadInput{
+			Type:    s.objectType,
+			Reader:  bytes.NewReader(b),
+			Columns: s.columns,
+			Limit:   s.limit,
+			Limits:  s.limits,
+		})
+	case FormatXLSX:
+		return xlsx.Read(&xlsx.ReadInput{
+			Type:      s.objectType,
+			Reader:    bytes.NewReader(b),
+			Sheet:     s.sheet,
+			Header:    s.header,
+			SkipLines: s.skipLines,
+			Limit:     s.limit,
+			Limits:    s.limits,
+		})
+	case FormatCBOR:
+		return cbor.Read(&cbor.ReadInput{
+			Type:   s.objectType,
+			Reader: bytes.NewReader(b),
+			Limit:  s.limit,
+			Limits: s.limits,
+		})
+	case FormatProtobuf:
+		mt, err := protobuf.LoadMessageType(s.descriptorSet, s.message)
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading protobuf message type")
+		}
+		return protobuf.Read(&protobuf.ReadInput{
+			Type:        s.objectType,
+			Reader:      bytes.NewReader(b),
+			MessageType: mt,
+			Limit:       s.limit,
+			Limits:      s.limits,
+		})
+	case FormatGeoJSON, FormatGeoJSONL:
+		return geojson.Read(&geojson.ReadInput{
+			Type:       s.objectType,
+			Reader:     bytes.NewReader(b),
+			Limit:      s.limit,
+			Flatten:    s.flatten,
+			Longitude:  s.longitude,
+			Latitude:   s.latitude,
+			WKT:        s.wkt,
+			NumberMode: s.numberMode,
+			Strict:     s.strict,
+			Limits:     s.limits,
+		})
+	case FormatJSONSeq:
+		return jsonseq.Read(&jsonseq.ReadInput{
+			Type:              s.objectType,
+			Reader:            bytes.NewReader(b),
+			ScannerBufferSize: s.scannerBufferSize,
+			Limit:             s.limit,
+			NumberMode:        s.numberMode,
+			Strict:            s.strict,
+			Limits:            s.limits,
+		})
+	case FormatMsgPack:
+		return msgpack.Read(&msgpack.ReadInput{
+			Type:   s.objectType,
+			Reader: bytes.NewReader(b),
+			Limit:  s.limit,
+			Limits: s.limits,
 		})
 	case FormatHCL:
 		objectType := s.objectType
@@ -540,25 +1315,202 @@ func (s *Serializer) Deserialize(b []byte) (interface{}, error) {
 }
 
 // Serialize serializes an object into a slice of byte and returns and error, if any.
+// If the encoding is set or the byte order mark is enabled, then the output of text formats is transcoded from UTF-8.
 func (s *Serializer) Serialize(object interface{}) ([]byte, error) {
+	b, err := s.serialize(object)
+	if err != nil {
+		return b, err
+	}
+	if (len(s.encoding) > 0 || s.bom) && !isBinary(s.format) {
+		e := s.encoding
+		if len(e) == 0 {
+			e = encoding.EncodingUTF8
+		}
+		out, err := encoding.Encode(b, e, s.bom)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error encoding output")
+		}
+		return out, nil
+	}
+	return b, nil
+}
+
+func (s *Serializer) serialize(object interface{}) ([]byte, error) {
 
 	keySerializer := s.keySerializer
 	if keySerializer == nil {
-		keySerializer = stringify.NewStringer("", false, false, false)
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 	}
 
 	valueSerializer := s.valueSerializer
 	if valueSerializer == nil {
-		valueSerializer = stringify.NewStringer("", false, false, false)
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	if len(s.extendedJSON) > 0 {
+		switch s.format {
+		case FormatJSON, FormatJSONL, FormatJSONSeq:
+			o, err := bson.ToExtendedJSON(object, s.extendedJSON)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, "error converting object to Extended JSON")
+			}
+			object = o
+		}
 	}
 
 	switch s.format {
+	case FormatJSON, FormatJSONL, FormatJSONSeq, FormatTOML, FormatYAML:
+		// Big numbers are marshaled as quoted text, so convert them into json.Number to write them as numbers.
+		object = number.ToJSONNumber(object)
+	}
+
+	switch s.format {
+	case FormatAvro:
+		buf := new(bytes.Buffer)
+		err := avro.Write(&avro.WriteInput{
+			Writer:        buf,
+			Schema:        s.schema,
+			Codec:         s.codec,
+			SampleSize:    s.sampleSize,
+			KeySerializer: keySerializer,
+			Object:        object,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing Avro")
+		}
+		return buf.Bytes(), nil
+	case FormatParquet:
+		buf := new(bytes.Buffer)
+		err := parquet.Write(&parquet.WriteInput{
+			Writer:        buf,
+			Schema:        s.schema,
+			Header:        s.header,
+			Codec:         s.codec,
+			SampleSize:    s.sampleSize,
+			KeySerializer: keySerializer,
+			Object:        object,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing Parquet")
+		}
+		return buf.Bytes(), nil
+	case FormatXLSX:
+		buf := new(bytes.Buffer)
+		err := xlsx.Write(&xlsx.WriteInput{
+			Writer:          buf,
+			Sheet:           s.sheet,
+			SheetKey:        s.sheetKey,
+			Header:          s.header,
+			AutoFilter:      s.autoFilter,
+			KeySerializer:   keySerializer,
+			ValueSerializer: valueSerializer,
+			Object:          object,
+			Sorted:          s.sorted,
+			Reversed:        s.reversed,
+			Limit:           s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing Excel workbook")
+		}
+		return buf.Bytes(), nil
+	case FormatSQL:
+		buf := new(bytes.Buffer)
+		err := sql.Write(&sql.WriteInput{
+			Writer:          buf,
+			Table:           s.table,
+			Dialect:         s.dialect,
+			Statement:       s.statement,
+			Header:          s.header,
+			CreateTable:     s.createTable,
+			BatchSize:       s.batchSize,
+			KeySerializer:   keySerializer,
+			ValueSerializer: valueSerializer,
+			Object:          object,
+			Sorted:          s.sorted,
+			Reversed:        s.reversed,
+			Limit:           s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing SQL")
+		}
+		return buf.Bytes(), nil
 	case FormatBSON:
+		// Slices are written as a stream of concatenated documents, such as created by mongodump.
+		if t := reflect.TypeOf(object); t != nil && (t.Kind() == reflect.Array || t.Kind() == reflect.Slice) {
+			buf := new(bytes.Buffer)
+			err := bson.Write(&bson.WriteInput{
+				Writer:        buf,
+				Object:        object,
+				KeySerializer: keySerializer,
+				Limit:         s.limit,
+			})
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, "error serializing BSON")
+			}
+			return buf.Bytes(), nil
+		}
 		o, err := stringify.StringifyMapKeys(object, keySerializer)
 		if err != nil {
 			return make([]byte, 0), errors.Wrap(err, "error stringifying map keys")
 		}
 		return bson.Marshal(o)
+	case FormatPlist:
+		o, err := stringify.StringifyMapKeys(object, keySerializer)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error stringifying map keys")
+		}
+		b, err := plist.Marshal(o, s.plistFormat, s.pretty)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing property list")
+		}
+		return b, nil
+	case FormatCBOR:
+		buf := new(bytes.Buffer)
+		err := cbor.Write(&cbor.WriteInput{
+			Writer:        buf,
+			Object:        object,
+			KeySerializer: keySerializer,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing CBOR")
+		}
+		return buf.Bytes(), nil
+	case FormatProtobuf:
+		mt, err := protobuf.LoadMessageType(s.descriptorSet, s.message)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error loading protobuf message type")
+		}
+		buf := new(bytes.Buffer)
+		err = protobuf.Write(&protobuf.WriteInput{
+			Writer:        buf,
+			MessageType:   mt,
+			Object:        object,
+			KeySerializer: keySerializer,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing protobuf")
+		}
+		return buf.Bytes(), nil
+	case FormatGeoJSON, FormatGeoJSONL:
+		buf := new(bytes.Buffer)
+		err := geojson.Write(&geojson.WriteInput{
+			Writer:        buf,
+			Object:        object,
+			Lines:         s.format == FormatGeoJSONL,
+			Longitude:     s.longitude,
+			Latitude:      s.latitude,
+			WKT:           s.wkt,
+			KeySerializer: keySerializer,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing GeoJSON")
+		}
+		return buf.Bytes(), nil
 	case FormatCSV, FormatTSV:
 		separator, errSeparator := sv.FormatToSeparator(s.format)
 		if errSeparator != nil {
@@ -581,6 +1533,28 @@ func (s *Serializer) Serialize(object interface{}) ([]byte, error) {
 			return make([]byte, 0), errors.Wrap(errWrite, "error writing separated values")
 		}
 		return buf.Bytes(), nil
+	case FormatFixedWidth:
+		columns, err := fixedwidth.ParseColumns(s.header)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error parsing header")
+		}
+		buf := new(bytes.Buffer)
+		err = fixedwidth.Write(&fixedwidth.WriteInput{
+			Writer:          buf,
+			Columns:         columns,
+			LineSeparator:   s.lineSeparator,
+			Object:          object,
+			KeySerializer:   keySerializer,
+			ValueSerializer: valueSerializer,
+			Sorted:          s.sorted,
+			Reversed:        s.reversed,
+			Limit:           s.limit,
+			Ruler:           len(columns) == 0, // if the columns are inferred, then include them in the output
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error writing fixed-width text")
+		}
+		return buf.Bytes(), nil
 	case FormatFmt:
 		if s.fit {
 			return []byte(fmt.Sprintf(s.formatSpecifier, fit.Fit(object))), nil
@@ -607,6 +1581,86 @@ func (s *Serializer) Serialize(object interface{}) ([]byte, error) {
 		return json.Marshal(o, s.pretty)
 	case FormatJSONL:
 		return jsonl.Marshal(object, s.lineSeparator, keySerializer, s.pretty, s.limit)
+	case FormatJSONSeq:
+		buf := new(bytes.Buffer)
+		err := jsonseq.Write(&jsonseq.WriteInput{
+			Writer:        buf,
+			KeySerializer: keySerializer,
+			Object:        object,
+			Pretty:        s.pretty,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing JSON text sequence")
+		}
+		return buf.Bytes(), nil
+	case FormatLogfmt:
+		buf := new(bytes.Buffer)
+		err := logfmt.Write(&logfmt.WriteInput{
+			Writer:          buf,
+			Keys:            s.header,
+			ExpandKeys:      s.expandHeader,
+			LineSeparator:   s.lineSeparator,
+			Object:          object,
+			KeySerializer:   keySerializer,
+			ValueSerializer: valueSerializer,
+			Sorted:          s.sorted,
+			Reversed:        s.reversed,
+			Limit:           s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error writing logfmt")
+		}
+		return buf.Bytes(), nil
+	case FormatMsgPack:
+		buf := new(bytes.Buffer)
+		err := msgpack.Write(&msgpack.WriteInput{
+			Writer:        buf,
+			Object:        object,
+			KeySerializer: keySerializer,
+			Limit:         s.limit,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error serializing MessagePack")
+		}
+		return buf.Bytes(), nil
+	case FormatDotEnv, FormatEnv:
+		buf := new(bytes.Buffer)
+		err := dotenv.Write(&dotenv.WriteInput{
+			Writer:          buf,
+			LineSeparator:   s.lineSeparator,
+			Object:          object,
+			KeySerializer:   keySerializer,
+			ValueSerializer: valueSerializer,
+			Sorted:          s.sorted,
+			Reversed:        s.reversed,
+			Export:          s.format == FormatEnv,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error writing environment variables")
+		}
+		return buf.Bytes(), nil
+	case FormatINI:
+		buf := new(bytes.Buffer)
+		err := ini.Write(&ini.WriteInput{
+			Writer:            buf,
+			LineSeparator:     s.lineSeparator,
+			KeyValueSeparator: s.keyValueSeparator,
+			Object:            object,
+			KeySerializer:     keySerializer,
+			ValueSerializer:   valueSerializer,
+			Sorted:            s.sorted,
+			Reversed:          s.reversed,
+			EscapePrefix:      s.escapePrefix,
+			EscapeSpace:       s.escapeSpace,
+			EscapeColon:       s.escapeColon,
+			EscapeNewLine:     s.escapeNewLine,
+			EscapeEqual:       s.escapeEqual,
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error writing INI")
+		}
+		return buf.Bytes(), nil
 	case FormatProperties:
 		buf := new(bytes.Buffer)
 		err := properties.Write(&properties.WriteInput{
@@ -650,6 +1704,36 @@ func (s *Serializer) Serialize(object interface{}) ([]byte, error) {
 			return make([]byte, 0), errors.Wrap(err, "error writing tags")
 		}
 		return buf.Bytes(), nil
+	case FormatHTML, FormatMarkdown, FormatTable:
+		buf := new(bytes.Buffer)
+		err := table.Write(&table.WriteInput{
+			Writer:          buf,
+			Header:          s.header,
+			ExpandHeader:    s.expandHeader,
+			Object:          object,
+			KeySerializer:   keySerializer,
+			ValueSerializer: valueSerializer,
+			Sorted:          s.sorted,
+			Reversed:        s.reversed,
+			Limit:           s.limit,
+			Style: table.Style{
+				Format:   s.format,
+				Borders:  s.borders,
+				Align:    s.align,
+				MaxWidth: s.maxWidth,
+				Wrap:     s.wrap,
+			},
+		})
+		if err != nil {
+			return make([]byte, 0), errors.Wrapf(err, "error writing %s table", s.format)
+		}
+		return buf.Bytes(), nil
+	case FormatXML:
+		o, err := stringify.StringifyMapKeys(object, keySerializer)
+		if err != nil {
+			return make([]byte, 0), errors.Wrap(err, "error stringifying map keys")
+		}
+		return xml.Marshal(o, s.pretty)
 	case FormatTOML, FormatYAML:
 		o, err := stringify.StringifyMapKeys(object, keySerializer)
 		if err != nil {
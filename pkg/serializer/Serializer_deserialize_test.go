@@ -8,9 +8,16 @@
 package serializer
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/json"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 )
 
 /*
@@ -48,6 +55,19 @@ func TestSerializerDeserializeJSON(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"foo": "bar"}, out)
 }
 
+func TestSerializerDeserializeJSON5(t *testing.T) {
+	in := "{\n  // comment\n  foo: 'bar',\n  size: 0x10,\n}"
+	s := New(FormatJSON5)
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"foo": "bar", "size": 16.0}, out)
+}
+
+func TestSerializerDeserializeJSON5Strict(t *testing.T) {
+	_, err := New(FormatJSON5).Strict(true).Deserialize([]byte("{a: 1, 'a': 2}"))
+	assert.IsType(t, &json.ErrDuplicateKey{}, errors.Cause(err))
+}
+
 func TestSerializerDeserializeJSONL(t *testing.T) {
 	in := "{\"a\":\"1\",\"b\":\"2\",\"c\":\"3\"}\n{\"a\":\"4\",\"b\":\"5\",\"c\":\"6\"}\n"
 	expected := []interface{}{
@@ -68,6 +88,72 @@ func TestSerializerDeserializeJSONL(t *testing.T) {
 	assert.Equal(t, expected, out)
 }
 
+func TestSerializerDeserializeJSONSeq(t *testing.T) {
+	in := "\x1e{\"a\":\"1\"}\n\x1e{\"b\":\"2\"}\n\x1e{\"c\":"
+	expected := []interface{}{
+		map[string]interface{}{
+			"a": "1",
+		},
+		map[string]interface{}{
+			"b": "2",
+		},
+	}
+	s := New(FormatJSONSeq).Limit(NoLimit)
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, out)
+}
+
+func TestSerializerDeserializeDotEnv(t *testing.T) {
+	in := "# comment\nexport A=1\nB=\"${A} 2\"\nC='${A}'\n"
+	s := New(FormatDotEnv).LineSeparator("\n")
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"A": "1", "B": "1 2", "C": "${A}"}, out)
+}
+
+func TestSerializerDeserializeFixedWidth(t *testing.T) {
+	in := "0001alice\n0002bob\n"
+	s := New(FormatFixedWidth).LineSeparator("\n").Header([]interface{}{"id:0:4", "name:4:0"})
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		map[string]string{"id": "0001", "name": "alice"},
+		map[string]string{"id": "0002", "name": "bob"},
+	}, out)
+}
+
+func TestSerializerDeserializeINI(t *testing.T) {
+	in := "a=1\n\n[b]\nc=2\n; comment\n[b]\nd=\"3 4\"\n"
+	s := New(FormatINI).LineSeparator("\n")
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "1", "b": map[string]interface{}{"c": "2", "d": "3 4"}}, out)
+}
+
+func TestSerializerDeserializeLogfmt(t *testing.T) {
+	in := "level=info msg=\"hello world\" ok\nlevel=debug\n"
+	s := New(FormatLogfmt).LineSeparator("\n").SkipBlanks(true)
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"level": "info", "msg": "hello world", "ok": nil},
+		map[string]interface{}{"level": "debug"},
+	}, out)
+}
+
+func TestSerializerDeserializeRegex(t *testing.T) {
+	in := "INFO: started\nnot a match\nWARN: disk full\n"
+	s := New(FormatRegex).LineSeparator("\n").Pattern(`^(?P<level>[A-Z]+): (?P<message>.*)$`).NoMatch("raw")
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		map[string]string{"level": "INFO", "message": "started"},
+		map[string]string{"raw": "not a match"},
+		map[string]string{"level": "WARN", "message": "disk full"},
+	}, out)
+}
+
 func TestSerializerDeserializeTags(t *testing.T) {
 	in := "hello=\"beautiful world\""
 	s := New(FormatTags).KeyValueSeparator("=").LineSeparator("\n")
@@ -91,3 +177,109 @@ func TestSerializerDeserializeYaml(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, map[string]interface{}{"a": 1, "b": 2, "c": 3}, out)
 }
+
+func TestSerializerDeserializeXML(t *testing.T) {
+	in := "<a x=\"1\"><b>2</b><b>3</b></a>"
+	s := New(FormatXML)
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"@x": "1", "b": []interface{}{"2", "3"}}}, out)
+}
+
+func TestSerializerDeserializeXMLPath(t *testing.T) {
+	in := "<a x=\"1\"><b>2</b><b>3</b></a>"
+	s := New(FormatXML).XMLPath("/a/b").Limit(NoLimit)
+	out, err := s.Deserialize([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"2", "3"}, out)
+}
+
+func TestSerializerDeserializeLimits(t *testing.T) {
+	_, err := New(FormatJSON).Limits(limits.Limits{MaxTotalBytes: 4}).Deserialize([]byte(`{"a":1}`))
+	assert.IsType(t, &limits.ErrMaxTotalBytes{}, errors.Cause(err))
+
+	_, err = New(FormatTOML).Limits(limits.Limits{MaxDepth: 1}).Deserialize([]byte("[a]\nb = 1\n"))
+	assert.IsType(t, &limits.ErrMaxDepth{}, errors.Cause(err))
+
+	_, err = New(FormatJSONL).LineSeparator("\n").Limits(limits.Limits{MaxKeys: 1}).Deserialize([]byte("{\"a\":1}\n{\"a\":1,\"b\":2}\n"))
+	assert.IsType(t, &limits.ErrMaxKeys{}, errors.Cause(err))
+
+	out, err := New(FormatJSONL).LineSeparator("\n").Limits(limits.Limits{MaxKeys: 1, MaxDepth: 1}).Deserialize([]byte("{\"a\":1}\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"a": 1.0}}, out)
+}
+
+func TestSerializerDeserializeEncoding(t *testing.T) {
+	out, err := New(FormatJSON).Encoding("auto").Deserialize([]byte("\xff\xfe{\x00\"\x00a\x00\"\x00:\x00\"\x00\xe9\x00\"\x00}\x00"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "é"}, out)
+
+	out, err = New(FormatJSON).Encoding("latin1").Deserialize([]byte("{\"a\":\"caf\xe9\"}"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "café"}, out)
+}
+
+func TestSerializerDeserializeEncodingStrict(t *testing.T) {
+	out, err := New(FormatJSON).Strict(true).Encoding("utf-8").Deserialize([]byte("\xef\xbb\xbf{\"a\":\"café\"}"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "café"}, out)
+
+	_, err = New(FormatJSON).Strict(true).Encoding("utf-8").Deserialize([]byte("{\"a\":\"\xff\"}"))
+	assert.Error(t, err)
+
+	_, err = New(FormatJSON).Strict(true).Encoding("auto").Deserialize([]byte("{\"a\":\"\xff\"}"))
+	assert.Error(t, err)
+}
+
+func TestSerializerDeserializeNumberLarge(t *testing.T) {
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+
+	out, err := New(FormatJSON).UseNumber(number.ModeBig).Deserialize([]byte(`{"id":92233720368547758070}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": i}, out)
+
+	out, err = New(FormatYAML).UseNumber(number.ModeBig).Deserialize([]byte("id: 92233720368547758070\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": i}, out)
+
+	out, err = New(FormatTOML).UseNumber(number.ModeBig).Deserialize([]byte("id = 92233720368547758070\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": i}, out)
+
+	// BSON integers are at most 64 bits, so the largest int64 is decoded without losing precision.
+	b, err := New(FormatBSON).Serialize(map[string]interface{}{"id": int64(math.MaxInt64)})
+	assert.NoError(t, err)
+	out, err = New(FormatBSON).UseNumber(number.ModeBig).Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": big.NewInt(math.MaxInt64)}, out)
+}
+
+func TestSerializerNumberRoundTrip(t *testing.T) {
+	in := []byte(`{"f":1.5,"id":9007199254740993}`)
+	for _, mode := range []string{number.ModeNumber, number.ModeBig} {
+		obj, err := New(FormatJSON).UseNumber(mode).Deserialize(in)
+		assert.NoError(t, err)
+
+		out, err := New(FormatJSON).Serialize(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, string(in), string(out), mode)
+
+		out, err = New(FormatYAML).Serialize(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, "f: 1.5\nid: 9007199254740993\n", string(out), mode)
+	}
+
+	large := []byte("id: 92233720368547758070\n")
+	for _, mode := range []string{number.ModeNumber, number.ModeBig} {
+		obj, err := New(FormatYAML).UseNumber(mode).Deserialize(large)
+		assert.NoError(t, err)
+
+		out, err := New(FormatYAML).Serialize(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, string(large), string(out), mode)
+
+		out, err = New(FormatJSON).Serialize(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id":92233720368547758070}`, string(out), mode)
+	}
+}
@@ -8,13 +8,34 @@
 package serializer
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
 
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
 
+func TestSerializerSerializeAvro(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"a": "x", "b": 1},
+		map[string]interface{}{"a": "y"},
+	}
+	s := New(FormatAvro).Limit(NoLimit).Codec("snappy")
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"a": "x", "b": int64(1)},
+		map[string]interface{}{"a": "y", "b": nil},
+	}, out)
+}
+
 func TestSerializerSerializeBSON(t *testing.T) {
 	in := map[string]interface{}{
 		"foo": "bar",
@@ -27,6 +48,45 @@ func TestSerializerSerializeBSON(t *testing.T) {
 	assert.Equal(t, in, out)
 }
 
+func TestSerializerSerializeBSONStream(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"a": "1"},
+		map[string]interface{}{"b": "2"},
+	}
+	s := New(FormatBSON)
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestSerializerSerializeExtendedJSON(t *testing.T) {
+	in := map[string]interface{}{"a": 1, "b": "x"}
+	s := New(FormatJSON).ExtendedJSON("canonical")
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":{"$numberInt":"1"},"b":"x"}`, string(b))
+}
+
+func TestSerializerSerializeCBOR(t *testing.T) {
+	in := []map[string]interface{}{
+		map[string]interface{}{
+			"a": "1",
+		},
+		map[string]interface{}{
+			"b": "2",
+		},
+	}
+	s := New(FormatCBOR).Limit(NoLimit).Type(reflect.TypeOf(in))
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xa1, 0x61, 0x61, 0x61, 0x31, 0xa1, 0x61, 0x62, 0x61, 0x32}, b)
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
 func TestSerializerCSVMap(t *testing.T) {
 	in := map[interface{}]interface{}{
 		"a": "x",
@@ -141,6 +201,73 @@ func TestSerializerGoStruct(t *testing.T) {
 	assert.Equal(t, "struct { A string; B string; C string }{A:\"1\", B:\"2\", C:\"3\"}", string(out))
 }
 
+func TestSerializerSerializeDotEnv(t *testing.T) {
+	in := map[string]interface{}{"a": "x y", "b": map[string]interface{}{"c": 2}}
+	s := New(FormatDotEnv).Sorted(true).LineSeparator("\n")
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "a=\"x y\"\nb_c=2\n", string(out))
+}
+
+func TestSerializerSerializeEnv(t *testing.T) {
+	in := map[string]interface{}{"a": "it's", "b": map[string]interface{}{"c": 2}}
+	s := New(FormatEnv).Sorted(true).LineSeparator("\n").KeySerializer(stringify.NewStringer("", false, false, true))
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "export A='it'\\''s'\nexport B_C='2'\n", string(out))
+}
+
+func TestSerializerSerializeEncoding(t *testing.T) {
+	in := []interface{}{map[string]interface{}{"a": "é"}}
+	out, err := New(FormatCSV).Limit(NoLimit).BOM(true).Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "\xef\xbb\xbfa\n\xc3\xa9\n", string(out))
+
+	out, err = New(FormatCSV).Limit(NoLimit).Encoding("latin1").Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "a\n\xe9\n", string(out))
+}
+
+func TestSerializerSerializeFixedWidth(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"id": 1, "name": "alice"},
+		map[string]interface{}{"id": 2, "name": "bob"},
+	}
+	s := New(FormatFixedWidth).LineSeparator("\n").Limit(NoLimit).Header([]interface{}{"id:0:4", "name:4:3"})
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "1   ali\n2   bob\n", string(out))
+}
+
+func TestSerializerSerializeGeoJSON(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"a": "x", "lon": "1", "lat": "2"},
+		map[string]interface{}{"a": "y", "wkt": "LINESTRING (1 2, 3 4)"},
+	}
+	s := New(FormatGeoJSON).Limit(NoLimit).Longitude("lon").Latitude("lat").WKT("wkt")
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"FeatureCollection","features":[
+{"type":"Feature","geometry":{"coordinates":[1,2],"type":"Point"},"properties":{"a":"x"}},
+{"type":"Feature","geometry":{"coordinates":[[1,2],[3,4]],"type":"LineString"},"properties":{"a":"y"}}
+]}
+`, string(b))
+	out, err := s.Flatten(true).Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"a": "x", "lon": 1.0, "lat": 2.0},
+		map[string]interface{}{"a": "y", "wkt": "LINESTRING (1 2, 3 4)"},
+	}, out)
+}
+
+func TestSerializerSerializeINI(t *testing.T) {
+	in := map[string]interface{}{"a": 1, "b": map[string]interface{}{"c": 2}}
+	s := New(FormatINI).Sorted(true).LineSeparator("\n").KeyValueSeparator("=")
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "a=1\n\n[b]\nc=2\n", string(out))
+}
+
 func TestSerializerSerializeJSON(t *testing.T) {
 	in := map[interface{}]interface{}{
 		"foo": "bar",
@@ -170,6 +297,141 @@ func TestSerializerSerializeJSONL(t *testing.T) {
 	assert.Equal(t, "{\"a\":\"1\",\"b\":\"2\",\"c\":\"3\"}\n{\"a\":\"4\",\"b\":\"5\",\"c\":\"6\"}\n", string(out))
 }
 
+func TestSerializerSerializeJSONSeq(t *testing.T) {
+	in := []map[string]interface{}{
+		map[string]interface{}{
+			"a": "1",
+		},
+		map[string]interface{}{
+			"b": "2",
+		},
+	}
+	s := New(FormatJSONSeq).Limit(NoLimit)
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "\x1e{\"a\":\"1\"}\n\x1e{\"b\":\"2\"}\n", string(out))
+}
+
+func TestSerializerSerializeLogfmt(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"level": "info", "msg": "hello world", "ok": nil},
+		map[string]interface{}{"level": "debug"},
+	}
+	s := New(FormatLogfmt).
+		LineSeparator("\n").
+		ValueSerializer(stringify.NewStringer("", false, false, false)).
+		Limit(NoLimit).
+		Sorted(true)
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "level=info msg=\"hello world\" ok\nlevel=debug\n", string(out))
+}
+
+func TestSerializerSerializeMarkdown(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"name": "alice", "age": 30},
+		map[string]interface{}{"name": "bob", "age": 4},
+	}
+	s := New(FormatMarkdown).Limit(NoLimit).Sorted(true)
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "| age | name  |\n| --: | ----- |\n|  30 | alice |\n|   4 | bob   |\n", string(out))
+}
+
+func TestSerializerSerializeMsgPack(t *testing.T) {
+	in := []map[string]interface{}{
+		map[string]interface{}{
+			"a": "1",
+		},
+		map[string]interface{}{
+			"b": "2",
+		},
+	}
+	s := New(FormatMsgPack).Limit(NoLimit).Type(reflect.TypeOf(in))
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x81, 0xa1, 0x61, 0xa1, 0x31, 0x81, 0xa1, 0x62, 0xa1, 0x32}, b)
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestSerializerSerializeParquet(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"a": "x", "b": "1"},
+		map[string]interface{}{"a": "y"},
+	}
+	s := New(FormatParquet).Limit(NoLimit).Codec("gzip")
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	out, err := s.Columns([]string{"b"}).Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		map[string]interface{}{"b": int64(1)},
+		map[string]interface{}{"b": nil},
+	}, out)
+}
+
+func TestSerializerSerializePlist(t *testing.T) {
+	in := map[string]interface{}{
+		"a": "x",
+		"b": []interface{}{int64(1), 2.5, true},
+	}
+	s := New(FormatPlist).PlistFormat("binary")
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "bplist00", string(b[:8]))
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestSerializerSerializeProtobuf(t *testing.T) {
+	set := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(sourcecontextpb.File_google_protobuf_source_context_proto),
+		},
+	}
+	descriptorSet, err := proto.Marshal(set)
+	assert.NoError(t, err)
+	in := []interface{}{
+		map[string]interface{}{"fileName": "a.proto"},
+		map[string]interface{}{"file_name": "b.proto"},
+	}
+	s := New(FormatProtobuf).Limit(NoLimit).DescriptorSet(descriptorSet).Message("google.protobuf.SourceContext")
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("\x09\x0a\x07a.proto\x09\x0a\x07b.proto"), b)
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"fileName": "a.proto"},
+		map[string]interface{}{"fileName": "b.proto"},
+	}, out)
+}
+
+func TestSerializerSerializeSQL(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"name": "alice", "age": 30},
+		map[string]interface{}{"name": "bob", "age": nil},
+	}
+	s := New(FormatSQL).Limit(NoLimit).Sorted(true).Table("people").Dialect("sqlite").CreateTable(true)
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE \"people\" (\n  \"age\" INTEGER,\n  \"name\" TEXT\n);\nINSERT INTO \"people\" (\"age\", \"name\") VALUES\n(30, 'alice'),\n(NULL, 'bob');\n", string(out))
+}
+
+func TestSerializerSerializeTable(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"name": "alice", "age": 30},
+		map[string]interface{}{"name": "bob", "age": 4},
+	}
+	s := New(FormatTable).Limit(NoLimit).Sorted(true).Borders("box").Align("left")
+	out, err := s.Serialize(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "┌─────┬───────┐\n│ age │ name  │\n├─────┼───────┤\n│ 30  │ alice │\n│ 4   │ bob   │\n└─────┴───────┘\n", string(out))
+}
+
 func TestSerializerSerializeTags(t *testing.T) {
 	in := map[interface{}]interface{}{
 		"hello": "beautiful world",
@@ -193,6 +455,22 @@ func TestSerializerSerializeToml(t *testing.T) {
 	assert.Equal(t, "a = 1.0\nb = 2.0\nc = 3.0\n", string(out))
 }
 
+func TestSerializerSerializeXLSX(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{"a": "x", "b": 1},
+		map[string]interface{}{"a": "y"},
+	}
+	s := New(FormatXLSX).Limit(NoLimit).Sorted(true).Sheet("Data").AutoFilter(true)
+	b, err := s.Serialize(in)
+	assert.NoError(t, err)
+	out, err := s.Deserialize(b)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		map[string]string{"a": "x", "b": "1"},
+		map[string]string{"a": "y", "b": ""},
+	}, out)
+}
+
 func TestSerializerSerializeYaml(t *testing.T) {
 	in := map[string]interface{}{"a": 1.0, "b": 2.0, "c": 3.0}
 	s := New(FormatYAML)
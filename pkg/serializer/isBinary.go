@@ -0,0 +1,17 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package serializer
+
+// isBinary returns true if the format is a binary format, which is not transcoded between text encodings.
+func isBinary(format string) bool {
+	switch format {
+	case FormatAvro, FormatBSON, FormatCBOR, FormatGob, FormatMsgPack, FormatParquet, FormatPlist, FormatProtobuf, FormatXLSX:
+		return true
+	}
+	return false
+}
@@ -17,7 +17,7 @@ import (
 // Returns a new bufio.SplitFunc compatible with bufio.Scanner
 //
 // Examples:
-//	- ScanLines([]byte("\n")[0], true) - split on new lines and drop carriage returns at the end of a line.
+//	- ScanLines("\n", true) - split on new lines and drop carriage returns at the end of a line.
 //	- ScanLines(byte(0), false) - split on null byte
 func ScanLines(separator byte, dropCR bool) bufio.SplitFunc {
 	return bufio.SplitFunc(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
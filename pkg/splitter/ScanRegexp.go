@@ -0,0 +1,52 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package splitter
+
+import (
+	"bufio"
+	"regexp"
+)
+
+// ScanRegexp returns a function that splits a stream of bytes on
+// matches of the given regular expression and whether to drop line-ending carriage returns.
+// A match that reaches the end of the buffered data is only used once more data has been read,
+// since the match may continue, e.g., with `\n+`.
+// If the regular expression matches an empty string, then the function returns ErrEmptySeparator.
+// Returns a new bufio.SplitFunc compatible with bufio.Scanner
+//
+// Examples:
+//	- ScanRegexp(regexp.MustCompile("\n+"), false) - split on one or more new lines, skipping blank lines.
+//	- ScanRegexp(regexp.MustCompile("\r?\n|\r"), false) - split on any line ending.
+func ScanRegexp(re *regexp.Regexp, dropCR bool) bufio.SplitFunc {
+	return bufio.SplitFunc(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if loc := re.FindIndex(data); loc != nil {
+			if loc[0] == loc[1] {
+				return 0, nil, ErrEmptySeparator
+			}
+			if loc[1] < len(data) || atEOF {
+				// We have a full separator-terminated line.
+				if dropCR {
+					return loc[1], DropCarriageReturn(data[0:loc[0]]), nil
+				}
+				return loc[1], data[0:loc[0]], nil
+			}
+		}
+		// If we're at EOF, we have a final, non-terminated line. Return it.
+		if atEOF {
+			if dropCR {
+				return len(data), DropCarriageReturn(data), nil
+			}
+			return len(data), data, nil
+		}
+		// Request more data.
+		return 0, nil, nil
+	})
+}
@@ -0,0 +1,32 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package splitter
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanRegexp(t *testing.T) {
+	// Read one byte at a time, so that matches at the end of the buffer are tested.
+	s := bufio.NewScanner(iotest.OneByteReader(strings.NewReader("a\n\n\nb\r\nc\n")))
+	s.Split(ScanRegexp(regexp.MustCompile("(\r?\n)+"), false))
+	tokens, err := scan(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, tokens)
+
+	s = bufio.NewScanner(strings.NewReader("a\nb"))
+	s.Split(ScanRegexp(regexp.MustCompile("x*"), false))
+	_, err = scan(s)
+	assert.Equal(t, ErrEmptySeparator, err)
+}
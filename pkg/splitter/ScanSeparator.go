@@ -0,0 +1,52 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package splitter
+
+import (
+	"bufio"
+	"bytes"
+)
+
+// ScanSeparator returns a function that splits a stream of bytes on
+// the given separator, which can be any sequence of bytes, and whether to drop line-ending carriage returns.
+// If the separator is a single byte, then ScanSeparator is equivalent to ScanLines.
+// Returns a new bufio.SplitFunc compatible with bufio.Scanner
+//
+// Examples:
+//	- ScanSeparator([]byte("\r\n"), false) - split on carriage return and new line pairs, but not on single new lines.
+//	- ScanSeparator([]byte("\x1e\n"), false) - split on a record separator followed by a new line.
+//	- ScanSeparator([]byte("||"), false) - split on double pipes.
+func ScanSeparator(separator []byte, dropCR bool) bufio.SplitFunc {
+	if len(separator) == 1 {
+		return ScanLines(separator[0], dropCR)
+	}
+	return bufio.SplitFunc(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if len(separator) == 0 {
+			return 0, nil, ErrEmptySeparator
+		}
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.Index(data, separator); i >= 0 {
+			// We have a full separator-terminated line.
+			if dropCR {
+				return i + len(separator), DropCarriageReturn(data[0:i]), nil
+			}
+			return i + len(separator), data[0:i], nil
+		}
+		// If we're at EOF, we have a final, non-terminated line. Return it.
+		if atEOF {
+			if dropCR {
+				return len(data), DropCarriageReturn(data), nil
+			}
+			return len(data), data, nil
+		}
+		// Request more data.
+		return 0, nil, nil
+	})
+}
@@ -0,0 +1,44 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package splitter
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func scan(s *bufio.Scanner) ([]string, error) {
+	tokens := make([]string, 0)
+	for s.Scan() {
+		tokens = append(tokens, s.Text())
+	}
+	return tokens, s.Err()
+}
+
+func TestScanSeparator(t *testing.T) {
+	s := bufio.NewScanner(strings.NewReader("a\nb\r\nc||d\r\n"))
+	s.Split(ScanSeparator([]byte("\r\n"), false))
+	tokens, err := scan(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a\nb", "c||d"}, tokens)
+
+	s = bufio.NewScanner(strings.NewReader("a||b|c||"))
+	s.Split(ScanSeparator([]byte("||"), false))
+	tokens, err = scan(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b|c"}, tokens)
+
+	s = bufio.NewScanner(strings.NewReader("a\r\x1e\nb"))
+	s.Split(ScanSeparator([]byte("\x1e\n"), true))
+	tokens, err = scan(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tokens)
+}
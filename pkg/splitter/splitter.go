@@ -10,3 +10,11 @@
 // Reference:
 //  - https://godoc.org/bufio#SplitFunc
 package splitter
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrEmptySeparator = errors.New("separator is empty")
+)
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"fmt"
+)
+
+// ErrInvalidDialect is used when the dialect is not one of the supported dialects.
+type ErrInvalidDialect struct {
+	Value string // the invalid dialect
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidDialect) Error() string {
+	return fmt.Sprintf("invalid dialect %q, expecting one of %q", e.Value, Dialects)
+}
@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"fmt"
+)
+
+// ErrInvalidStatement is used when the statement is not one of the supported statements,
+// or is not supported by the dialect.
+type ErrInvalidStatement struct {
+	Value   string // the invalid statement
+	Dialect string // the dialect
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidStatement) Error() string {
+	if stringSliceContains(Statements, e.Value) {
+		return fmt.Sprintf("statement %q is not supported by dialect %q", e.Value, e.Dialect)
+	}
+	return fmt.Sprintf("invalid statement %q, expecting one of %q", e.Value, Statements)
+}
@@ -0,0 +1,76 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer          io.Writer // the underlying writer
+	Table           string    // the name of the table, optionally qualified by a schema
+	Dialect         string    // the SQL dialect.  If blank, then uses DefaultDialect.
+	Statement       string    // the statement used to write rows.  If blank, then uses DefaultStatement.
+	Header          []interface{}
+	CreateTable     bool // write a CREATE TABLE statement before the first row
+	BatchSize       int  // the number of rows in each INSERT statement.  If less than one, then uses DefaultBatchSize.
+	KeySerializer   stringify.Stringer
+	ValueSerializer stringify.Stringer
+	Object          interface{} // the object to write
+	Sorted          bool        // sort columns
+	Reversed        bool        // if sorted, sort in reverse alphabetical order.
+	Limit           int         // the maximum number of rows to write, if less than zero, then unlimited.
+}
+
+// Write writes the given object(s) as SQL statements.
+// If the type of the input object is of kind Array or Slice, then writes each object as its own row.
+// Otherwise, just writes a single row for the object.
+func Write(input *WriteInput) error {
+	w, err := NewWriter(
+		input.Writer,
+		input.Table,
+		input.Dialect,
+		input.Statement,
+		input.Header,
+		input.CreateTable,
+		input.BatchSize,
+		input.KeySerializer,
+		input.ValueSerializer,
+		input.Sorted,
+		input.Reversed,
+	)
+	if err != nil {
+		return errors.Wrap(err, "error creating writer")
+	}
+	value := reflect.ValueOf(input.Object)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < value.Len() && (input.Limit < 0 || i < input.Limit); i++ {
+			if err := w.WriteObject(value.Index(i).Interface()); err != nil {
+				return errors.Wrapf(err, "error writing object %d", i)
+			}
+		}
+	default:
+		if err := w.WriteObject(input.Object); err != nil {
+			return errors.Wrap(err, "error writing object")
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "error flushing writer")
+	}
+	return nil
+}
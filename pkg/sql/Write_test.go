@@ -0,0 +1,63 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:      buf,
+		Table:       "places",
+		CreateTable: true,
+		Object: []interface{}{
+			map[string]interface{}{"a": "x", "b": 1},
+			map[string]interface{}{"a": "y", "b": 2},
+			map[string]interface{}{"a": "z", "b": 3},
+		},
+		Sorted: true,
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE "places" (
+  "a" TEXT,
+  "b" BIGINT
+);
+INSERT INTO "places" ("a", "b") VALUES
+('x', 1),
+('y', 2);
+`, buf.String())
+}
+
+func TestWriteObject(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer:  buf,
+		Table:   "places",
+		Dialect: DialectSQLite,
+		Object:  map[string]interface{}{"a": "x", "b": true},
+		Sorted:  true,
+		Limit:   -1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO \"places\" (\"a\", \"b\") VALUES\n('x', 1);\n", buf.String())
+}
+
+func TestWriteMissingTable(t *testing.T) {
+	err := Write(&WriteInput{
+		Writer: new(bytes.Buffer),
+		Object: map[string]interface{}{"a": "x"},
+		Limit:  -1,
+	})
+	require.Error(t, err)
+}
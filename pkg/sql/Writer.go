@@ -0,0 +1,325 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as SQL statements.
+type Writer struct {
+	writer          io.Writer
+	table           string        // the name of the table, optionally qualified by a schema
+	dialect         string        // the SQL dialect
+	statement       string        // the statement used to write rows, either insert or copy
+	columns         []interface{} // the columns of the table.  If empty or includes a wildcard, then created from the first batch.
+	createTable     bool          // write a CREATE TABLE statement before the first row
+	batchSize       int           // the number of rows in each INSERT statement
+	keySerializer   stringify.Stringer
+	valueSerializer stringify.Stringer
+	sorted          bool
+	reversed        bool
+	noDataValue     string          // the serialized no-data value, which is written as NULL
+	header          []interface{}   // the header, once initialized from the first batch
+	names           []string        // the quoted names of the columns in the header
+	kinds           []string        // the kinds of the columns in the header
+	objects         []reflect.Value // the objects in the current batch
+	started         bool            // the header has been initialized
+	copying         bool            // a COPY statement has been started, but not terminated
+}
+
+// NewWriter returns a new Writer for writing objects to an underlying writer as SQL statements for the given table.
+// If the dialect is blank, then uses DefaultDialect.
+// If the statement is blank, then uses DefaultStatement.  The copy statement is only supported by PostgreSQL.
+// If the batch size is less than one, then uses DefaultBatchSize.
+// The header and the kinds of the columns are inferred from the first batch of objects,
+// so objects are cached in memory until a batch is complete or the writer is flushed.
+func NewWriter(w io.Writer, table string, dialect string, statement string, columns []interface{}, createTable bool, batchSize int, keySerializer stringify.Stringer, valueSerializer stringify.Stringer, sorted bool, reversed bool) (*Writer, error) {
+
+	if len(table) == 0 {
+		return nil, ErrMissingTable
+	}
+
+	if len(dialect) == 0 {
+		dialect = DefaultDialect
+	}
+	if !stringSliceContains(Dialects, dialect) {
+		return nil, &ErrInvalidDialect{Value: dialect}
+	}
+
+	if len(statement) == 0 {
+		statement = DefaultStatement
+	}
+	if !stringSliceContains(Statements, statement) || (statement == StatementCopy && dialect != DialectPostgres) {
+		return nil, &ErrInvalidStatement{Value: statement, Dialect: dialect}
+	}
+
+	if batchSize < 1 {
+		batchSize = DefaultBatchSize
+	}
+
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	noDataValue, err := valueSerializer(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error serializing missing value")
+	}
+
+	return &Writer{
+		writer:          w,
+		table:           table,
+		dialect:         dialect,
+		statement:       statement,
+		columns:         columns,
+		createTable:     createTable,
+		batchSize:       batchSize,
+		keySerializer:   keySerializer,
+		valueSerializer: valueSerializer,
+		sorted:          sorted,
+		reversed:        reversed,
+		noDataValue:     noDataValue,
+		objects:         make([]reflect.Value, 0, batchSize),
+	}, nil
+}
+
+// write writes the string to the underlying writer.
+func (w *Writer) write(str string) error {
+	_, err := io.WriteString(w.writer, str)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// start initializes the header and the kinds of the columns from the current batch,
+// and writes the CREATE TABLE statement, if enabled.
+func (w *Writer) start() error {
+	w.started = true
+
+	header := w.columns
+	wildcard := false
+	knownKeys := map[interface{}]struct{}{}
+	for _, k := range header {
+		if str, ok := k.(string); ok && str == sv.Wildcard {
+			wildcard = true
+		} else {
+			knownKeys[k] = struct{}{}
+		}
+	}
+
+	switch {
+	case len(header) == 0:
+		if len(w.objects) > 0 {
+			header, knownKeys = sv.CreateHeaderAndKnownKeysFromValue(w.objects[0], w.sorted, w.reversed)
+			for _, object := range w.objects[1:] {
+				header, knownKeys = sv.ExpandHeader(header, knownKeys, object, w.sorted, w.reversed)
+			}
+		}
+	case wildcard:
+		for _, object := range w.objects {
+			header, knownKeys = sv.ExpandHeaderWithWildcard(header, knownKeys, object, w.sorted, w.reversed)
+		}
+		header = sv.RemoveWildcard(header)
+	}
+
+	if len(header) == 0 {
+		return nil
+	}
+
+	names, err := stringify.StringifySlice(header, w.keySerializer)
+	if err != nil {
+		return errors.Wrapf(err, "error stringifying header %q", header)
+	}
+
+	kinds := make([]string, 0, len(header))
+	for i, name := range names {
+		c := &column{}
+		for _, object := range w.objects {
+			c.observe(field(object, header[i]))
+		}
+		kinds = append(kinds, c.kind())
+		names[i] = quoteIdentifier(w.dialect, name)
+	}
+
+	w.header = header
+	w.names = names
+	w.kinds = kinds
+
+	if w.createTable {
+		definitions := make([]string, 0, len(names))
+		for i, name := range names {
+			definitions = append(definitions, "  "+name+" "+columnType(w.dialect, kinds[i]))
+		}
+		err := w.write(fmt.Sprintf("CREATE TABLE %s (\n%s\n);\n", quoteTable(w.dialect, w.table), strings.Join(definitions, ",\n")))
+		if err != nil {
+			return errors.Wrap(err, "error writing create table statement")
+		}
+	}
+
+	return nil
+}
+
+// row returns the formatted values and their classes for the columns of the object.
+func (w *Writer) row(object reflect.Value) ([]string, []int, error) {
+	values := make([]string, len(w.header))
+	classes := make([]int, len(w.header))
+	for i, column := range w.header {
+		str, class, err := formatValue(w.dialect, w.kinds[i], field(object, column), w.valueSerializer, w.noDataValue)
+		if err != nil {
+			return values, classes, errors.Wrapf(err, "error formatting column %q", fmt.Sprint(column))
+		}
+		values[i] = str
+		classes[i] = class
+	}
+	return values, classes, nil
+}
+
+// writeBatch writes the objects in the current batch as an INSERT statement or as rows of a COPY statement.
+func (w *Writer) writeBatch() error {
+	if len(w.objects) == 0 {
+		return nil
+	}
+
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	objects := w.objects
+	w.objects = make([]reflect.Value, 0, w.batchSize)
+
+	if len(w.header) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	if w.statement == StatementCopy {
+		if !w.copying {
+			b.WriteString(fmt.Sprintf("COPY %s (%s) FROM stdin;\n", quoteTable(w.dialect, w.table), strings.Join(w.names, ", ")))
+			w.copying = true
+		}
+		for _, object := range objects {
+			values, classes, err := w.row(object)
+			if err != nil {
+				return err
+			}
+			for i := range values {
+				values[i] = copyValue(values[i], classes[i])
+			}
+			b.WriteString(strings.Join(values, "\t") + "\n")
+		}
+	} else {
+		b.WriteString(fmt.Sprintf("INSERT INTO %s (%s) VALUES\n", quoteTable(w.dialect, w.table), strings.Join(w.names, ", ")))
+		for j, object := range objects {
+			values, classes, err := w.row(object)
+			if err != nil {
+				return err
+			}
+			for i := range values {
+				values[i] = literal(w.dialect, values[i], classes[i])
+			}
+			b.WriteString("(" + strings.Join(values, ", ") + ")")
+			if j < len(objects)-1 {
+				b.WriteString(",\n")
+			} else {
+				b.WriteString(";\n")
+			}
+		}
+	}
+
+	return w.write(b.String())
+}
+
+// WriteObject adds the object to the current batch, and writes the batch if complete.
+func (w *Writer) WriteObject(obj interface{}) error {
+	objectValue := reflect.ValueOf(obj)
+	for objectValue.IsValid() && (objectValue.Kind() == reflect.Ptr || objectValue.Kind() == reflect.Interface) {
+		objectValue = objectValue.Elem()
+	}
+	if !objectValue.IsValid() || (objectValue.Kind() != reflect.Map && objectValue.Kind() != reflect.Struct) {
+		return errors.New(fmt.Sprintf("could not write the given value with type %T as a row", obj))
+	}
+	w.objects = append(w.objects, objectValue)
+	if len(w.objects) >= w.batchSize {
+		return w.writeBatch()
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the current batch and terminates the COPY statement, if started.
+// If no objects were written, but the columns were given, then the CREATE TABLE statement is still written, if enabled.
+// Flush also flushes the underlying writer, if it has a Flush method.
+func (w *Writer) Flush() error {
+	if err := w.writeBatch(); err != nil {
+		return err
+	}
+	if !w.started && w.createTable && len(w.columns) > 0 {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+	if w.copying {
+		if err := w.write("\\.\n"); err != nil {
+			return errors.Wrap(err, "error terminating copy statement")
+		}
+		w.copying = false
+	}
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+func TestWriterBatches(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "public.places", DialectPostgres, StatementInsert, nil, false, 2, nil, nil, true, false)
+	require.NoError(t, err)
+	err = w.WriteObjects([]interface{}{
+		map[string]interface{}{"name": "O'Hare", "count": 1},
+		map[string]interface{}{"name": "Dulles", "count": 2},
+		map[string]interface{}{"name": "Reagan", "count": nil},
+	})
+	require.NoError(t, err)
+	err = w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, `INSERT INTO "public"."places" ("count", "name") VALUES
+(1, 'O''Hare'),
+(2, 'Dulles');
+INSERT INTO "public"."places" ("count", "name") VALUES
+(NULL, 'Reagan');
+`, buf.String())
+}
+
+func TestWriterCreateTable(t *testing.T) {
+	testCases := []struct {
+		dialect string
+		output  string
+	}{
+		{
+			dialect: DialectPostgres,
+			output:  "CREATE TABLE \"t\" (\n  \"active\" BOOLEAN,\n  \"code\" TEXT,\n  \"count\" BIGINT,\n  \"created\" TIMESTAMPTZ,\n  \"score\" DOUBLE PRECISION\n);\n",
+		},
+		{
+			dialect: DialectMySQL,
+			output:  "CREATE TABLE `t` (\n  `active` BOOLEAN,\n  `code` TEXT,\n  `count` BIGINT,\n  `created` DATETIME(6),\n  `score` DOUBLE\n);\n",
+		},
+		{
+			dialect: DialectSQLite,
+			output:  "CREATE TABLE \"t\" (\n  \"active\" INTEGER,\n  \"code\" TEXT,\n  \"count\" INTEGER,\n  \"created\" TEXT,\n  \"score\" REAL\n);\n",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.dialect, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w, err := NewWriter(buf, "t", testCase.dialect, "", nil, true, 0, nil, nil, true, false)
+			require.NoError(t, err)
+			err = w.WriteObjects([]interface{}{
+				map[string]interface{}{"active": "true", "code": "02134", "count": "10", "created": time.Unix(0, 0), "score": 1},
+				map[string]interface{}{"active": "false", "code": "10001", "count": "", "created": nil, "score": "2.5"},
+			})
+			require.NoError(t, err)
+			err = w.Flush()
+			require.NoError(t, err)
+			require.Contains(t, buf.String(), testCase.output)
+		})
+	}
+}
+
+func TestWriterValues(t *testing.T) {
+	buf := new(bytes.Buffer)
+	valueSerializer := stringify.NewStringer("-", false, false, false)
+	w, err := NewWriter(buf, "t", DialectMySQL, StatementInsert, []interface{}{"a", "b", "c", "d"}, false, 0, nil, valueSerializer, false, false)
+	require.NoError(t, err)
+	err = w.WriteObject(map[string]interface{}{"a": "-", "b": "back\\slash", "c": false, "d": time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)})
+	require.NoError(t, err)
+	err = w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO `t` (`a`, `b`, `c`, `d`) VALUES\n(NULL, 'back\\\\slash', FALSE, '2020-01-02 03:04:05');\n", buf.String())
+}
+
+func TestWriterCopy(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "t", DialectPostgres, StatementCopy, []interface{}{"a", "*"}, false, 1, nil, nil, true, false)
+	require.NoError(t, err)
+	err = w.WriteObjects([]interface{}{
+		map[string]interface{}{"a": "x\ty", "b": true},
+		map[string]interface{}{"a": nil, "b": false},
+	})
+	require.NoError(t, err)
+	err = w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, "COPY \"t\" (\"a\", \"b\") FROM stdin;\nx\\ty\ttrue\n\\N\tfalse\n\\.\n", buf.String())
+}
+
+func TestWriterInvalid(t *testing.T) {
+	_, err := NewWriter(new(bytes.Buffer), "t", "oracle", "", nil, false, 0, nil, nil, false, false)
+	require.Equal(t, &ErrInvalidDialect{Value: "oracle"}, err)
+	_, err = NewWriter(new(bytes.Buffer), "t", DialectSQLite, StatementCopy, nil, false, 0, nil, nil, false, false)
+	require.Equal(t, &ErrInvalidStatement{Value: StatementCopy, Dialect: DialectSQLite}, err)
+	_, err = NewWriter(new(bytes.Buffer), "", "", "", nil, false, 0, nil, nil, false, false)
+	require.Equal(t, ErrMissingTable, err)
+}
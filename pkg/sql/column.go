@@ -0,0 +1,164 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	stdjson "encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// column is the set of types observed for the values of a column.
+type column struct {
+	boolean bool
+	integer bool
+	float   bool
+	time    bool
+	text    bool
+}
+
+// isNumeric returns true if the string only contains the characters of a decimal number.
+// This excludes values like "Inf" and "NaN" that strconv.ParseFloat accepts.
+func isNumeric(str string) bool {
+	return len(str) > 0 && len(strings.Trim(str, "0123456789+-.eE")) == 0
+}
+
+// isInteger returns true if the string is a decimal integer without leading zeros.
+func isInteger(str string) bool {
+	if len(str) > 1 && str[0] == '0' {
+		return false
+	}
+	_, err := strconv.ParseInt(str, 10, 64)
+	return isNumeric(str) && err == nil
+}
+
+// isFloat returns true if the string is a decimal number without leading zeros.
+func isFloat(str string) bool {
+	if len(str) > 1 && str[0] == '0' && str[1] != '.' {
+		return false
+	}
+	_, err := strconv.ParseFloat(str, 64)
+	return isNumeric(str) && err == nil
+}
+
+// observe adds the type of the value to the observed types.
+// Nil values are ignored.
+func (c *column) observe(value reflect.Value) {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return
+	}
+	switch x := value.Interface().(type) {
+	case time.Time:
+		c.time = true
+		return
+	case stdjson.Number:
+		if _, err := x.Int64(); err == nil {
+			c.integer = true
+		} else {
+			c.float = true
+		}
+		return
+	case string:
+		str := strings.TrimSpace(x)
+		switch {
+		case len(str) == 0:
+		case str == "true" || str == "false":
+			c.boolean = true
+		case isInteger(str):
+			c.integer = true
+		case isFloat(str):
+			c.float = true
+		default:
+			c.text = true
+		}
+		return
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		c.boolean = true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c.integer = true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		c.integer = true
+	case reflect.Float32, reflect.Float64:
+		if f := value.Float(); f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			c.integer = true
+		} else {
+			c.float = true
+		}
+	case reflect.Map, reflect.Slice:
+		if !value.IsNil() {
+			c.text = true
+		}
+	default:
+		c.text = true
+	}
+}
+
+// kind returns the kind of the column: "boolean", "integer", "float", "time", or "text".
+// A column with both integers and floats is a float column.
+// A column with no values or mixed values is a text column.
+func (c *column) kind() string {
+	switch {
+	case c.text:
+	case c.boolean && !c.integer && !c.float && !c.time:
+		return "boolean"
+	case c.time && !c.boolean && !c.integer && !c.float:
+		return "time"
+	case c.integer && !c.float && !c.boolean && !c.time:
+		return "integer"
+	case c.float && !c.boolean && !c.time:
+		return "float"
+	}
+	return "text"
+}
+
+// columnType returns the type of a column of the given kind for the dialect.
+func columnType(dialect string, kind string) string {
+	switch dialect {
+	case DialectMySQL:
+		switch kind {
+		case "boolean":
+			return "BOOLEAN"
+		case "integer":
+			return "BIGINT"
+		case "float":
+			return "DOUBLE"
+		case "time":
+			return "DATETIME(6)"
+		}
+	case DialectSQLite:
+		switch kind {
+		case "boolean", "integer":
+			return "INTEGER"
+		case "float":
+			return "REAL"
+		}
+	default:
+		switch kind {
+		case "boolean":
+			return "BOOLEAN"
+		case "integer":
+			return "BIGINT"
+		case "float":
+			return "DOUBLE PRECISION"
+		case "time":
+			return "TIMESTAMPTZ"
+		}
+	}
+	return "TEXT"
+}
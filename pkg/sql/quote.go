@@ -0,0 +1,60 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	"strings"
+)
+
+var (
+	// copyReplacer escapes the characters that have a special meaning in the text format of COPY.
+	copyReplacer = strings.NewReplacer(
+		"\\", "\\\\",
+		"\b", "\\b",
+		"\f", "\\f",
+		"\n", "\\n",
+		"\r", "\\r",
+		"\t", "\\t",
+		"\v", "\\v",
+	)
+
+	// mysqlReplacer escapes the characters that have a special meaning in MySQL string literals.
+	mysqlReplacer = strings.NewReplacer(
+		"\\", "\\\\",
+		"'", "''",
+		"\x00", "\\0",
+	)
+)
+
+// quoteIdentifier returns the identifier quoted for the dialect.
+// MySQL uses backticks, while PostgreSQL and SQLite use double quotes.
+func quoteIdentifier(dialect string, identifier string) string {
+	if dialect == DialectMySQL {
+		return "`" + strings.Replace(identifier, "`", "``", -1) + "`"
+	}
+	return "\"" + strings.Replace(identifier, "\"", "\"\"", -1) + "\""
+}
+
+// quoteTable returns the name of the table quoted for the dialect.
+// A name qualified by a schema, e.g., "public.places", is quoted part by part.
+func quoteTable(dialect string, table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = quoteIdentifier(dialect, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteString returns the string as a string literal for the dialect.
+// MySQL also treats backslashes as escape characters.
+func quoteString(dialect string, str string) string {
+	if dialect == DialectMySQL {
+		return "'" + mysqlReplacer.Replace(str) + "'"
+	}
+	return "'" + strings.Replace(str, "'", "''", -1) + "'"
+}
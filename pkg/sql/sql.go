@@ -0,0 +1,68 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package sql provides a simple API for writing objects as SQL statements for loading into a relational database.
+// sql also supports a writer for efficiently processing a stream.
+//
+// Each object is written as a row of a table, with the columns of the table created from the keys of the objects,
+// as with separated values.  Objects are written in batches, with one INSERT statement per batch.
+// For PostgreSQL, the rows can also be written as a single COPY statement with the rows in the text format.
+// Identifiers are quoted for the dialect, so column names with spaces or reserved words are kept as is.
+// Nil values and values that serialize as the no-data value are written as NULL.
+//
+// The header and the types of the columns are inferred from the first batch of objects.
+// If enabled, a CREATE TABLE statement with the inferred column types is written before the first row.
+//
+// References:
+//	- https://www.postgresql.org/docs/current/sql-insert.html
+//	- https://www.postgresql.org/docs/current/sql-copy.html
+//	- https://dev.mysql.com/doc/refman/8.0/en/insert.html
+//	- https://www.sqlite.org/lang_insert.html
+//
+package sql
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	DialectMySQL    = "mysql"    // MySQL and MariaDB
+	DialectPostgres = "postgres" // PostgreSQL
+	DialectSQLite   = "sqlite"   // SQLite
+
+	StatementCopy   = "copy"   // a COPY statement with the rows in the text format (PostgreSQL only)
+	StatementInsert = "insert" // INSERT statements with a batch of rows each
+
+	DefaultBatchSize = 100
+	DefaultDialect   = DialectPostgres
+	DefaultStatement = StatementInsert
+)
+
+var (
+	Dialects = []string{
+		DialectMySQL,
+		DialectPostgres,
+		DialectSQLite,
+	}
+	Statements = []string{
+		StatementCopy,
+		StatementInsert,
+	}
+)
+
+var (
+	ErrMissingTable = errors.New("missing table")
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package sql
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// the classes of formatted values
+const (
+	classNull = iota
+	classBoolean
+	classNumber
+	classString
+)
+
+// field returns the value of the column of the map or struct, or an invalid value if missing.
+// Struct fields are matched without regard to case, as with separated values.
+func field(objectValue reflect.Value, column interface{}) reflect.Value {
+	switch objectValue.Kind() {
+	case reflect.Map:
+		return objectValue.MapIndex(reflect.ValueOf(column))
+	case reflect.Struct:
+		columnLowerCase := strings.ToLower(fmt.Sprint(column))
+		return objectValue.FieldByNameFunc(func(match string) bool { return strings.ToLower(match) == columnLowerCase })
+	}
+	return reflect.Value{}
+}
+
+// formatTime returns the time formatted for the dialect.
+// MySQL does not support time zones in datetime literals, so times are converted to UTC.
+func formatTime(dialect string, t time.Time) string {
+	if dialect == DialectMySQL {
+		return t.UTC().Format("2006-01-02 15:04:05.999999")
+	}
+	return t.Format("2006-01-02 15:04:05.999999999Z07:00")
+}
+
+// formatValue formats the value of a column of the given kind and returns the class of the value.
+// Nil values and values that serialize as the no-data value are of the null class.
+// Strings in boolean, integer, and float columns that match the kind of the column are not quoted.
+func formatValue(dialect string, kind string, value reflect.Value, valueSerializer stringify.Stringer, noDataValue string) (string, int, error) {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return "", classNull, nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return "", classNull, nil
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			return "true", classBoolean, nil
+		}
+		return "false", classBoolean, nil
+	case reflect.Map, reflect.Slice:
+		if value.IsNil() {
+			return "", classNull, nil
+		}
+	case reflect.Struct:
+		if t, ok := value.Interface().(time.Time); ok {
+			return formatTime(dialect, t), classString, nil
+		}
+	}
+
+	str, err := valueSerializer(value.Interface())
+	if err != nil {
+		return "", classNull, errors.Wrap(err, "error serializing value")
+	}
+	if str == noDataValue {
+		return "", classNull, nil
+	}
+
+	if _, ok := value.Interface().(stdjson.Number); ok && isNumeric(str) {
+		return str, classNumber, nil
+	}
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if isNumeric(str) {
+			return str, classNumber, nil
+		}
+	case reflect.String:
+		trimmed := strings.TrimSpace(str)
+		switch kind {
+		case "boolean":
+			if trimmed == "true" || trimmed == "false" {
+				return trimmed, classBoolean, nil
+			}
+		case "integer":
+			if isInteger(trimmed) {
+				return trimmed, classNumber, nil
+			}
+		case "float":
+			if isInteger(trimmed) || isFloat(trimmed) {
+				return trimmed, classNumber, nil
+			}
+		}
+	}
+	return str, classString, nil
+}
+
+// literal returns the formatted value as a literal for the dialect.
+// SQLite has no boolean type, so booleans are written as 1 or 0.
+func literal(dialect string, str string, class int) string {
+	switch class {
+	case classNull:
+		return "NULL"
+	case classBoolean:
+		if dialect == DialectSQLite {
+			if str == "true" {
+				return "1"
+			}
+			return "0"
+		}
+		return strings.ToUpper(str)
+	case classNumber:
+		return str
+	}
+	return quoteString(dialect, str)
+}
+
+// copyValue returns the formatted value as a value in the text format of COPY.
+func copyValue(str string, class int) string {
+	if class == classNull {
+		return "\\N"
+	}
+	return copyReplacer.Replace(str)
+}
@@ -13,6 +13,8 @@ import (
 	"reflect"
 
 	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // Iterator is used to iterate over a table of separated values.
@@ -34,6 +36,7 @@ type NewIteratorInput struct {
 	Comment    string
 	LazyQuotes bool
 	Limit      int
+	Limits     limits.Limits // the maximum size of each line and number of columns
 }
 
 // NewIterator returns a new iterator for iterating over a table of separated values.
@@ -43,7 +46,7 @@ func NewIterator(input *NewIteratorInput) (*Iterator, error) {
 		return nil, errors.New("input type must be of kind map")
 	}
 
-	reader := csv.NewReader(input.Reader)
+	reader := csv.NewReader(limits.NewRecordReader(input.Reader, '\n', input.Limits.MaxRecordBytes))
 	reader.Comma = input.Separator
 	reader.LazyQuotes = input.LazyQuotes
 	reader.FieldsPerRecord = -1 // records may have a variable number of fields
@@ -78,6 +81,10 @@ func NewIterator(input *NewIteratorInput) (*Iterator, error) {
 		}
 	}
 
+	if err := input.Limits.CheckKeys(len(header)); err != nil {
+		return nil, errors.Wrap(err, "error checking header")
+	}
+
 	return &Iterator{Reader: reader, Type: input.Type, header: header, limit: input.Limit, count: 0}, nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // ReadInput provides the input for the Read function.
@@ -26,6 +27,7 @@ type ReadInput struct {
 	Comment    string
 	LazyQuotes bool
 	Limit      int
+	Limits     limits.Limits // the maximum size of each line and number of columns
 }
 
 // Read reads the separated values from the input reader into a slice.
@@ -53,6 +55,7 @@ func Read(input *ReadInput) (interface{}, error) {
 		SkipLines:  input.SkipLines,
 		LazyQuotes: input.LazyQuotes,
 		Limit:      input.Limit,
+		Limits:     input.Limits,
 	})
 	if errorIterator != nil {
 		return nil, errors.Wrap(errorIterator, "error creating iterator")
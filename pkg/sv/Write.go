@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
 
@@ -64,13 +65,13 @@ func Write(input *WriteInput) error {
 		// set the key serializer
 		keySerializer := input.KeySerializer
 		if keySerializer == nil {
-			keySerializer = stringify.NewStringer("", false, false, false)
+			keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 		}
 
 		// set the value serializer
 		valueSerializer := input.ValueSerializer
 		if valueSerializer == nil {
-			valueSerializer = stringify.NewStringer("", false, false, false)
+			valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 		}
 
 		// initialize header, wildcard, and known keys
@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-simple-serializer/pkg/inspector"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
 
@@ -42,11 +43,11 @@ func NewWriter(underlying io.Writer, separator rune, columns []interface{}, keyS
 	csvWriter.Comma = separator
 
 	if keySerializer == nil {
-		keySerializer = stringify.NewStringer("", false, false, false)
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 	}
 
 	if valueSerializer == nil {
-		valueSerializer = stringify.NewStringer("", false, false, false)
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
 	}
 
 	return &Writer{
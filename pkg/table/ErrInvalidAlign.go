@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidAlign is used when the column alignment is not known.
+type ErrInvalidAlign struct {
+	Value string // the invalid alignment
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidAlign) Error() string {
+	return fmt.Sprintf("invalid column alignment %q, expecting one of %s", e.Value, strings.Join(Alignments, ", "))
+}
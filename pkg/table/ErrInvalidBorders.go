@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidBorders is used when the table borders are not known.
+type ErrInvalidBorders struct {
+	Value string // the invalid borders
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidBorders) Error() string {
+	return fmt.Sprintf("invalid table borders %q, expecting one of %s", e.Value, strings.Join(Borders, ", "))
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidFormat is used when the table format is not known.
+type ErrInvalidFormat struct {
+	Value string // the invalid format
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidFormat) Error() string {
+	return fmt.Sprintf("invalid table format %q, expecting one of %s", e.Value, strings.Join(Formats, ", "))
+}
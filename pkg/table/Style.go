@@ -0,0 +1,46 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+// Style describes how a table is rendered.
+// The zero value is a plain ASCII table with automatic alignment and unlimited column width.
+type Style struct {
+	Format   string // the table format: table, markdown, or html.  Defaults to table.
+	Borders  string // the borders of an ASCII table: box, plain, or none.  Defaults to plain.
+	Align    string // the column alignment: auto, left, or right.  Defaults to auto.
+	MaxWidth int    // the maximum width of a column in characters.  If less than 1, then unlimited.
+	Wrap     bool   // wrap long values onto multiple lines rather than truncating them.  Only used by the table format.
+}
+
+// Validate returns an error if the style has an unknown format, borders, or alignment.
+func (s Style) Validate() error {
+	if len(s.Format) > 0 && !stringSliceContains(Formats, s.Format) {
+		return &ErrInvalidFormat{Value: s.Format}
+	}
+	if len(s.Borders) > 0 && !stringSliceContains(Borders, s.Borders) {
+		return &ErrInvalidBorders{Value: s.Borders}
+	}
+	if len(s.Align) > 0 && !stringSliceContains(Alignments, s.Align) {
+		return &ErrInvalidAlign{Value: s.Align}
+	}
+	return nil
+}
+
+// withDefaults returns a copy of the style with the defaults filled in.
+func (s Style) withDefaults() Style {
+	if len(s.Format) == 0 {
+		s.Format = FormatTable
+	}
+	if len(s.Borders) == 0 {
+		s.Borders = BordersPlain
+	}
+	if len(s.Align) == 0 {
+		s.Align = AlignAuto
+	}
+	return s
+}
@@ -0,0 +1,187 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer          io.Writer // the underlying writer
+	Header          []interface{}
+	ExpandHeader    bool // expand the given header with unknown keys
+	KeySerializer   stringify.Stringer
+	ValueSerializer stringify.Stringer
+	Object          interface{} // the object to write
+	Sorted          bool        // sort columns
+	Reversed        bool        // if sorted, sort in reverse alphabetical order.
+	Limit           int         // the maximum number of rows to write, if less than zero, then unlimited.
+	Style           Style       // the style of the table
+}
+
+// Write writes the given object(s) as a table.
+// If the type of the input object is of kind Array or Slice, then writes each object as its own row.
+// Otherwise, just writes a table with a header and one row for the object.
+// Write caches all the rows in memory, so each column is as wide as its widest value, up to the maximum width.
+// If the header is empty or includes a wildcard, then the header is created from the keys of all the objects.
+func Write(input *WriteInput) error {
+
+	if err := input.Style.Validate(); err != nil {
+		return errors.Wrap(err, "invalid style")
+	}
+	style := input.Style.withDefaults()
+
+	// set the key serializer
+	keySerializer := input.KeySerializer
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	// set the value serializer
+	valueSerializer := input.ValueSerializer
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	inputObjectValue := reflect.ValueOf(input.Object)
+	for reflect.TypeOf(inputObjectValue.Interface()).Kind() == reflect.Ptr {
+		inputObjectValue = inputObjectValue.Elem()
+	}
+	inputObjectValue = reflect.ValueOf(inputObjectValue.Interface()) // sets value to concerete type
+
+	objects := make([]reflect.Value, 0)
+	switch inputObjectValue.Type().Kind() {
+	case reflect.Map, reflect.Struct:
+		objects = append(objects, inputObjectValue)
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < inputObjectValue.Len() && (input.Limit < 0 || i < input.Limit); i++ {
+			objects = append(objects, reflect.ValueOf(inputObjectValue.Index(i).Interface()))
+		}
+	}
+
+	if len(objects) == 0 {
+		// If there are no records then just write nothing
+		return nil
+	}
+
+	// initialize header, wildcard, and known keys
+	header := input.Header
+	wildcard := false
+	knownKeys := map[interface{}]struct{}{}
+	for _, k := range header {
+		if str, ok := k.(string); ok && str == sv.Wildcard {
+			wildcard = true
+		} else {
+			knownKeys[k] = struct{}{}
+		}
+	}
+
+	switch {
+	case len(header) == 0:
+		header, knownKeys = sv.CreateHeaderAndKnownKeysFromValue(objects[0], input.Sorted, input.Reversed)
+		for _, object := range objects[1:] {
+			header, knownKeys = sv.ExpandHeader(header, knownKeys, object, input.Sorted, input.Reversed)
+		}
+	case wildcard:
+		for _, object := range objects {
+			header, knownKeys = sv.ExpandHeaderWithWildcard(header, knownKeys, object, input.Sorted, input.Reversed)
+		}
+		header = sv.RemoveWildcard(header)
+	case input.ExpandHeader:
+		for _, object := range objects {
+			header, knownKeys = sv.ExpandHeader(header, knownKeys, object, input.Sorted, input.Reversed)
+		}
+	}
+
+	if len(header) == 0 {
+		return nil
+	}
+
+	outputHeader, err := stringify.StringifySlice(header, keySerializer)
+	if err != nil {
+		return errors.Wrapf(err, "error stringifying header %q", header)
+	}
+
+	rows := make([][]string, 0, len(objects))
+	for _, object := range objects {
+		row, err := sv.ToRowFromValue(object, header, valueSerializer)
+		if err != nil {
+			return errors.Wrap(err, "error serializing object to row")
+		}
+		rows = append(rows, row)
+	}
+
+	// the string used for missing values, which is ignored when aligning columns.
+	noDataValue, err := valueSerializer(nil)
+	if err != nil {
+		return errors.Wrap(err, "error serializing missing value")
+	}
+
+	limits := make([]int, len(header))
+	widths := make([]int, len(header))
+	aligns := make([]string, len(header))
+	r := newRenderer(input.Writer, style, limits, widths, aligns)
+	for j := range header {
+		limits[j] = style.MaxWidth
+		if w := width(r.cell(outputHeader[j], limits[j])); w > widths[j] {
+			widths[j] = w
+		}
+		for _, row := range rows {
+			if w := width(r.cell(row[j], limits[j])); w > widths[j] {
+				widths[j] = w
+			}
+		}
+		aligns[j] = style.Align
+		if style.Align == AlignAuto {
+			if isNumericColumn(rows, j, noDataValue) {
+				aligns[j] = AlignRight
+			} else {
+				aligns[j] = AlignLeft
+			}
+		}
+	}
+
+	err = r.writeHeader(outputHeader)
+	if err != nil {
+		return errors.Wrap(err, "error writing header")
+	}
+	for _, row := range rows {
+		err = r.writeRow(row)
+		if err != nil {
+			return errors.Wrap(err, "error writing row")
+		}
+	}
+	err = r.writeFooter()
+	if err != nil {
+		return errors.Wrap(err, "error writing footer")
+	}
+	return nil
+}
+
+// isNumericColumn returns true if the column has at least one value and all the values are numeric.
+// Empty values and missing values are ignored.
+func isNumericColumn(rows [][]string, column int, noDataValue string) bool {
+	numeric := false
+	for _, row := range rows {
+		if value := row[column]; len(value) > 0 && value != noDataValue {
+			if !isNumeric(value) {
+				return false
+			}
+			numeric = true
+		}
+	}
+	return numeric
+}
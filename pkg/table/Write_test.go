@@ -0,0 +1,156 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testObjects = []interface{}{
+	map[string]interface{}{"id": 1, "name": "alice", "note": "likes | pipes"},
+	map[string]interface{}{"id": 20, "name": "bob"},
+	map[string]interface{}{"id": 300, "name": "carol", "note": "a very long note about nothing"},
+}
+
+func TestWritePlain(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: testObjects,
+		Sorted: true,
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	expected := `+-----+-------+--------------------------------+
+|  id | name  | note                           |
++-----+-------+--------------------------------+
+|   1 | alice | likes | pipes                  |
+|  20 | bob   |                                |
+| 300 | carol | a very long note about nothing |
++-----+-------+--------------------------------+
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteBoxMaxWidth(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: testObjects,
+		Sorted: true,
+		Limit:  -1,
+		Style:  Style{Borders: BordersBox, MaxWidth: 10},
+	})
+	assert.NoError(t, err)
+	expected := `┌─────┬───────┬────────────┐
+│  id │ name  │ note       │
+├─────┼───────┼────────────┤
+│   1 │ alice │ likes | p… │
+│  20 │ bob   │            │
+│ 300 │ carol │ a very lo… │
+└─────┴───────┴────────────┘
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteWrap(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Header: []interface{}{"name", "note"},
+		Object: testObjects,
+		Limit:  2,
+		Style:  Style{Borders: BordersNone, MaxWidth: 6, Wrap: true},
+	})
+	assert.NoError(t, err)
+	expected := "name   note\nalice  likes\n       |\n       pipes\nbob\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteWildcard(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Header: []interface{}{"*", "id"},
+		Object: testObjects,
+		Sorted: true,
+		Limit:  -1,
+		Style:  Style{Borders: BordersNone, Align: AlignLeft, MaxWidth: 5},
+	})
+	assert.NoError(t, err)
+	expected := "name   note   id\nalice  li...  1\nbob           20\ncarol  a ...  300\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Header: []interface{}{"id", "note"},
+		Object: []interface{}{
+			map[string]interface{}{"id": 1, "note": "likes | pipes"},
+			map[string]interface{}{"id": 2, "note": "line\nbreak"},
+		},
+		Limit: -1,
+		Style: Style{Format: FormatMarkdown},
+	})
+	assert.NoError(t, err)
+	expected := `|  id | note           |
+| --: | -------------- |
+|   1 | likes \| pipes |
+|   2 | line<br>break  |
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteHTML(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: map[string]interface{}{"id": 1, "name": "<alice>"},
+		Sorted: true,
+		Limit:  -1,
+		Style:  Style{Format: FormatHTML},
+	})
+	assert.NoError(t, err)
+	expected := `<table>
+  <thead>
+    <tr><th style="text-align: right">id</th><th>name</th></tr>
+  </thead>
+  <tbody>
+    <tr><td style="text-align: right">1</td><td>&lt;alice&gt;</td></tr>
+  </tbody>
+</table>
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: []interface{}{},
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", buf.String())
+}
+
+func TestWriteInvalidStyle(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Object: testObjects,
+		Limit:  -1,
+		Style:  Style{Borders: "double"},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,186 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/inspector"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// Writer formats and writes objects to the underlying writer as rows of a table.
+type Writer struct {
+	writer          io.Writer     // writer for the underlying stream
+	columns         []interface{} // the columns of the table
+	keySerializer   stringify.Stringer
+	valueSerializer stringify.Stringer
+	sorted          bool      // if inferring columns, sort the columns by alphabetical order
+	reversed        bool      // if sorted, sort columns in reverse alphabetical order
+	style           Style     // the style of the table
+	renderer        *renderer // the renderer for the current table, nil until the header is written.
+}
+
+// NewWriter returns a new Writer for writing objects to an underlying writer as rows of a table.
+// NewWriter is a streaming writer, so cannot dynamically expand the header or fit the columns to their values.
+// If no columns are given, then the columns are inferred from the first object.
+// Each column is as wide as the maximum width, if set, or otherwise its column header.
+// If the maximum width is set, then longer values are truncated or wrapped to fit.
+// Otherwise, longer values are written in full, and extend past the column.
+// To fit the columns to their values, then use the Write function.
+func NewWriter(w io.Writer, columns []interface{}, keySerializer stringify.Stringer, valueSerializer stringify.Stringer, sorted bool, reversed bool, style Style) *Writer {
+
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	return &Writer{
+		writer:          w,
+		columns:         columns,
+		keySerializer:   keySerializer,
+		valueSerializer: valueSerializer,
+		sorted:          sorted,
+		reversed:        reversed,
+		style:           style,
+		renderer:        nil,
+	}
+}
+
+// WriteHeader writes the beginning of a new table, including the header.
+func (w *Writer) WriteHeader() error {
+	if err := w.style.Validate(); err != nil {
+		return errors.Wrap(err, "invalid style")
+	}
+	style := w.style.withDefaults()
+
+	// Stringify columns into strings
+	h, err := stringify.StringifySlice(w.columns, w.keySerializer)
+	if err != nil {
+		return errors.Wrap(err, "error stringifying columns")
+	}
+
+	limits := make([]int, len(h))
+	widths := make([]int, len(h))
+	aligns := make([]string, len(h))
+	r := newRenderer(w.writer, style, limits, widths, aligns)
+	for j := range h {
+		limits[j] = style.MaxWidth
+		if style.MaxWidth > 0 && style.Format == FormatTable {
+			widths[j] = style.MaxWidth
+		} else if hw := width(r.cell(h[j], limits[j])); hw > widths[j] {
+			widths[j] = hw
+		}
+		aligns[j] = style.Align
+	}
+
+	err = r.writeHeader(h)
+	if err != nil {
+		return errors.Wrap(err, "error writing header")
+	}
+	w.renderer = r
+	return nil
+}
+
+// WriteObject formats and writes a single object to the underlying writer as a row of the table.
+// If this is the first object since the writer was created or flushed, then writes the header first.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.renderer == nil {
+		if len(w.columns) == 0 {
+			inputObjectValue := reflect.ValueOf(obj)
+			for reflect.TypeOf(inputObjectValue.Interface()).Kind() == reflect.Ptr {
+				inputObjectValue = inputObjectValue.Elem()
+			}
+			inputObjectValue = reflect.ValueOf(inputObjectValue.Interface()) // sets value to concerete type
+			inputObjectKind := inputObjectValue.Type().Kind()
+			if inputObjectKind == reflect.Map {
+				w.columns = inspector.GetKeysFromValue(inputObjectValue, w.sorted, w.reversed)
+			} else if inputObjectKind == reflect.Struct {
+				fieldNames := make([]interface{}, 0)
+				for _, fieldName := range inspector.GetFieldNamesFromValue(inputObjectValue, w.sorted, w.reversed) {
+					fieldNames = append(fieldNames, fieldName)
+				}
+				w.columns = fieldNames
+			}
+		}
+		if len(w.columns) == 0 {
+			return errors.New(fmt.Sprintf("could not infer the header from the given value with type %T", obj))
+		}
+		err := w.WriteHeader()
+		if err != nil {
+			return errors.Wrap(err, "error writing header")
+		}
+	}
+	row, err := sv.ToRow(obj, w.columns, w.valueSerializer)
+	if err != nil {
+		return errors.Wrap(err, "error serializing object as row")
+	}
+	err = w.renderer.writeRow(row)
+	if err != nil {
+		return errors.Wrap(err, "error writing object")
+	}
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer as rows of the table.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the end of the current table, if any, and flushes the underlying writer, if it has a Flush method.
+// If more objects are written after flushing, then they are written as a new table.
+func (w *Writer) Flush() error {
+	if w.renderer != nil {
+		err := w.renderer.writeFooter()
+		if err != nil {
+			return errors.Wrap(err, "error writing footer")
+		}
+		w.renderer = nil
+	}
+	if flusher, ok := w.writer.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.writer.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
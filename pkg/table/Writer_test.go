@@ -0,0 +1,105 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	w := NewWriter(buf, []interface{}{"id", "name"}, nil, nil, false, false, Style{MaxWidth: 5})
+	assert.NotNil(t, w)
+
+	err := w.WriteObjects(testObjects)
+	assert.NoError(t, err)
+
+	err = w.Flush()
+	assert.NoError(t, err)
+
+	expected := `+-------+-------+
+| id    | name  |
++-------+-------+
+|     1 | alice |
+|    20 | bob   |
+|   300 | carol |
++-------+-------+
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriterHeaderWidth(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	w := NewWriter(buf, []interface{}{"name"}, nil, nil, false, false, Style{Borders: BordersBox, MaxWidth: 4, Wrap: true})
+	assert.NotNil(t, w)
+
+	err := w.WriteObject(map[string]interface{}{"name": "alice"})
+	assert.NoError(t, err)
+
+	err = w.Flush()
+	assert.NoError(t, err)
+
+	expected := `┌──────┐
+│ name │
+├──────┤
+│ alic │
+│ e    │
+└──────┘
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriterUnlimited(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	w := NewWriter(buf, []interface{}{"a", "n"}, nil, nil, false, false, Style{})
+	assert.NotNil(t, w)
+
+	err := w.WriteObject(map[string]interface{}{"a": "the quick brown fox", "n": -1.25})
+	assert.NoError(t, err)
+
+	err = w.Flush()
+	assert.NoError(t, err)
+
+	expected := `+---+---+
+| a | n |
++---+---+
+| the quick brown fox | -1.25 |
++---+---+
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriterHTML(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	w := NewWriter(buf, nil, nil, nil, true, false, Style{Format: FormatHTML})
+	assert.NotNil(t, w)
+
+	err := w.WriteObject(map[string]interface{}{"name": "bob", "id": 20})
+	assert.NoError(t, err)
+
+	err = w.Flush()
+	assert.NoError(t, err)
+
+	expected := `<table>
+  <thead>
+    <tr><th>id</th><th>name</th></tr>
+  </thead>
+  <tbody>
+    <tr><td style="text-align: right">20</td><td>bob</td></tr>
+  </tbody>
+</table>
+`
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,107 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// isNumeric returns true if the value is an integer or floating point number.
+func isNumeric(value string) bool {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return false
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+// width returns the number of characters in the widest of the given lines.
+func width(lines []string) int {
+	max := 0
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// pad pads the line with spaces to the given width.
+func pad(line string, width int, right bool) string {
+	n := utf8.RuneCountInString(line)
+	if n >= width {
+		return line
+	}
+	if right {
+		return strings.Repeat(" ", width-n) + line
+	}
+	return line + strings.Repeat(" ", width-n)
+}
+
+// truncate shortens the line to the given width, ending with the ellipsis.
+func truncate(line string, width int, ellipsis string) string {
+	runes := []rune(line)
+	if len(runes) <= width {
+		return line
+	}
+	e := []rune(ellipsis)
+	if width <= len(e) {
+		return string(runes[:width])
+	}
+	return string(runes[:width-len(e)]) + ellipsis
+}
+
+// wrap breaks the line into lines no wider than the given width.
+// Lines are broken at spaces, and words longer than the width are broken wherever they need to be.
+func wrap(line string, width int) []string {
+	lines := make([]string, 0)
+	current := make([]rune, 0, width)
+	for _, word := range strings.Fields(line) {
+		runes := []rune(word)
+		if len(current) > 0 && len(current)+1+len(runes) > width {
+			lines = append(lines, string(current))
+			current = current[:0]
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		for len(current)+len(runes) > width {
+			n := width - len(current)
+			current = append(current, runes[:n]...)
+			lines = append(lines, string(current))
+			current = current[:0]
+			runes = runes[n:]
+		}
+		current = append(current, runes...)
+	}
+	if len(current) > 0 || len(lines) == 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
+
+// split splits the value into lines, and truncates or wraps each line to the given width.
+// If the width is less than 1, then the lines are not truncated or wrapped.
+func split(value string, width int, wrapLines bool, ellipsis string) []string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(strings.Replace(value, "\r\n", "\n", -1), "\n") {
+		line = strings.Replace(line, "\t", " ", -1)
+		switch {
+		case width < 1 || utf8.RuneCountInString(line) <= width:
+			lines = append(lines, line)
+		case wrapLines:
+			lines = append(lines, wrap(line, width)...)
+		default:
+			lines = append(lines, truncate(line, width, ellipsis))
+		}
+	}
+	return lines
+}
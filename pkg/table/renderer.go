@@ -0,0 +1,227 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package table
+
+import (
+	"html"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// borders is the set of characters used to draw the borders of an ASCII table.
+type borders struct {
+	horizontal string
+	vertical   string
+	top        [3]string // left, middle, and right corners of the top rule
+	middle     [3]string // left, middle, and right corners of the rule below the header
+	bottom     [3]string // left, middle, and right corners of the bottom rule
+	ellipsis   string
+}
+
+var (
+	bordersBox = borders{
+		horizontal: "─",
+		vertical:   "│",
+		top:        [3]string{"┌", "┬", "┐"},
+		middle:     [3]string{"├", "┼", "┤"},
+		bottom:     [3]string{"└", "┴", "┘"},
+		ellipsis:   "…",
+	}
+	bordersPlain = borders{
+		horizontal: "-",
+		vertical:   "|",
+		top:        [3]string{"+", "+", "+"},
+		middle:     [3]string{"+", "+", "+"},
+		bottom:     [3]string{"+", "+", "+"},
+		ellipsis:   "...",
+	}
+)
+
+// renderer writes the parts of a table to the underlying writer.
+type renderer struct {
+	writer io.Writer
+	style  Style
+	limits []int    // the maximum width of the values in each column, if less than 1, then unlimited.
+	widths []int    // the width each column is padded to.
+	aligns []string // the alignment of each column, if auto, then decided for each cell.
+}
+
+func newRenderer(w io.Writer, style Style, limits []int, widths []int, aligns []string) *renderer {
+	if style.Format == FormatMarkdown {
+		// the delimiter row requires at least 3 characters per column.
+		for j := range widths {
+			if widths[j] < 3 {
+				widths[j] = 3
+			}
+		}
+	}
+	return &renderer{
+		writer: w,
+		style:  style,
+		limits: limits,
+		widths: widths,
+		aligns: aligns,
+	}
+}
+
+func (r *renderer) borders() borders {
+	if r.style.Borders == BordersBox {
+		return bordersBox
+	}
+	return bordersPlain
+}
+
+// cell returns the lines of a cell as displayed in the table.
+// The markdown and html formats return a single line, with line breaks encoded as <br>.
+func (r *renderer) cell(value string, width int) []string {
+	switch r.style.Format {
+	case FormatMarkdown:
+		lines := split(value, width, false, "...")
+		for i, line := range lines {
+			lines[i] = strings.Replace(line, "|", "\\|", -1)
+		}
+		return []string{strings.Join(lines, "<br>")}
+	case FormatHTML:
+		lines := split(value, width, false, "...")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+		return []string{strings.Join(lines, "<br>")}
+	}
+	return split(value, width, r.style.Wrap, r.borders().ellipsis)
+}
+
+// right returns true if the value in the given column should be right-aligned.
+func (r *renderer) right(column int, value string, header bool) bool {
+	switch r.aligns[column] {
+	case AlignRight:
+		return true
+	case AlignAuto:
+		return (!header) && isNumeric(value)
+	}
+	return false
+}
+
+func (r *renderer) write(s string) error {
+	_, err := io.WriteString(r.writer, s)
+	if err != nil {
+		return errors.Wrap(err, "error writing to underlying writer")
+	}
+	return nil
+}
+
+// rule returns a horizontal rule using the given corners.
+func (r *renderer) rule(corners [3]string) string {
+	b := r.borders()
+	parts := make([]string, 0, len(r.widths))
+	for _, w := range r.widths {
+		parts = append(parts, strings.Repeat(b.horizontal, w+2))
+	}
+	return corners[0] + strings.Join(parts, corners[1]) + corners[2] + "\n"
+}
+
+// row returns the given row formatted as lines of text.
+func (r *renderer) row(row []string, header bool) string {
+	cells := make([][]string, len(row))
+	height := 1
+	for j, value := range row {
+		cells[j] = r.cell(value, r.limits[j])
+		if len(cells[j]) > height {
+			height = len(cells[j])
+		}
+	}
+
+	switch r.style.Format {
+	case FormatHTML:
+		tag := "td"
+		if header {
+			tag = "th"
+		}
+		var sb strings.Builder
+		sb.WriteString("    <tr>")
+		for j, c := range cells {
+			if r.right(j, row[j], header) {
+				sb.WriteString("<" + tag + " style=\"text-align: right\">")
+			} else {
+				sb.WriteString("<" + tag + ">")
+			}
+			sb.WriteString(c[0] + "</" + tag + ">")
+		}
+		sb.WriteString("</tr>\n")
+		return sb.String()
+	case FormatMarkdown:
+		parts := make([]string, 0, len(cells))
+		for j, c := range cells {
+			parts = append(parts, pad(c[0], r.widths[j], r.right(j, row[j], header)))
+		}
+		return "| " + strings.Join(parts, " | ") + " |\n"
+	}
+
+	var sb strings.Builder
+	for i := 0; i < height; i++ {
+		parts := make([]string, 0, len(cells))
+		for j, c := range cells {
+			line := ""
+			if i < len(c) {
+				line = c[i]
+			}
+			parts = append(parts, pad(line, r.widths[j], r.right(j, row[j], header)))
+		}
+		if r.style.Borders == BordersNone {
+			sb.WriteString(strings.TrimRight(strings.Join(parts, "  "), " ") + "\n")
+		} else {
+			v := r.borders().vertical
+			sb.WriteString(v + " " + strings.Join(parts, " "+v+" ") + " " + v + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// writeHeader writes the beginning of the table, including the header.
+func (r *renderer) writeHeader(header []string) error {
+	switch r.style.Format {
+	case FormatHTML:
+		return r.write("<table>\n  <thead>\n" + r.row(header, true) + "  </thead>\n  <tbody>\n")
+	case FormatMarkdown:
+		parts := make([]string, 0, len(r.widths))
+		for j, w := range r.widths {
+			if r.aligns[j] == AlignRight {
+				parts = append(parts, strings.Repeat("-", w-1)+":")
+			} else {
+				parts = append(parts, strings.Repeat("-", w))
+			}
+		}
+		return r.write(r.row(header, true) + "| " + strings.Join(parts, " | ") + " |\n")
+	}
+	if r.style.Borders == BordersNone {
+		return r.write(r.row(header, true))
+	}
+	b := r.borders()
+	return r.write(r.rule(b.top) + r.row(header, true) + r.rule(b.middle))
+}
+
+// writeRow writes a row of the table.
+func (r *renderer) writeRow(row []string) error {
+	return r.write(r.row(row, false))
+}
+
+// writeFooter writes the end of the table.
+func (r *renderer) writeFooter() error {
+	switch r.style.Format {
+	case FormatHTML:
+		return r.write("  </tbody>\n</table>\n")
+	case FormatMarkdown:
+		return nil
+	}
+	if r.style.Borders == BordersNone {
+		return nil
+	}
+	return r.write(r.rule(r.borders().bottom))
+}
@@ -0,0 +1,48 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package table provides functions for writing objects as human-readable tables, including ASCII tables, Markdown tables, and HTML tables.
+//
+// The Write function caches all the rows in memory, so it can dynamically expand the header and size each column to fit its values.
+// The Writer is a streaming writer, so the columns must be given up front or are inferred from the first object.
+// Since the Writer cannot look ahead, each column is as wide as the maximum width, if set, or otherwise the column header.
+//
+// By default, columns that only contain numbers are right-aligned.
+// Values longer than the maximum width are truncated or, if wrapping is enabled, wrapped onto multiple lines.
+//
+//  - https://github.github.com/gfm/#tables-extension-
+//  - https://html.spec.whatwg.org/multipage/tables.html
+package table
+
+const (
+	FormatTable    = "table"    // ASCII table
+	FormatMarkdown = "markdown" // GitHub Flavored Markdown table
+	FormatHTML     = "html"     // HTML table
+
+	BordersBox   = "box"   // borders drawn with box-drawing characters
+	BordersPlain = "plain" // borders drawn with +, -, and | characters
+	BordersNone  = "none"  // no borders, columns are separated by spaces
+
+	AlignAuto  = "auto"  // right-align numeric values and left-align everything else
+	AlignLeft  = "left"  // left-align all values
+	AlignRight = "right" // right-align all values
+)
+
+var (
+	Formats    = []string{FormatHTML, FormatMarkdown, FormatTable}
+	Borders    = []string{BordersBox, BordersNone, BordersPlain}
+	Alignments = []string{AlignAuto, AlignLeft, AlignRight}
+)
+
+func stringSliceContains(slc []string, str string) bool {
+	for _, x := range slc {
+		if x == str {
+			return true
+		}
+	}
+	return false
+}
@@ -10,12 +10,15 @@ package tags
 import (
 	"io"
 	"reflect"
+	"regexp"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/pkg/errors"
 
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/scanner"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/splitter"
 )
 
 // Iterator iterates trough a stream of bytes
@@ -24,26 +27,32 @@ import (
 type Iterator struct {
 	Scanner           scanner.Scanner // the scanner that splits the underlying stream of bytes
 	Type              reflect.Type
-	KeyValueSeparator rune   // the key value separator
-	Comment           string // The comment line prefix.  Can be any string.
-	SkipBlanks        bool   // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
-	SkipComments      bool   // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
-	Limit             int    // Limit the number of objects to read and return from the underlying stream.
-	Count             int    // The current count of the number of objects read.
+	KeyValueSeparator rune          // the key value separator
+	Comment           string        // The comment line prefix.  Can be any string.
+	SkipBlanks        bool          // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments      bool          // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Limit             int           // Limit the number of objects to read and return from the underlying stream.
+	Count             int           // The current count of the number of objects read.
+	Line              int           // The current line number.
+	Strict            bool          // Reject lines with duplicate keys or invalid UTF-8.
+	Limits            limits.Limits // The resource limits for each line.
 }
 
 // NewIteratorInput provides the input parameters for the NewIterator function.
 type NewIteratorInput struct {
-	Reader            io.Reader
-	Type              reflect.Type
-	SkipLines         int    // Skip a given number of lines at the beginning of the stream.
-	SkipBlanks        bool   // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
-	SkipComments      bool   // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
-	Comment           string // The comment line prefix. Can be any string.
-	Limit             int    // Limit the number of objects to read and return from the underlying stream.
-	KeyValueSeparator string // the key value separator
-	LineSeparator     byte   // The new line byte.
-	DropCR            bool   // Drop carriage returns at the end of lines.
+	Reader              io.Reader
+	Type                reflect.Type
+	SkipLines           int            // Skip a given number of lines at the beginning of the stream.
+	SkipBlanks          bool           // Skip blank lines.  If false, Next() returns a blank line as (nil, nil).  If true, Next() simply skips forward until it finds a non-blank line.
+	SkipComments        bool           // Skip commented lines.  If false, Next() returns a commented line as (nil, nil).  If true, Next() simply skips forward until it finds a non-commented line.
+	Comment             string         // The comment line prefix. Can be any string.
+	Limit               int            // Limit the number of objects to read and return from the underlying stream.
+	KeyValueSeparator   string         // the key value separator
+	LineSeparator       string         // The line separator, which can be any sequence of bytes, e.g., "\n" or "\r\n".
+	LineSeparatorRegexp *regexp.Regexp // If not nil, split lines on matches of the regular expression rather than the line separator.
+	DropCR              bool           // Drop carriage returns at the end of lines.
+	Strict              bool           // Reject lines with duplicate keys or invalid UTF-8.
+	Limits              limits.Limits  // The resource limits for each line.  MaxTotalBytes and MaxAliases are not used.
 }
 
 // NewIterator returns a new JSON Lines (aka jsonl) Iterator base on the given input.
@@ -57,11 +66,17 @@ func NewIterator(input *NewIteratorInput) (*Iterator, error) {
 		return nil, errors.Wrap(ErrInvalidUTF8, "error decoding key-value separator")
 	}
 
-	s := scanner.New(input.Reader, input.LineSeparator, input.DropCR)
+	split := splitter.ScanSeparator([]byte(input.LineSeparator), input.DropCR)
+	if input.LineSeparatorRegexp != nil {
+		split = splitter.ScanRegexp(input.LineSeparatorRegexp, input.DropCR)
+	}
+	s := scanner.NewWithSplitFunc(input.Reader, split, input.Limits.MaxRecordBytes)
+	line := 0
 	for i := 0; i < input.SkipLines; i++ {
 		if !s.Scan() {
 			break
 		}
+		line++
 	}
 
 	it := &Iterator{
@@ -73,6 +88,9 @@ func NewIterator(input *NewIteratorInput) (*Iterator, error) {
 		SkipComments:      input.SkipComments,
 		Limit:             input.Limit,
 		Count:             0,
+		Line:              line,
+		Strict:            input.Strict,
+		Limits:            input.Limits,
 	}
 
 	return it, nil
@@ -93,6 +111,7 @@ func (it *Iterator) Next() (interface{}, error) {
 	it.Count++
 
 	if it.Scanner.Scan() {
+		it.Line++
 		line := strings.TrimSpace(it.Scanner.Text())
 		if len(line) == 0 {
 			if it.SkipBlanks {
@@ -106,18 +125,33 @@ func (it *Iterator) Next() (interface{}, error) {
 			}
 			return nil, nil
 		}
+		if it.Strict {
+			err := Validate([]byte(line), it.KeyValueSeparator)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error validating tags on line %d", it.Line)
+			}
+		}
 		if it.Type != nil {
 			obj, err := UnmarshalType([]byte(line), it.KeyValueSeparator, it.Type)
 			if err != nil {
 				return obj, errors.Wrap(err, "error unmarshaling next tags object")
 			}
+			if err := it.Limits.Check(obj); err != nil {
+				return nil, errors.Wrapf(err, "error checking limits of tags on line %d", it.Line)
+			}
 			return obj, nil
 		}
 		obj, err := Unmarshal([]byte(line), it.KeyValueSeparator)
 		if err != nil {
 			return obj, errors.Wrap(err, "error unmarshaling next tags object")
 		}
+		if err := it.Limits.Check(obj); err != nil {
+			return nil, errors.Wrapf(err, "error checking limits of tags on line %d", it.Line)
+		}
 		return obj, nil
 	}
+	if err := it.Scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error scanning line %d", it.Line+1)
+	}
 	return nil, io.EOF
 }
@@ -10,9 +10,11 @@ package tags
 import (
 	"io"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +33,7 @@ func TestIterator(t *testing.T) {
 		SkipBlanks:        false,
 		SkipComments:      false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 	})
 	require.NoError(t, err)
@@ -88,7 +90,7 @@ func TestIteratorType(t *testing.T) {
 		SkipBlanks:        false,
 		SkipComments:      false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 	})
 	require.NoError(t, err)
@@ -144,7 +146,7 @@ func TestIteratorComment(t *testing.T) {
 		Comment:           "#",
 		SkipBlanks:        false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 	})
 	require.NoError(t, err)
@@ -188,7 +190,7 @@ func TestIteratorEmpty(t *testing.T) {
 		Comment:           "#",
 		SkipBlanks:        false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 	})
 	require.NoError(t, err)
@@ -207,7 +209,7 @@ func TestIteratorBlanks(t *testing.T) {
 		Comment:           "#",
 		SkipBlanks:        true,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 	})
 
@@ -219,3 +221,46 @@ func TestIteratorBlanks(t *testing.T) {
 	assert.Equal(t, io.EOF, err)
 	assert.Nil(t, obj)
 }
+
+func TestIteratorStrict(t *testing.T) {
+	text := "a=1 b=2\na=1 a=2\n"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:            strings.NewReader(text),
+		KeyValueSeparator: "=",
+		LineSeparator:     "\n",
+		Strict:            true,
+	})
+	assert.NoError(t, err)
+
+	obj, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, obj)
+
+	obj, err = it.Next()
+	assert.Error(t, err)
+	assert.Equal(t, &ErrDuplicateKey{Key: "a", Column: 5}, errors.Cause(err))
+	assert.Contains(t, err.Error(), "line 2")
+	assert.Nil(t, obj)
+}
+
+func TestIteratorLineSeparatorRegexp(t *testing.T) {
+	text := "a=1\r\n\r\nb=2\nc=3"
+
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:              strings.NewReader(text),
+		KeyValueSeparator:   "=",
+		LineSeparatorRegexp: regexp.MustCompile("(\r?\n)+"),
+	})
+	assert.NoError(t, err)
+
+	for _, expected := range []map[string]string{{"a": "1"}, {"b": "2"}, {"c": "3"}} {
+		obj, err := it.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, obj)
+	}
+
+	obj, err := it.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, obj)
+}
@@ -10,25 +10,30 @@ package tags
 import (
 	"io"
 	"reflect"
+	"regexp"
 
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
 )
 
 // ReadInput provides the input for the Read function.
 type ReadInput struct {
-	Type              reflect.Type  // the output type
-	Reader            io.Reader     // the underlying reader
-	Keys              []interface{} // the keys to read
-	SkipLines         int
-	SkipBlanks        bool
-	SkipComments      bool
-	Comment           string // the comment prefix
-	KeyValueSeparator string // the key-value separator
-	LineSeparator     byte   // the line separator
-	DropCR            bool   // drop carriage return
-	Limit             int
+	Type                reflect.Type  // the output type
+	Reader              io.Reader     // the underlying reader
+	Keys                []interface{} // the keys to read
+	SkipLines           int
+	SkipBlanks          bool
+	SkipComments        bool
+	Comment             string         // the comment prefix
+	KeyValueSeparator   string         // the key-value separator
+	LineSeparator       string         // the line separator, which can be any sequence of bytes
+	LineSeparatorRegexp *regexp.Regexp // if not nil, split lines on matches of the regular expression
+	DropCR              bool           // drop carriage return
+	Limit               int
+	Strict              bool          // reject lines with duplicate keys or invalid UTF-8
+	Limits              limits.Limits // the resource limits for each line
 }
 
 // Read reads the lines of tags from the input Reader into the given type.
@@ -39,15 +44,18 @@ func Read(input *ReadInput) (interface{}, error) {
 		inputType = input.Type
 	}
 	it, err := NewIterator(&NewIteratorInput{
-		Reader:            input.Reader,
-		SkipLines:         input.SkipLines,
-		SkipBlanks:        input.SkipBlanks,
-		SkipComments:      input.SkipComments,
-		Comment:           input.Comment,
-		Limit:             input.Limit,
-		KeyValueSeparator: input.KeyValueSeparator,
-		LineSeparator:     input.LineSeparator,
-		DropCR:            input.DropCR,
+		Reader:              input.Reader,
+		SkipLines:           input.SkipLines,
+		SkipBlanks:          input.SkipBlanks,
+		SkipComments:        input.SkipComments,
+		Comment:             input.Comment,
+		Limit:               input.Limit,
+		KeyValueSeparator:   input.KeyValueSeparator,
+		LineSeparator:       input.LineSeparator,
+		LineSeparatorRegexp: input.LineSeparatorRegexp,
+		DropCR:              input.DropCR,
+		Strict:              input.Strict,
+		Limits:              input.Limits,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating interator")
@@ -26,7 +26,7 @@ func ExampleRead() {
 		SkipBlanks:        true,
 		SkipComments:      false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 		Comment:           "",
 	})
@@ -35,7 +35,7 @@ func TestRead(t *testing.T) {
 		SkipBlanks:        true,
 		SkipComments:      false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 		Comment:           "",
 	})
@@ -65,7 +65,7 @@ func TestReadBlanks(t *testing.T) {
 		SkipBlanks:        false,
 		SkipComments:      false,
 		KeyValueSeparator: "=",
-		LineSeparator:     []byte("\n")[0],
+		LineSeparator:     "\n",
 		DropCR:            true,
 		Comment:           "",
 	})
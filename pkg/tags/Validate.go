@@ -0,0 +1,77 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package tags
+
+import (
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Validate checks that the line of tags can be decoded without ambiguity,
+// and returns an error describing the first problem found, if any.
+// Validate is used by the iterator when strict mode is enabled.
+//
+//  - Invalid UTF-8 returns ErrInvalidUTF8 wrapped with the location of the invalid byte.
+//  - Lines with the same key more than once return ErrDuplicateKey.
+func Validate(b []byte, keyValueSeparator rune) error {
+
+	if len(b) == 0 {
+		return ErrEmptyInput
+	}
+
+	if !utf8.Valid(b) {
+		column := 1
+		for offset := 0; offset < len(b); column++ {
+			r, size := utf8.DecodeRune(b[offset:])
+			if r == utf8.RuneError && size == 1 {
+				break
+			}
+			offset += size
+		}
+		return errors.Wrapf(ErrInvalidUTF8, "invalid byte at column %d", column)
+	}
+
+	keys := map[string]struct{}{}
+	key := ""
+	quotes := 0
+	str := ""
+	start := 1 // the column where the current tag starts
+	column := 0
+	for i, c := range string(b) {
+		column++
+		if quotes == 0 {
+			switch c {
+			case quote:
+				quotes++
+			case keyValueSeparator:
+				if len(key) == 0 {
+					key = str
+					str = ""
+					if _, ok := keys[key]; ok {
+						return &ErrDuplicateKey{Key: key, Column: start}
+					}
+					keys[key] = struct{}{}
+				}
+			case space:
+				key = ""
+				str = ""
+				start = column + 1
+			default:
+				str += string(c)
+			}
+		} else if quotes == 1 {
+			// if the quote is not escaped, then close the quotes
+			if c == quote && b[i-1] != '\\' {
+				quotes--
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package tags
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate([]byte("a=1 b=\"x y\" c=3"), '='))
+}
+
+func TestValidateDuplicateKey(t *testing.T) {
+	assert.Equal(t, &ErrDuplicateKey{Key: "a", Column: 13}, Validate([]byte("a=1 b=\"x y\" a=3"), '='))
+}
+
+func TestValidateInvalidUTF8(t *testing.T) {
+	assert.Equal(t, ErrInvalidUTF8, errors.Cause(Validate([]byte("a=\xff"), '=')))
+}
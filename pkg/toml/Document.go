@@ -0,0 +1,743 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package toml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	bstoml "github.com/BurntSushi/toml" // import the BurntSushi toml library as bstoml
+	"github.com/pkg/errors"
+)
+
+var (
+	bareKeyRegexp = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+)
+
+// Document is a TOML document that can be edited by key path and written back
+// without losing comments, key order, or formatting.
+// Paths are slices of keys.  A key that is an integer indexes into an array or an array of tables.
+// Edits only rewrite the text of the values and tables that are changed.
+type Document struct {
+	text string
+}
+
+// entry is a table header or key/value pair in a TOML document.
+type entry struct {
+	path       []string // the full path of the table or key
+	table      bool     // true if the entry is a table header
+	array      bool     // true if the entry is an array of tables header
+	start      int      // the offset of the start of the line
+	end        int      // the offset after the end of the last line, including the newline
+	valueStart int      // the offset of the start of the value
+	valueEnd   int      // the offset after the end of the value
+}
+
+// NewDocument parses the given TOML bytes into a Document.
+func NewDocument(b []byte) (*Document, error) {
+	d := &Document{text: string(b)}
+	_, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	_, err = d.entries()
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// decode returns the document decoded as a map.
+func (d *Document) decode() (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	_, err := bstoml.Decode(d.text, &obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing TOML document")
+	}
+	return obj, nil
+}
+
+// Get returns the decoded value at the given path.
+// If no value exists at the path, then returns ErrPathNotFound.
+func (d *Document) Get(path []string) (interface{}, error) {
+	obj, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	var value interface{} = obj
+	for i, key := range path {
+		value = child(value, key)
+		if value == nil {
+			return nil, &ErrPathNotFound{Path: path[:i+1]}
+		}
+	}
+	return value, nil
+}
+
+// child returns the child of the map or slice with the given key, or nil if not found.
+func child(obj interface{}, key string) interface{} {
+	if m, ok := obj.(map[string]interface{}); ok {
+		return m[key]
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Slice {
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < v.Len() {
+			return v.Index(i).Interface()
+		}
+	}
+	return nil
+}
+
+// Set sets the value at the given path.
+// If a key already exists at the path, then only the text of its value is replaced,
+// keeping the key, spacing, and trailing comment.
+// New keys are added to the end of the deepest existing table that contains the path.
+// An index equal to the length of an array appends to the array.
+func (d *Document) Set(path []string, value interface{}) error {
+	if len(path) == 0 {
+		return ErrEmptyPath
+	}
+
+	entries, err := d.entries()
+	if err != nil {
+		return err
+	}
+
+	// Replace the value of an existing key or a value nested within the value of an existing key.
+	for _, e := range entries {
+		if e.table || !hasPrefix(path, e.path) {
+			continue
+		}
+		if len(e.path) < len(path) {
+			current, err := d.Get(e.path)
+			if err != nil {
+				return err
+			}
+			updated, ok := setIn(current, path[len(e.path):], value)
+			if !ok {
+				return &ErrPathNotFound{Path: path}
+			}
+			value = updated
+		}
+		str, err := formatValue(value)
+		if err != nil {
+			return errors.Wrapf(err, "error formatting value for path %q", strings.Join(path, "."))
+		}
+		return d.edit(d.text[:e.valueStart] + str + d.text[e.valueEnd:])
+	}
+
+	// Replace an existing table with the new value.
+	for _, e := range entries {
+		if e.table && hasPrefix(e.path, path) {
+			err := d.Delete(path)
+			if err != nil {
+				return err
+			}
+			return d.Set(path, value)
+		}
+	}
+
+	// Find the deepest table that contains the path.
+	table := -1
+	for i, e := range entries {
+		if e.table && len(e.path) < len(path) && hasPrefix(path, e.path) && (table == -1 || len(e.path) > len(entries[table].path)) {
+			table = i
+		}
+	}
+	var tablePath []string
+	if table != -1 {
+		tablePath = entries[table].path
+	}
+	relative := path[len(tablePath):]
+
+	str, err := formatValue(value)
+	if err != nil {
+		return errors.Wrapf(err, "error formatting value for path %q", strings.Join(path, "."))
+	}
+
+	// Find the end of the last key in the table and whether the table has dotted keys that start with the same key.
+	offset := -1
+	dotted := false
+	for _, e := range entries[table+1:] {
+		if e.table {
+			break
+		}
+		offset = e.end
+		if len(e.path) > len(tablePath) && e.path[len(tablePath)] == relative[0] {
+			dotted = true
+		}
+	}
+
+	if table == -1 && len(relative) > 1 && !dotted {
+		// Add a new table to the end of the document.
+		prefix := ""
+		if len(d.text) > 0 {
+			if !strings.HasSuffix(d.text, "\n") {
+				prefix = "\n"
+			}
+			prefix += "\n"
+		}
+		return d.edit(d.text + prefix + "[" + formatKey(path[:len(path)-1]) + "]\n" + formatKey(path[len(path)-1:]) + " = " + str + "\n")
+	}
+
+	line := formatKey(relative) + " = " + str + "\n"
+	if offset == -1 {
+		if table != -1 {
+			// Add the key directly after the table header.
+			offset = entries[table].end
+		} else {
+			// Add the key before the first table in the document.
+			offset = len(d.text)
+			for _, e := range entries {
+				if e.table {
+					offset = e.start
+					line += "\n"
+					break
+				}
+			}
+		}
+	}
+	if offset > 0 && d.text[offset-1] != '\n' {
+		line = "\n" + line
+	}
+	return d.edit(d.text[:offset] + line + d.text[offset:])
+}
+
+// Delete removes the value at the given path.
+// Deleting a table removes the table header, its keys, and its sub-tables.
+// If no value exists at the path, then returns ErrPathNotFound.
+func (d *Document) Delete(path []string) error {
+	if len(path) == 0 {
+		return ErrEmptyPath
+	}
+
+	entries, err := d.entries()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if e.table || !hasPrefix(path, e.path) {
+			continue
+		}
+		if len(e.path) == len(path) {
+			return d.edit(d.text[:e.start] + d.text[e.end:])
+		}
+		current, err := d.Get(e.path)
+		if err != nil {
+			return err
+		}
+		updated, ok := deleteIn(current, path[len(e.path):])
+		if !ok {
+			return &ErrPathNotFound{Path: path}
+		}
+		str, err := formatValue(updated)
+		if err != nil {
+			return errors.Wrapf(err, "error formatting value for path %q", strings.Join(e.path, "."))
+		}
+		return d.edit(d.text[:e.valueStart] + str + d.text[e.valueEnd:])
+	}
+
+	// Remove the sections of the table and its sub-tables, from the end of the document to the start.
+	text := d.text
+	removed := false
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if !e.table || !hasPrefix(e.path, path) {
+			continue
+		}
+		end := len(d.text)
+		for _, next := range entries[i+1:] {
+			if next.table {
+				end = next.start
+				break
+			}
+		}
+		text = text[:e.start] + text[end:]
+		removed = true
+	}
+	if !removed {
+		return &ErrPathNotFound{Path: path}
+	}
+	return d.edit(text)
+}
+
+// edit replaces the text of the document, if the new text is valid TOML.
+func (d *Document) edit(text string) error {
+	previous := d.text
+	d.text = text
+	_, err := d.decode()
+	if err != nil {
+		d.text = previous
+		return errors.Wrap(err, "error editing TOML document")
+	}
+	return nil
+}
+
+// entries parses the table headers and key/value pairs in the document.
+// Keys within an array of tables are prefixed with the index of the table in the array.
+func (d *Document) entries() ([]entry, error) {
+	text := d.text
+	entries := make([]entry, 0)
+	arrays := map[string]int{} // the current index of each array of tables
+	table := []string{}
+	pos := 0
+	for pos < len(text) {
+		pos = skipSpace(text, pos)
+		if pos >= len(text) {
+			break
+		}
+		switch text[pos] {
+		case '\n', '\r':
+			pos++
+			continue
+		case '#':
+			pos = endOfLine(text, pos)
+			continue
+		}
+		start := strings.LastIndexByte(text[:pos], '\n') + 1
+		if text[pos] == '[' {
+			array := strings.HasPrefix(text[pos:], "[[")
+			if array {
+				pos += 2
+			} else {
+				pos++
+			}
+			keys, next, err := parseKey(text, pos)
+			if err != nil {
+				return nil, err
+			}
+			pos = skipSpace(text, next)
+			if array {
+				if !strings.HasPrefix(text[pos:], "]]") {
+					return nil, errors.Errorf("missing closing brackets for table header at offset %d", start)
+				}
+				pos += 2
+			} else {
+				if !strings.HasPrefix(text[pos:], "]") {
+					return nil, errors.Errorf("missing closing bracket for table header at offset %d", start)
+				}
+				pos++
+			}
+			// Resolve the path of the table by adding the indices of the enclosing arrays of tables.
+			path := make([]string, 0, len(keys)+1)
+			for i, key := range keys {
+				path = append(path, key)
+				if i == len(keys)-1 && array {
+					index := 0
+					if n, ok := arrays[strings.Join(path, "\x00")]; ok {
+						index = n + 1
+					}
+					arrays[strings.Join(path, "\x00")] = index
+					path = append(path, strconv.Itoa(index))
+				} else if n, ok := arrays[strings.Join(path, "\x00")]; ok {
+					path = append(path, strconv.Itoa(n))
+				}
+			}
+			// Starting a new element of an array of tables resets the indices of the arrays nested within it.
+			if array {
+				prefix := strings.Join(path[:len(path)-1], "\x00") + "\x00"
+				for k := range arrays {
+					if strings.HasPrefix(k, prefix) {
+						delete(arrays, k)
+					}
+				}
+			}
+			table = path
+			end := endOfLine(text, pos)
+			entries = append(entries, entry{path: path, table: true, array: array, start: start, end: end, valueStart: end, valueEnd: end})
+			pos = end
+			continue
+		}
+		keys, next, err := parseKey(text, pos)
+		if err != nil {
+			return nil, err
+		}
+		pos = skipSpace(text, next)
+		if pos >= len(text) || text[pos] != '=' {
+			return nil, errors.Errorf("missing equals sign after key at offset %d", start)
+		}
+		valueStart := skipSpace(text, pos+1)
+		valueEnd, err := scanValue(text, valueStart)
+		if err != nil {
+			return nil, err
+		}
+		path := make([]string, 0, len(table)+len(keys))
+		path = append(path, table...)
+		path = append(path, keys...)
+		end := endOfLine(text, valueEnd)
+		entries = append(entries, entry{path: path, start: start, end: end, valueStart: valueStart, valueEnd: valueEnd})
+		pos = end
+	}
+	return entries, nil
+}
+
+// skipSpace returns the offset of the next character that is not a space or tab.
+func skipSpace(text string, pos int) int {
+	for pos < len(text) && (text[pos] == ' ' || text[pos] == '\t') {
+		pos++
+	}
+	return pos
+}
+
+// endOfLine returns the offset after the newline that ends the line containing the offset.
+func endOfLine(text string, pos int) int {
+	if i := strings.IndexByte(text[pos:], '\n'); i != -1 {
+		return pos + i + 1
+	}
+	return len(text)
+}
+
+// parseKey parses a bare, quoted, or dotted key starting at the offset.
+// Returns the keys and the offset after the key.
+func parseKey(text string, pos int) ([]string, int, error) {
+	keys := make([]string, 0)
+	for {
+		pos = skipSpace(text, pos)
+		if pos >= len(text) {
+			return nil, pos, errors.Errorf("missing key at offset %d", pos)
+		}
+		switch text[pos] {
+		case '"', '\'':
+			end, err := scanString(text, pos)
+			if err != nil {
+				return nil, pos, err
+			}
+			key := text[pos+1 : end-1]
+			if text[pos] == '"' {
+				var str string
+				_, err := bstoml.Decode("k = "+text[pos:end], &struct {
+					K *string `toml:"k"`
+				}{K: &str})
+				if err != nil {
+					return nil, pos, errors.Wrapf(err, "invalid quoted key at offset %d", pos)
+				}
+				key = str
+			}
+			keys = append(keys, key)
+			pos = end
+		default:
+			end := pos
+			for end < len(text) && (isBareKeyChar(text[end])) {
+				end++
+			}
+			if end == pos {
+				return nil, pos, errors.Errorf("invalid key at offset %d", pos)
+			}
+			keys = append(keys, text[pos:end])
+			pos = end
+		}
+		next := skipSpace(text, pos)
+		if next >= len(text) || text[next] != '.' {
+			return keys, pos, nil
+		}
+		pos = next + 1
+	}
+}
+
+// isBareKeyChar returns true if the character can be used in a bare key.
+func isBareKeyChar(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '_' || c == '-'
+}
+
+// scanString returns the offset after the end of the string starting at the offset.
+// Supports basic, literal, multi-line basic, and multi-line literal strings.
+func scanString(text string, pos int) (int, error) {
+	quote := text[pos]
+	if strings.HasPrefix(text[pos:], strings.Repeat(string(quote), 3)) {
+		delimiter := strings.Repeat(string(quote), 3)
+		for i := pos + 3; i < len(text); i++ {
+			if quote == '"' && text[i] == '\\' {
+				i++
+				continue
+			}
+			if strings.HasPrefix(text[i:], delimiter) {
+				end := i + 3
+				// A multi-line string can end with up to two additional quotes.
+				for n := 0; n < 2 && end < len(text) && text[end] == quote; n++ {
+					end++
+				}
+				return end, nil
+			}
+		}
+		return len(text), errors.Errorf("unterminated multi-line string at offset %d", pos)
+	}
+	for i := pos + 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			return i + 1, nil
+		case '\n':
+			return i, errors.Errorf("unterminated string at offset %d", pos)
+		}
+	}
+	return len(text), errors.Errorf("unterminated string at offset %d", pos)
+}
+
+// scanValue returns the offset after the end of the value starting at the offset.
+// Arrays and inline tables can span multiple lines and include comments.
+func scanValue(text string, pos int) (int, error) {
+	if pos >= len(text) {
+		return pos, errors.Errorf("missing value at offset %d", pos)
+	}
+	switch text[pos] {
+	case '"', '\'':
+		return scanString(text, pos)
+	case '[', '{':
+		depth := 0
+		for i := pos; i < len(text); i++ {
+			switch text[i] {
+			case '"', '\'':
+				end, err := scanString(text, i)
+				if err != nil {
+					return end, err
+				}
+				i = end - 1
+			case '#':
+				i = endOfLine(text, i) - 1
+			case '[', '{':
+				depth++
+			case ']', '}':
+				depth--
+				if depth == 0 {
+					return i + 1, nil
+				}
+			}
+		}
+		return len(text), errors.Errorf("unterminated value at offset %d", pos)
+	}
+	end := pos
+	for end < len(text) && text[end] != '\n' && text[end] != '#' {
+		end++
+	}
+	return pos + len(strings.TrimRight(text[pos:end], " \t\r")), nil
+}
+
+// Bytes returns the document formatted as TOML.
+func (d *Document) Bytes() ([]byte, error) {
+	return []byte(d.text), nil
+}
+
+// setIn sets the value at the path within the given map or slice.
+// Returns the updated object and true if the path could be set.
+func setIn(obj interface{}, path []string, value interface{}) (interface{}, bool) {
+	if len(path) == 0 {
+		return value, true
+	}
+	if m, ok := obj.(map[string]interface{}); ok {
+		c := m[path[0]]
+		if c == nil {
+			c = map[string]interface{}{}
+		}
+		updated, ok := setIn(c, path[1:], value)
+		if !ok {
+			return nil, false
+		}
+		m[path[0]] = updated
+		return m, true
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Slice {
+		return nil, false
+	}
+	i, err := strconv.Atoi(path[0])
+	if err != nil || i < 0 || i > v.Len() {
+		return nil, false
+	}
+	items := make([]interface{}, 0, v.Len()+1)
+	for j := 0; j < v.Len(); j++ {
+		items = append(items, v.Index(j).Interface())
+	}
+	if i == len(items) {
+		items = append(items, map[string]interface{}{})
+	}
+	updated, ok := setIn(items[i], path[1:], value)
+	if !ok {
+		return nil, false
+	}
+	items[i] = updated
+	return items, true
+}
+
+// deleteIn removes the value at the path within the given map or slice.
+// Returns the updated object and true if the value was found.
+func deleteIn(obj interface{}, path []string) (interface{}, bool) {
+	if m, ok := obj.(map[string]interface{}); ok {
+		c, ok := m[path[0]]
+		if !ok {
+			return nil, false
+		}
+		if len(path) == 1 {
+			delete(m, path[0])
+			return m, true
+		}
+		updated, ok := deleteIn(c, path[1:])
+		if !ok {
+			return nil, false
+		}
+		m[path[0]] = updated
+		return m, true
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Slice {
+		return nil, false
+	}
+	i, err := strconv.Atoi(path[0])
+	if err != nil || i < 0 || i >= v.Len() {
+		return nil, false
+	}
+	items := make([]interface{}, 0, v.Len())
+	for j := 0; j < v.Len(); j++ {
+		items = append(items, v.Index(j).Interface())
+	}
+	if len(path) == 1 {
+		return append(items[:i], items[i+1:]...), true
+	}
+	updated, ok := deleteIn(items[i], path[1:])
+	if !ok {
+		return nil, false
+	}
+	items[i] = updated
+	return items, true
+}
+
+// hasPrefix returns true if the path starts with the prefix.
+func hasPrefix(path []string, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i, key := range prefix {
+		if path[i] != key {
+			return false
+		}
+	}
+	return true
+}
+
+// quote returns the string as a TOML basic string.
+func quote(str string) string {
+	buf := new(bytes.Buffer)
+	e := json.NewEncoder(buf)
+	e.SetEscapeHTML(false)
+	_ = e.Encode(str) // encoding a string cannot fail
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// formatKey returns the path formatted as a dotted TOML key.
+func formatKey(path []string) string {
+	keys := make([]string, 0, len(path))
+	for _, key := range path {
+		if bareKeyRegexp.MatchString(key) {
+			keys = append(keys, key)
+		} else {
+			keys = append(keys, quote(key))
+		}
+	}
+	return strings.Join(keys, ".")
+}
+
+// formatValue returns the value formatted as a TOML value.
+// Maps are formatted as inline tables with sorted keys.
+func formatValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", ErrNilObject
+	}
+	switch v := value.(type) {
+	case string:
+		return quote(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case float32:
+		return formatFloat(float64(v)), nil
+	case float64:
+		return formatFloat(v), nil
+	case json.Number:
+		return v.String(), nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Array, reflect.Slice:
+		items := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			item, err := formatValue(rv.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+			items = append(items, item)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		values := map[string]interface{}{}
+		for _, k := range rv.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			keys = append(keys, key)
+			values[key] = rv.MapIndex(k).Interface()
+		}
+		sort.Strings(keys)
+		if len(keys) == 0 {
+			return "{}", nil
+		}
+		pairs := make([]string, 0, len(keys))
+		for _, key := range keys {
+			str, err := formatValue(values[key])
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, formatKey([]string{key})+" = "+str)
+		}
+		return "{ " + strings.Join(pairs, ", ") + " }", nil
+	}
+	return "", &ErrInvalidKind{
+		Value: rv.Type(),
+		Expected: []reflect.Kind{
+			reflect.String,
+			reflect.Bool,
+			reflect.Int,
+			reflect.Uint,
+			reflect.Float64,
+			reflect.Array,
+			reflect.Slice,
+			reflect.Map,
+		},
+	}
+}
+
+// formatFloat returns the float formatted as a TOML float.
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	str := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(str, ".e") {
+		str += ".0"
+	}
+	return str
+}
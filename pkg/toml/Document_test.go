@@ -0,0 +1,123 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package toml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const config = `# Server configuration
+title = "example" # the title
+
+[server]
+host = "localhost"
+ports = [ 8000, # http
+  8001 ]
+
+[[products]]
+name = "hammer"
+
+[[products]]
+name = "nail"
+size = { width = 1, height = 2 }
+`
+
+func TestDocumentGet(t *testing.T) {
+	d, err := NewDocument([]byte(config))
+	require.NoError(t, err)
+
+	obj, err := d.Get([]string{"title"})
+	assert.NoError(t, err)
+	assert.Equal(t, "example", obj)
+
+	obj, err = d.Get([]string{"server", "ports", "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8001), obj)
+
+	obj, err = d.Get([]string{"products", "1", "size", "height"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), obj)
+
+	obj, err = d.Get([]string{"server", "missing"})
+	assert.IsType(t, &ErrPathNotFound{}, err)
+	assert.Nil(t, obj)
+}
+
+func TestDocumentSet(t *testing.T) {
+	d, err := NewDocument([]byte(config))
+	require.NoError(t, err)
+
+	require.NoError(t, d.Set([]string{"title"}, "test"))
+	require.NoError(t, d.Set([]string{"server", "ports"}, []interface{}{80, 443}))
+	require.NoError(t, d.Set([]string{"server", "timeout"}, 1.5))
+	require.NoError(t, d.Set([]string{"products", "0", "price"}, 10))
+	require.NoError(t, d.Set([]string{"products", "1", "size", "width"}, 3))
+	require.NoError(t, d.Set([]string{"version"}, 2))
+	require.NoError(t, d.Set([]string{"owner", "first name"}, "Tom"))
+
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	expected := `# Server configuration
+title = "test" # the title
+version = 2
+
+[server]
+host = "localhost"
+ports = [80, 443]
+timeout = 1.5
+
+[[products]]
+name = "hammer"
+price = 10
+
+[[products]]
+name = "nail"
+size = { height = 2, width = 3 }
+
+[owner]
+"first name" = "Tom"
+`
+	assert.Equal(t, expected, string(b))
+}
+
+func TestDocumentDelete(t *testing.T) {
+	d, err := NewDocument([]byte(config))
+	require.NoError(t, err)
+
+	require.NoError(t, d.Delete([]string{"server", "host"}))
+	require.NoError(t, d.Delete([]string{"products", "0"}))
+	require.NoError(t, d.Delete([]string{"products", "0", "size", "width"}))
+	assert.IsType(t, &ErrPathNotFound{}, d.Delete([]string{"server", "host"}))
+
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	expected := `# Server configuration
+title = "example" # the title
+
+[server]
+ports = [ 8000, # http
+  8001 ]
+
+[[products]]
+name = "nail"
+size = { height = 2 }
+`
+	assert.Equal(t, expected, string(b))
+}
+
+func TestDocumentInvalid(t *testing.T) {
+	d, err := NewDocument([]byte(config))
+	require.NoError(t, err)
+	assert.Error(t, d.Set([]string{"title", "sub"}, "x"))
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, config, string(b))
+}
@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package toml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrPathNotFound is used when no value exists at a key path.
+type ErrPathNotFound struct {
+	Path []string // the path that could not be found
+}
+
+// Error returns the error formatted as a string.
+func (e ErrPathNotFound) Error() string {
+	return fmt.Sprintf("path %q not found", strings.Join(e.Path, "."))
+}
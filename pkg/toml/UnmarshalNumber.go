@@ -0,0 +1,130 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package toml
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	bstoml "github.com/BurntSushi/toml" // import the BurntSushi toml library as bstoml
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// UnmarshalNumber parses a slice of bytes into a map like Unmarshal,
+// but decodes numbers using the given number mode, so that large integers do not lose precision.
+// The text of each integer and float literal is parsed with number.Parse,
+// so integers that do not fit in an int64 can be decoded with the number and big modes.
+// If the mode is empty or float, then simply uses Unmarshal.
+// See the number package for the supported modes.
+func UnmarshalNumber(b []byte, mode string) (interface{}, error) {
+
+	if len(mode) == 0 || mode == number.ModeFloat {
+		return Unmarshal(b)
+	}
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	d := &Document{text: string(b)}
+	entries, err := d.entries()
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error unmarshaling TOML %q", string(b)))
+	}
+
+	// Replace each number literal with a quoted placeholder, so that the TOML library does not parse it.
+	prefix := newPlaceholder()
+	numbers := map[string]string{}
+	text := new(strings.Builder)
+	pos := 0
+	for _, e := range entries {
+		if e.table {
+			continue
+		}
+		p, err := replaceNumbers(d.text, e.valueStart, e.valueEnd, text, pos, prefix, numbers)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error unmarshaling TOML %q", string(b)))
+		}
+		pos = p
+	}
+	text.WriteString(d.text[pos:])
+
+	obj := map[string]interface{}{}
+	_, err = bstoml.Decode(text.String(), &obj)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error unmarshaling TOML %q", string(b)))
+	}
+
+	return number.Convert(number.Replace(obj, func(value interface{}) (interface{}, bool) {
+		if str, ok := value.(string); ok {
+			if n, ok := numbers[str]; ok {
+				return json.Number(n), true
+			}
+		}
+		return nil, false
+	}), mode)
+}
+
+// newPlaceholder returns a random prefix for the strings that temporarily replace numbers.
+func newPlaceholder() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return "gss-number-" + hex.EncodeToString(b) + "-"
+}
+
+// replaceNumbers writes the text from pos to the end of the value to the builder,
+// replacing each number literal in the value with a quoted placeholder.
+// Keys of inline tables, strings, and comments are written as is.
+// Returns the offset after the end of the value.
+func replaceNumbers(text string, start int, end int, b *strings.Builder, pos int, prefix string, numbers map[string]string) (int, error) {
+	b.WriteString(text[pos:start])
+	i := start
+	for i < end {
+		c := text[i]
+		switch {
+		case c == '"' || c == '\'':
+			next, err := scanString(text, i)
+			if err != nil {
+				return i, err
+			}
+			b.WriteString(text[i:next])
+			i = next
+		case c == '#':
+			next := endOfLine(text, i)
+			b.WriteString(text[i:next])
+			i = next
+		case isBareKeyChar(c) || c == '+' || c == '.':
+			next := i
+			for next < end && (isBareKeyChar(text[next]) || text[next] == '+' || text[next] == '.' || text[next] == ':') {
+				next++
+			}
+			token := text[i:next]
+			if k := skipSpace(text, next); k < len(text) && text[k] == '=' {
+				// The token is a key within an inline table.
+				b.WriteString(token)
+			} else if n, ok := number.Normalize(token); ok {
+				placeholder := prefix + strconv.Itoa(len(numbers))
+				numbers[placeholder] = n
+				b.WriteString(strconv.Quote(placeholder))
+			} else {
+				b.WriteString(token)
+			}
+			i = next
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return end, nil
+}
@@ -0,0 +1,61 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package toml
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalNumber(t *testing.T) {
+	in := "id = 92233720368547758070\nx = 0x1F # hex\nd = 1979-05-27T07:32:00Z\ns = \"1\"\nt = { 1 = 1_000 }\n\n[table]\nv = [1.5, -2]\n"
+	obj, err := UnmarshalNumber([]byte(in), "number")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id": json.Number("92233720368547758070"),
+		"x":  json.Number("31"),
+		"d":  time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC),
+		"s":  "1",
+		"t":  map[string]interface{}{"1": json.Number("1000")},
+		"table": map[string]interface{}{
+			"v": []interface{}{json.Number("1.5"), json.Number("-2")},
+		},
+	}, obj)
+}
+
+func TestUnmarshalNumberBig(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("id = 92233720368547758070\n"), "big")
+	assert.NoError(t, err)
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	assert.Equal(t, map[string]interface{}{"id": i}, obj)
+}
+
+func TestUnmarshalNumberNaN(t *testing.T) {
+	for _, mode := range []string{"float", "number", "int64", "big"} {
+		t.Run(mode, func(t *testing.T) {
+			obj, err := UnmarshalNumber([]byte("a = nan\nb = inf\nc = -inf\n"), mode)
+			assert.NoError(t, err)
+			m, ok := obj.(map[string]interface{})
+			assert.True(t, ok)
+			assert.True(t, math.IsNaN(m["a"].(float64)))
+			assert.Equal(t, math.Inf(1), m["b"])
+			assert.Equal(t, math.Inf(-1), m["c"])
+		})
+	}
+}
+
+func TestUnmarshalNumberEmpty(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte{}, "number")
+	assert.Equal(t, ErrEmptyInput, err)
+	assert.Nil(t, obj)
+}
@@ -21,6 +21,7 @@ var (
 
 var (
 	ErrEmptyInput = errors.New("empty input")
+	ErrEmptyPath  = errors.New("empty path")
 	// TOML cannot marshal nil values, because of a design decision.
 	// https://github.com/toml-lang/toml/issues/30
 	ErrNilObject = errors.New("nil object, toml cannot marshal nil values")
@@ -7,9 +7,24 @@
 
 // Package writer provides an easy API to create a writer to write objects to a file.
 // Depends on the following packages in go-simple-serializer.
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/avro
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/bson
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/cbor
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/encoding
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/fixedwidth
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/geojson
 //	- github.com/spatialcurrent/go-simple-serializer/pkg/jsonl
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/jsonseq
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/msgpack
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/number
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/parquet
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/protobuf
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/sql
 //	- github.com/spatialcurrent/go-simple-serializer/pkg/sv
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/logfmt
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/table
 //	- github.com/spatialcurrent/go-simple-serializer/pkg/tags
+//	- github.com/spatialcurrent/go-simple-serializer/pkg/xlsx
 package writer
 
 import (
@@ -18,11 +33,26 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/avro"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/bson"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/cbor"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/encoding"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/fixedwidth"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/fmt"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/geojson"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/gob"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/jsonl"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/jsonseq"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/logfmt"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/msgpack"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/parquet"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/protobuf"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sql"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/table"
 	"github.com/spatialcurrent/go-simple-serializer/pkg/tags"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/xlsx"
 	"github.com/spatialcurrent/go-stringify/pkg/stringify"
 )
 
@@ -37,7 +67,7 @@ type NewWriterInput struct {
 	Format            string
 	FormatSpecifier   string
 	Header            []interface{}
-	ExpandHeader      bool // in context, only used by tags as ExpandKeys
+	ExpandHeader      bool // in context, only used by logfmt and tags as ExpandKeys
 	KeySerializer     stringify.Stringer
 	ValueSerializer   stringify.Stringer
 	KeyValueSeparator string
@@ -46,19 +76,78 @@ type NewWriterInput struct {
 	Pretty            bool
 	Sorted            bool
 	Reversed          bool
+	Borders           string // in context, only used by table
+	Align             string // in context, only used by html, markdown, and table
+	MaxWidth          int    // in context, only used by html, markdown, and table
+	Wrap              bool   // in context, only used by table
+	Schema            string // in context, only used by avro and parquet
+	Codec             string // in context, only used by avro and parquet
+	SampleSize        int    // in context, only used by avro and parquet
+	Sheet             string // in context, only used by xlsx
+	SheetKey          string // in context, only used by xlsx
+	AutoFilter        bool   // in context, only used by xlsx
+	Table             string // in context, only used by sql
+	Dialect           string // in context, only used by sql
+	Statement         string // in context, only used by sql
+	BatchSize         int    // in context, only used by sql
+	CreateTable       bool   // in context, only used by sql
+	DescriptorSet     []byte // in context, only used by protobuf
+	Message           string // in context, only used by protobuf
+	Longitude         string // in context, only used by geojson and geojsonl
+	Latitude          string // in context, only used by geojson and geojsonl
+	WKT               string // in context, only used by geojson and geojsonl
+	ExtendedJSON      string // in context, only used by jsonl and jsonseq
+	Encoding          string // the text encoding of the output, one of encoding.OutputEncodings.  If empty, then writes UTF-8.  Not used by binary formats.
+	BOM               bool   // write a byte order mark before the output.  Not used by binary formats.
 }
 
 // NewWriter returns a new pipe.Writer for writing formatted objects to an underlying writer.
 func NewWriter(input *NewWriterInput) (pipe.Writer, error) {
 
 	switch input.Format {
-	case "go", "jsonl", "tags":
+	case "fixedwidth", "go", "jsonl", "logfmt", "tags":
 		if len(input.LineSeparator) == 0 {
 			return nil, ErrMissingLineSeparator
 		}
 	}
 
+	// Transcode text from UTF-8 and write any byte order mark.  Binary formats are written as is.
 	switch input.Format {
+	case "avro", "bson", "cbor", "gob", "msgpack", "parquet", "protobuf", "xlsx":
+	default:
+		if len(input.Encoding) > 0 || input.BOM {
+			e := input.Encoding
+			if len(e) == 0 {
+				e = encoding.EncodingUTF8
+			}
+			w, err := encoding.NewWriter(input.Writer, e, input.BOM)
+			if err != nil {
+				return nil, errors.Wrap(err, "error creating encoder")
+			}
+			// copy the input, so that the caller's input is not modified
+			in := *input
+			in.Writer = w
+			input = &in
+		}
+	}
+
+	switch input.Format {
+	case "avro":
+		w, err := avro.NewWriter(
+			input.Writer,
+			input.Schema,
+			input.Codec,
+			input.SampleSize,
+			input.KeySerializer,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating avro writer")
+		}
+		return w, nil
+	case "bson":
+		return bson.NewWriter(input.Writer, input.KeySerializer), nil
+	case "cbor":
+		return cbor.NewWriter(input.Writer, input.KeySerializer), nil
 	case "csv", "tsv":
 		separator, err := sv.FormatToSeparator(input.Format)
 		if err != nil {
@@ -74,6 +163,22 @@ func NewWriter(input *NewWriterInput) (pipe.Writer, error) {
 			input.Reversed,
 		)
 		return w, nil
+	case "fixedwidth":
+		columns, err := fixedwidth.ParseColumns(input.Header)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing header")
+		}
+		if len(columns) == 0 {
+			return nil, fixedwidth.ErrMissingColumns
+		}
+		w := fixedwidth.NewWriter(
+			input.Writer,
+			columns,
+			input.LineSeparator,
+			input.ValueSerializer,
+			false,
+		)
+		return w, nil
 	case "fmt":
 		w := fmt.NewWriter(input.Writer, input.FormatSpecifier, input.LineSeparator)
 		return w, nil
@@ -82,6 +187,23 @@ func NewWriter(input *NewWriterInput) (pipe.Writer, error) {
 		return w, nil
 	case "gob":
 		return gob.NewWriter(input.Writer, input.Fit), nil
+	case "html", "markdown", "table":
+		w := table.NewWriter(
+			input.Writer,
+			input.Header,
+			input.KeySerializer,
+			input.ValueSerializer,
+			input.Sorted,
+			input.Reversed,
+			table.Style{
+				Format:   input.Format,
+				Borders:  input.Borders,
+				Align:    input.Align,
+				MaxWidth: input.MaxWidth,
+				Wrap:     input.Wrap,
+			},
+		)
+		return w, nil
 	case "jsonl":
 		w := jsonl.NewWriter(
 			input.Writer,
@@ -89,6 +211,84 @@ func NewWriter(input *NewWriterInput) (pipe.Writer, error) {
 			input.KeySerializer,
 			input.Pretty,
 		)
+		// Big numbers are converted into json.Number, so that they are not written as quoted strings.
+		nw := number.NewWriter(w)
+		if len(input.ExtendedJSON) > 0 {
+			return bson.NewExtendedJSONWriter(nw, input.ExtendedJSON), nil
+		}
+		return nw, nil
+	case "geojson", "geojsonl":
+		w := geojson.NewWriter(
+			input.Writer,
+			input.Format == "geojsonl",
+			input.Longitude,
+			input.Latitude,
+			input.WKT,
+			input.KeySerializer,
+		)
+		return w, nil
+	case "jsonseq":
+		w := jsonseq.NewWriter(
+			input.Writer,
+			input.KeySerializer,
+			input.Pretty,
+		)
+		// Big numbers are converted into json.Number, so that they are not written as quoted strings.
+		nw := number.NewWriter(w)
+		if len(input.ExtendedJSON) > 0 {
+			return bson.NewExtendedJSONWriter(nw, input.ExtendedJSON), nil
+		}
+		return nw, nil
+	case "logfmt":
+		w := logfmt.NewWriter(
+			input.Writer,
+			input.Header,
+			input.ExpandHeader,
+			input.LineSeparator,
+			input.KeySerializer,
+			input.ValueSerializer,
+			input.Sorted,
+			input.Reversed,
+		)
+		return w, nil
+	case "msgpack":
+		return msgpack.NewWriter(input.Writer, input.KeySerializer), nil
+	case "parquet":
+		w, err := parquet.NewWriter(
+			input.Writer,
+			input.Schema,
+			input.Header,
+			input.Codec,
+			input.SampleSize,
+			input.KeySerializer,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating parquet writer")
+		}
+		return w, nil
+	case "protobuf":
+		mt, err := protobuf.LoadMessageType(input.DescriptorSet, input.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading protobuf message type")
+		}
+		return protobuf.NewWriter(input.Writer, mt, input.KeySerializer), nil
+	case "sql":
+		w, err := sql.NewWriter(
+			input.Writer,
+			input.Table,
+			input.Dialect,
+			input.Statement,
+			input.Header,
+			input.CreateTable,
+			input.BatchSize,
+			input.KeySerializer,
+			input.ValueSerializer,
+			input.Sorted,
+			input.Reversed,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating sql writer")
+		}
 		return w, nil
 	case "tags":
 		if len(input.KeyValueSeparator) == 0 {
@@ -106,6 +306,18 @@ func NewWriter(input *NewWriterInput) (pipe.Writer, error) {
 			input.Reversed,
 		)
 		return w, nil
+	case "xlsx":
+		return xlsx.NewWriter(
+			input.Writer,
+			input.Sheet,
+			input.SheetKey,
+			input.Header,
+			input.AutoFilter,
+			input.KeySerializer,
+			input.ValueSerializer,
+			input.Sorted,
+			input.Reversed,
+		), nil
 	}
 
 	return nil, &ErrInvalidFormat{Format: input.Format}
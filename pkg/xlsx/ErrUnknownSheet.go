@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"fmt"
+)
+
+// ErrUnknownSheet is used when a sheet is not in the workbook.
+type ErrUnknownSheet struct {
+	Value string // the name or index of the sheet
+}
+
+// Error returns the error formatted as a string.
+func (e ErrUnknownSheet) Error() string {
+	return fmt.Sprintf("unknown sheet %q", e.Value)
+}
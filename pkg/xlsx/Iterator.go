@@ -0,0 +1,181 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator is used to iterate over the rows of a sheet in a workbook.
+type Iterator struct {
+	Type   reflect.Type
+	file   *excelize.File
+	rows   *excelize.Rows
+	sheet  string
+	header []interface{}
+	limit  int
+	count  int
+	closed bool
+}
+
+// NewIteratorInput provides the input parameters for NewIterator function.
+type NewIteratorInput struct {
+	Reader    io.Reader
+	Type      reflect.Type // required
+	Sheet     string       // the name or zero-based index of the sheet.  If blank, then reads the first sheet.
+	Header    []interface{}
+	SkipLines int // the number of rows to skip before the header
+	Limit     int
+	Limits    limits.Limits // the maximum number of columns
+}
+
+// NewIterator returns a new iterator for iterating over the rows of a sheet in a workbook.
+// The workbook is read into memory, since a workbook is a zip archive.
+// If the header is not given, then the first row, after skipping lines, is used as the header.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+
+	if input.Type == nil || input.Type.Kind() != reflect.Map {
+		return nil, errors.New("input type must be of kind map")
+	}
+
+	f, err := excelize.OpenReader(input.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening workbook")
+	}
+
+	sheet, err := findSheet(f, input.Sheet)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrapf(err, "error reading rows of sheet %q", sheet)
+	}
+
+	it := &Iterator{
+		Type:   input.Type,
+		file:   f,
+		rows:   rows,
+		sheet:  sheet,
+		header: input.Header,
+		limit:  input.Limit,
+		count:  0,
+	}
+
+	for i := 0; i < input.SkipLines; i++ {
+		if _, err := it.next(); err != nil {
+			if err == io.EOF {
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "error skipping lines")
+		}
+	}
+
+	if len(input.Header) == 0 {
+		h, err := it.next()
+		if err != nil {
+			if err == io.EOF {
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "error reading header")
+		}
+		header := make([]interface{}, 0, len(h))
+		for _, str := range h {
+			header = append(header, str)
+		}
+		it.header = header
+	}
+
+	if err := input.Limits.CheckKeys(len(it.header)); err != nil {
+		it.close()
+		return nil, errors.Wrap(err, "error checking header")
+	}
+
+	return it, nil
+}
+
+// next returns the cells of the next row that is not empty.
+// When the sheet is exhausted, closes the workbook and returns (nil, io.EOF).
+func (it *Iterator) next() ([]string, error) {
+	for !it.closed && it.rows.Next() {
+		cells, err := it.rows.Columns()
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading cells")
+		}
+		if len(cells) > 0 {
+			return cells, nil
+		}
+	}
+	if !it.closed {
+		if err := it.rows.Error(); err != nil {
+			it.close()
+			return nil, errors.Wrap(err, "error reading rows")
+		}
+	}
+	it.close()
+	return nil, io.EOF
+}
+
+// close closes the rows and the workbook, removing any temporary files.
+func (it *Iterator) close() {
+	if !it.closed {
+		_ = it.rows.Close()
+		_ = it.file.Close()
+		it.closed = true
+	}
+}
+
+// Next reads from the sheet and returns the next object and error, if any.
+// When finished, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+	// If reached limit, return io.EOF
+	if it.limit > 0 && it.count >= it.limit {
+		it.close()
+		return nil, io.EOF
+	}
+
+	// Increment Counter
+	it.count++
+
+	row, err := it.next()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "error reading next row")
+	}
+	m := reflect.MakeMap(it.Type)
+	for i, h := range it.header {
+		// Every key in the header is set, even if the trailing cells of the row are empty.
+		value := ""
+		if i < len(row) {
+			value = row[i]
+		}
+		m.SetMapIndex(reflect.ValueOf(h), reflect.ValueOf(value))
+	}
+	return m.Interface(), nil
+}
+
+// Header returns the header of the sheet.
+func (it *Iterator) Header() []interface{} {
+	return it.header
+}
+
+// Sheet returns the name of the sheet being read.
+func (it *Iterator) Sheet() string {
+	return it.sheet
+}
@@ -0,0 +1,124 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func testFile(t *testing.T) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, "", "sheet", []interface{}{"a", "b"}, false, nil, nil, false, false)
+	require.NoError(t, w.WriteObject(map[string]interface{}{"sheet": "first", "a": "x", "b": 1}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"sheet": "second", "a": "y", "b": 2}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"sheet": "second", "a": "z", "b": 3}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"sheet": "second", "a": "w"}))
+	require.NoError(t, w.Flush())
+	return buf
+}
+
+func TestIterator(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]string{}),
+		Sheet:  "second",
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"a", "b"}, it.Header())
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "y", "b": "2"}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "z", "b": "3"}, obj)
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorIndex(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader:    testFile(t),
+		Type:      reflect.TypeOf(map[string]interface{}{}),
+		Sheet:     "1",
+		Header:    []interface{}{"c", "d"},
+		SkipLines: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "second", it.Sheet())
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"c": "z", "d": "3"}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"c": "w", "d": ""}, obj)
+	_, err = it.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestIteratorTrailingBlankCells(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, "", "", []interface{}{"id", "name", "note"}, false, nil, nil, false, false)
+	require.NoError(t, w.WriteObject(map[string]interface{}{"id": 1, "name": "b", "note": "x"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"id": 2, "name": "c", "note": ""}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"id": 3}))
+	require.NoError(t, w.Flush())
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: buf,
+		Type:   reflect.TypeOf(map[string]string{}),
+	})
+	require.NoError(t, err)
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"id": "1", "name": "b", "note": "x"}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"id": "2", "name": "c", "note": ""}, obj)
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"id": "3", "name": "", "note": ""}, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]string{}),
+		Limits: limits.Limits{MaxKeys: 1},
+	})
+	require.Error(t, err)
+}
+
+func TestIteratorInvalid(t *testing.T) {
+	_, err := NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(map[string]string{}),
+		Sheet:  "third",
+	})
+	require.Equal(t, &ErrUnknownSheet{Value: "third"}, err)
+
+	_, err = NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader("a,b\n1,2\n"),
+		Type:   reflect.TypeOf(map[string]string{}),
+	})
+	require.Error(t, err)
+
+	_, err = NewIterator(&NewIteratorInput{
+		Reader: testFile(t),
+		Type:   reflect.TypeOf(""),
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input for the Read function.
+type ReadInput struct {
+	Type      reflect.Type // the output type
+	Reader    io.Reader
+	Sheet     string // the name or zero-based index of the sheet.  If blank, then reads the first sheet.
+	Header    []interface{}
+	SkipLines int
+	Limit     int
+	Limits    limits.Limits // the maximum number of columns
+}
+
+// Read reads the rows of a sheet from the input reader into a slice.
+func Read(input *ReadInput) (interface{}, error) {
+
+	// If input.Type is nil, then use []map[string]string{}.
+	inputType := reflect.TypeOf([]map[string]string{})
+	if input.Type != nil {
+		inputType = input.Type
+	}
+
+	// The iterator requires the type to return for each element,
+	// rather than the type of the array itself.
+	iteratorType := inputType.Elem()
+	if iteratorType.Kind() == reflect.Interface {
+		iteratorType = reflect.TypeOf(map[string]string{})
+	}
+
+	it, errorIterator := NewIterator(&NewIteratorInput{
+		Reader:    input.Reader,
+		Type:      iteratorType,
+		Sheet:     input.Sheet,
+		Header:    input.Header,
+		SkipLines: input.SkipLines,
+		Limit:     input.Limit,
+		Limits:    input.Limits,
+	})
+	if errorIterator != nil {
+		return nil, errors.Wrap(errorIterator, "error creating iterator")
+	}
+	output := reflect.MakeSlice(inputType, 0, 0).Interface()
+	w := pipe.NewSliceWriterWithValues(output)
+	errorRun := pipe.NewBuilder().Input(it).Output(w).Run()
+	return w.Values(), errorRun
+}
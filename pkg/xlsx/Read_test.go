@@ -0,0 +1,23 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	out, err := Read(&ReadInput{
+		Reader: testFile(t),
+		Sheet:  "first",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]string{{"a": "x", "b": "1"}}, out)
+}
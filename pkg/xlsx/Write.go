@@ -0,0 +1,69 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// WriteInput provides the input for the Write function.
+type WriteInput struct {
+	Writer          io.Writer // the underlying writer
+	Sheet           string    // the name of the default sheet.  If blank, then uses DefaultSheet.
+	SheetKey        string    // if not blank, the key of the value used as the name of the sheet for each object
+	Header          []interface{}
+	AutoFilter      bool // add an autofilter to the header row of each sheet
+	KeySerializer   stringify.Stringer
+	ValueSerializer stringify.Stringer
+	Object          interface{} // the object to write
+	Sorted          bool        // sort columns
+	Reversed        bool        // if sorted, sort in reverse alphabetical order.
+	Limit           int         // the maximum number of rows to write, if less than zero, then unlimited.
+}
+
+// Write writes the given object(s) as an Excel workbook.
+// If the type of the input object is of kind Array or Slice, then writes each object as its own row.
+// Otherwise, just writes a sheet with a header and one row for the object.
+func Write(input *WriteInput) error {
+	w := NewWriter(
+		input.Writer,
+		input.Sheet,
+		input.SheetKey,
+		input.Header,
+		input.AutoFilter,
+		input.KeySerializer,
+		input.ValueSerializer,
+		input.Sorted,
+		input.Reversed,
+	)
+	value := reflect.ValueOf(input.Object)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < value.Len() && (input.Limit < 0 || i < input.Limit); i++ {
+			if err := w.WriteObject(value.Index(i).Interface()); err != nil {
+				return errors.Wrapf(err, "error writing object %d", i)
+			}
+		}
+	default:
+		if err := w.WriteObject(input.Object); err != nil {
+			return errors.Wrap(err, "error writing object")
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "error flushing writer")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := Write(&WriteInput{
+		Writer: buf,
+		Sheet:  "Data",
+		Object: []interface{}{
+			map[string]interface{}{"a": "x", "b": 1},
+			map[string]interface{}{"a": "y", "b": 2},
+			map[string]interface{}{"a": "z", "b": 3},
+		},
+		Sorted: true,
+		Limit:  2,
+	})
+	require.NoError(t, err)
+	out, err := Read(&ReadInput{
+		Type:   reflect.TypeOf([]interface{}{}),
+		Reader: buf,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]string{"a": "x", "b": "1"},
+		map[string]string{"a": "y", "b": "2"},
+	}, out)
+}
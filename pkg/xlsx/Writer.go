@@ -0,0 +1,267 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/sv"
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// sheetState is the state of a sheet being written.
+type sheetState struct {
+	name      string
+	header    []interface{}
+	knownKeys map[interface{}]struct{}
+	rows      int // the number of rows written, including the header
+}
+
+// Writer formats and writes objects to the underlying writer as an Excel workbook.
+type Writer struct {
+	underlying      io.Writer
+	file            *excelize.File
+	sheet           string        // the name of the default sheet
+	sheetKey        string        // if not blank, the key of the value used as the name of the sheet
+	columns         []interface{} // the columns of each sheet.  If empty, then created from the objects.
+	autoFilter      bool          // add an autofilter to the header row of each sheet
+	keySerializer   stringify.Stringer
+	valueSerializer stringify.Stringer
+	sorted          bool
+	reversed        bool
+	sheets          []*sheetState
+	sheetsByName    map[string]*sheetState // the sheets by lower case name, since sheet names are case insensitive
+	closed          bool
+}
+
+// NewWriter returns a new Writer for writing objects to an underlying writer as an Excel workbook.
+// If the sheet is blank, then uses DefaultSheet.
+// If the sheet key is not blank, then each object is written to the sheet named by the value of the key,
+// or to the default sheet if the object has no value for the key.
+// If columns are not given, then the header of each sheet is created from the first object and expanded with the keys of later objects.
+// Since a workbook is a zip archive, the rows are cached in memory until the writer is flushed.
+func NewWriter(underlying io.Writer, sheet string, sheetKey string, columns []interface{}, autoFilter bool, keySerializer stringify.Stringer, valueSerializer stringify.Stringer, sorted bool, reversed bool) *Writer {
+
+	if keySerializer == nil {
+		keySerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	if valueSerializer == nil {
+		valueSerializer = number.NewStringer(stringify.NewStringer("", false, false, false), false)
+	}
+
+	return &Writer{
+		underlying:      underlying,
+		file:            excelize.NewFile(),
+		sheet:           sheetName(sheet, DefaultSheet),
+		sheetKey:        sheetKey,
+		columns:         columns,
+		autoFilter:      autoFilter,
+		keySerializer:   keySerializer,
+		valueSerializer: valueSerializer,
+		sorted:          sorted,
+		reversed:        reversed,
+		sheets:          make([]*sheetState, 0),
+		sheetsByName:    map[string]*sheetState{},
+		closed:          false,
+	}
+}
+
+// sheetFor returns the name of the sheet for the object.
+func (w *Writer) sheetFor(objectValue reflect.Value) (string, error) {
+	if len(w.sheetKey) == 0 {
+		return w.sheet, nil
+	}
+	value := field(objectValue, w.sheetKey)
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return w.sheet, nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return w.sheet, nil
+	}
+	str, err := w.valueSerializer(value.Interface())
+	if err != nil {
+		return "", errors.Wrap(err, "error serializing sheet key")
+	}
+	return sheetName(str, w.sheet), nil
+}
+
+// addSheet adds a new sheet to the workbook.
+// The first sheet replaces the default sheet of a new workbook.
+func (w *Writer) addSheet(name string) (*sheetState, error) {
+	if len(w.sheets) == 0 {
+		if name != DefaultSheet {
+			if err := w.file.SetSheetName(DefaultSheet, name); err != nil {
+				return nil, errors.Wrapf(err, "error naming sheet %q", name)
+			}
+		}
+	} else {
+		if _, err := w.file.NewSheet(name); err != nil {
+			return nil, errors.Wrapf(err, "error creating sheet %q", name)
+		}
+	}
+	s := &sheetState{
+		name:      name,
+		header:    w.columns,
+		knownKeys: map[interface{}]struct{}{},
+		rows:      1,
+	}
+	for _, k := range w.columns {
+		s.knownKeys[k] = struct{}{}
+	}
+	w.sheets = append(w.sheets, s)
+	w.sheetsByName[strings.ToLower(name)] = s
+	return s, nil
+}
+
+// WriteObject writes the object as a row of its sheet.
+func (w *Writer) WriteObject(obj interface{}) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	objectValue := reflect.ValueOf(obj)
+	for objectValue.IsValid() && (objectValue.Kind() == reflect.Ptr || objectValue.Kind() == reflect.Interface) {
+		objectValue = objectValue.Elem()
+	}
+	if !objectValue.IsValid() || (objectValue.Kind() != reflect.Map && objectValue.Kind() != reflect.Struct) {
+		return errors.New(fmt.Sprintf("could not write the given value with type %T as a row", obj))
+	}
+
+	name, err := w.sheetFor(objectValue)
+	if err != nil {
+		return err
+	}
+
+	s, ok := w.sheetsByName[strings.ToLower(name)]
+	if !ok {
+		s, err = w.addSheet(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(w.columns) == 0 {
+		s.header, s.knownKeys = sv.ExpandHeader(s.header, s.knownKeys, objectValue, w.sorted, w.reversed)
+	}
+
+	row, err := toCells(objectValue.Interface(), s.header, w.valueSerializer)
+	if err != nil {
+		return errors.Wrap(err, "error converting object to row")
+	}
+
+	cell, err := excelize.CoordinatesToCellName(1, s.rows+1)
+	if err != nil {
+		return errors.Wrap(err, "error creating cell name")
+	}
+	if err := w.file.SetSheetRow(s.name, cell, &row); err != nil {
+		return errors.Wrapf(err, "error writing row %d of sheet %q", s.rows+1, s.name)
+	}
+	s.rows++
+	return nil
+}
+
+// WriteObjects formats and writes the given objects to the underlying writer.
+func (w *Writer) WriteObjects(objects interface{}) error {
+	value := reflect.ValueOf(objects)
+	k := value.Type().Kind()
+	if k == reflect.Ptr {
+		value = value.Elem()
+		k = value.Type().Kind()
+	}
+	if k == reflect.Array || k == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			err := w.WriteObject(value.Index(i).Interface())
+			if err != nil {
+				return errors.Wrap(err, "error writing object")
+			}
+		}
+	}
+	return nil
+}
+
+// writeHeader writes the header row of the sheet, and adds the autofilter, if enabled.
+func (w *Writer) writeHeader(s *sheetState) error {
+	if len(s.header) == 0 {
+		return nil
+	}
+	h, err := stringify.StringifySlice(s.header, w.keySerializer)
+	if err != nil {
+		return errors.Wrap(err, "error stringifying header")
+	}
+	if err := w.file.SetSheetRow(s.name, "A1", &h); err != nil {
+		return errors.Wrapf(err, "error writing header of sheet %q", s.name)
+	}
+	if w.autoFilter {
+		end, err := excelize.CoordinatesToCellName(len(s.header), s.rows)
+		if err != nil {
+			return errors.Wrap(err, "error creating cell name")
+		}
+		if err := w.file.AutoFilter(s.name, "A1:"+end, nil); err != nil {
+			return errors.Wrapf(err, "error adding autofilter to sheet %q", s.name)
+		}
+	}
+	return nil
+}
+
+// Flush writes the header row of each sheet and then writes the workbook to the underlying writer.
+// Since the workbook is a zip archive, no more objects can be written after the writer is flushed.
+// Flush also flushes the underlying writer, if it has a Flush method.
+func (w *Writer) Flush() error {
+	if !w.closed {
+		if len(w.sheets) == 0 && len(w.columns) > 0 {
+			if _, err := w.addSheet(w.sheet); err != nil {
+				return err
+			}
+		} else if len(w.sheets) == 0 && w.sheet != DefaultSheet {
+			if err := w.file.SetSheetName(DefaultSheet, w.sheet); err != nil {
+				return errors.Wrapf(err, "error naming sheet %q", w.sheet)
+			}
+		}
+		for _, s := range w.sheets {
+			if err := w.writeHeader(s); err != nil {
+				return err
+			}
+		}
+		if err := w.file.Write(w.underlying); err != nil {
+			return errors.Wrap(err, "error writing workbook")
+		}
+		if err := w.file.Close(); err != nil {
+			return errors.Wrap(err, "error closing workbook")
+		}
+		w.closed = true
+	}
+	if flusher, ok := w.underlying.(Flusher); ok {
+		err := flusher.Flush()
+		if err != nil {
+			return errors.Wrap(err, "error flushing underlying writer")
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying writer, if it has a Close method.
+func (w *Writer) Close() error {
+	if closer, ok := w.underlying.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "error closing underlying writer")
+		}
+	}
+	return nil
+}
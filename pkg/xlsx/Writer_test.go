@@ -0,0 +1,116 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, "Places", "", nil, true, nil, nil, true, false)
+	require.NoError(t, w.WriteObject(map[string]interface{}{"name": "a", "population": "30", "code": "007"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"name": "b", "population": 1.5, "valid": true}))
+	require.NoError(t, w.Flush())
+	require.Equal(t, ErrClosed, w.WriteObject(map[string]interface{}{"name": "c"}))
+
+	f, err := excelize.OpenReader(buf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Places"}, f.GetSheetList())
+	rows, err := f.GetRows("Places")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"code", "name", "population", "valid"},
+		{"007", "a", "30"},
+		{"", "b", "1.5", "TRUE"},
+	}, rows)
+
+	// Numbers are written as numeric cells, but strings with leading zeros are not.
+	cellType, err := f.GetCellType("Places", "C2")
+	require.NoError(t, err)
+	require.NotEqual(t, excelize.CellTypeSharedString, cellType)
+	cellType, err = f.GetCellType("Places", "A2")
+	require.NoError(t, err)
+	require.Equal(t, excelize.CellTypeSharedString, cellType)
+
+	filter := f.GetDefinedName()
+	require.Len(t, filter, 1)
+	require.Equal(t, "'Places'!$A$1:$D$3", filter[0].RefersTo)
+}
+
+func TestWriterBigNumbers(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, "Sheet1", "", nil, true, nil, nil, true, false)
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	require.NoError(t, w.WriteObject(map[string]interface{}{"a": big.NewInt(42), "b": big.NewFloat(1.5), "c": i}))
+	require.NoError(t, w.Flush())
+
+	f, err := excelize.OpenReader(buf)
+	require.NoError(t, err)
+	rows, err := f.GetRows("Sheet1")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a", "b", "c"}, {"42", "1.5", "92233720368547758070"}}, rows)
+
+	// Big numbers are written as numeric cells, unless they have too many digits to be kept exactly.
+	cellType, err := f.GetCellType("Sheet1", "A2")
+	require.NoError(t, err)
+	require.NotEqual(t, excelize.CellTypeSharedString, cellType)
+	cellType, err = f.GetCellType("Sheet1", "C2")
+	require.NoError(t, err)
+	require.Equal(t, excelize.CellTypeSharedString, cellType)
+}
+
+func TestWriterSheetKey(t *testing.T) {
+	type record struct {
+		Region string
+		Name   string
+		Date   time.Time
+	}
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, "", "region", []interface{}{"name", "region"}, false, nil, nil, false, false)
+	require.NoError(t, w.WriteObject(record{Region: "north", Name: "a"}))
+	require.NoError(t, w.WriteObject(&record{Region: "south", Name: "b"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"region": "North", "name": "c"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"region": "a/b", "name": "d"}))
+	require.NoError(t, w.WriteObject(map[string]interface{}{"name": "e"}))
+	require.NoError(t, w.Flush())
+
+	f, err := excelize.OpenReader(buf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"north", "south", "a_b", "Sheet1"}, f.GetSheetList())
+	rows, err := f.GetRows("north")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"name", "region"}, {"a", "north"}, {"c", "North"}}, rows)
+	rows, err = f.GetRows("Sheet1")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"name", "region"}, {"e"}}, rows)
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf, "Data", "", []interface{}{"a", "b"}, false, nil, nil, false, false)
+	require.NoError(t, w.Flush())
+
+	f, err := excelize.OpenReader(buf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Data"}, f.GetSheetList())
+	rows, err := f.GetRows("Data")
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a", "b"}}, rows)
+}
+
+func TestWriterInvalid(t *testing.T) {
+	w := NewWriter(new(bytes.Buffer), "", "", nil, false, nil, nil, false, false)
+	require.Error(t, w.WriteObject("a"))
+}
@@ -0,0 +1,138 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-stringify/pkg/stringify"
+)
+
+// maxDigits is the number of significant digits Excel keeps for a number.
+// Strings with more digits, such as identifiers, are written as text.
+const maxDigits = 15
+
+// digits returns the number of significant digits of a decimal number.
+func digits(str string) int {
+	mantissa := strings.SplitN(strings.ToLower(str), "e", 2)[0]
+	mantissa = strings.Replace(strings.Replace(mantissa, "-", "", 1), ".", "", 1)
+	return len(strings.Trim(mantissa, "0"))
+}
+
+// numberCell returns the string parsed as a number, if it is a decimal number with no more than maxDigits significant digits,
+// or otherwise the string as is.
+func numberCell(str string) interface{} {
+	if numeric.MatchString(str) && digits(str) <= maxDigits {
+		if f, err := strconv.ParseFloat(str, 64); err == nil {
+			return f
+		}
+	}
+	return str
+}
+
+// field returns the value of the column of the map or struct, or an invalid value if missing.
+// Struct fields are matched without regard to case, as with separated values.
+func field(objectValue reflect.Value, column interface{}) reflect.Value {
+	switch objectValue.Kind() {
+	case reflect.Map:
+		return objectValue.MapIndex(reflect.ValueOf(column))
+	case reflect.Struct:
+		columnLowerCase := strings.ToLower(fmt.Sprint(column))
+		return objectValue.FieldByNameFunc(func(match string) bool { return strings.ToLower(match) == columnLowerCase })
+	}
+	return reflect.Value{}
+}
+
+// cellValue returns the value to write to a cell.
+// Numbers, booleans, and times are returned as is, and strings and big numbers that are decimal numbers are parsed as numbers.
+// Nil values are returned as nil, which leaves the cell empty.
+// Other values are serialized as strings.
+func cellValue(value reflect.Value, valueSerializer stringify.Stringer) (interface{}, error) {
+	if value.IsValid() && value.CanInterface() {
+		// Big numbers are written like strings that are numbers, so numbers with too many digits are written exactly as text.
+		switch v := value.Interface().(type) {
+		case *big.Int:
+			if v != nil {
+				return numberCell(v.String()), nil
+			}
+		case *big.Float:
+			if v != nil && !v.IsInf() {
+				return numberCell(v.Text('g', -1)), nil
+			}
+		}
+	}
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return nil, nil
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		if f := value.Float(); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f, nil
+		}
+	case reflect.String:
+		str := value.String()
+		if n, ok := value.Interface().(json.Number); ok {
+			str = n.String()
+		}
+		return numberCell(str), nil
+	case reflect.Struct:
+		if t, ok := value.Interface().(time.Time); ok {
+			return t, nil
+		}
+	case reflect.Map, reflect.Slice:
+		if value.IsNil() {
+			return nil, nil
+		}
+	}
+	str, err := valueSerializer(value.Interface())
+	if err != nil {
+		return nil, errors.Wrap(err, "error serializing value")
+	}
+	return str, nil
+}
+
+// toCells converts an object into a row of cell values and returns an error, if any.
+func toCells(obj interface{}, columns []interface{}, valueSerializer stringify.Stringer) ([]interface{}, error) {
+	objectValue := reflect.ValueOf(obj)
+	for objectValue.IsValid() && (objectValue.Kind() == reflect.Ptr || objectValue.Kind() == reflect.Interface) {
+		objectValue = objectValue.Elem()
+	}
+	row := make([]interface{}, len(columns))
+	if !objectValue.IsValid() {
+		return row, nil
+	}
+	for i, column := range columns {
+		value, err := cellValue(field(objectValue, column), valueSerializer)
+		if err != nil {
+			return row, errors.Wrapf(err, "error converting column %q", fmt.Sprint(column))
+		}
+		row[i] = value
+	}
+	return row, nil
+}
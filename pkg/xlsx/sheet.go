@@ -0,0 +1,51 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xlsx
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// findSheet returns the name of the sheet with the given name or zero-based index.
+// If the value is blank, then returns the first sheet of the workbook.
+func findSheet(f *excelize.File, value string) (string, error) {
+	sheets := f.GetSheetList()
+	if len(value) == 0 {
+		if len(sheets) == 0 {
+			return "", &ErrUnknownSheet{Value: value}
+		}
+		return sheets[0], nil
+	}
+	for _, sheet := range sheets {
+		if sheet == value {
+			return sheet, nil
+		}
+	}
+	if i, err := strconv.Atoi(value); err == nil && i >= 0 && i < len(sheets) {
+		return sheets[i], nil
+	}
+	return "", &ErrUnknownSheet{Value: value}
+}
+
+// sheetName returns the value as a valid name for a sheet,
+// replacing characters that are not allowed and truncating the name to MaxSheetLength characters.
+// If the value is blank, then returns the fallback.
+func sheetName(value string, fallback string) string {
+	name := strings.Trim(sheetReplacer.Replace(value), "'")
+	if utf8.RuneCountInString(name) > MaxSheetLength {
+		name = string([]rune(name)[:MaxSheetLength])
+	}
+	if len(strings.TrimSpace(name)) == 0 {
+		return fallback
+	}
+	return name
+}
@@ -0,0 +1,59 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package xlsx provides a simple API for reading and writing Excel workbooks in the Office Open XML (XLSX) format.
+// xlsx also supports iterators and writers for efficiently processing a stream.
+// This package wraps the xuri excelize package.
+//
+// When reading, a single sheet is read, selected by name or by zero-based index.
+// Like separated values, the first row of the sheet is used as the header, unless the header is given.
+// Each cell is returned as a string formatted with the number format of the cell.
+//
+// When writing, each sheet starts with a header row.
+// Numbers, including strings and big numbers that are decimal numbers, are written as numeric cells,
+// and booleans and times are written as boolean and date cells.
+// If a sheet key is given, then each object is written to the sheet named by the value of that key,
+// so a single stream can be split into multiple sheets.
+// Since a workbook is a zip archive, the workbook is written to the underlying writer when the writer is flushed.
+//
+// References:
+//	- https://docs.microsoft.com/en-us/openspecs/office_standards/ms-xlsx/
+//	- https://godoc.org/github.com/xuri/excelize/v2
+//
+package xlsx
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultSheet   = "Sheet1" // the name of the default sheet of a new workbook
+	MaxSheetLength = 31       // the maximum number of characters in the name of a sheet
+)
+
+var (
+	ErrClosed = errors.New("writer is closed")
+)
+
+var (
+	// numeric matches decimal numbers without leading zeros, which are written as numeric cells.
+	numeric = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+	// sheetReplacer replaces the characters that are not allowed in the name of a sheet.
+	sheetReplacer = strings.NewReplacer(
+		":", "_",
+		"\\", "_",
+		"/", "_",
+		"?", "_",
+		"*", "_",
+		"[", "_",
+		"]", "_",
+	)
+)
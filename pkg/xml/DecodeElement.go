@@ -0,0 +1,73 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DecodeElement decodes the element that begins with the given start element from the decoder,
+// reading up to and including the matching end element.
+// Returns the text content of the element as a string if the element has no attributes or child elements.
+// Otherwise, returns a map of type map[string]interface{}.
+// Element and attribute names keep their namespace prefixes, e.g., "x:item" and "@xmlns:x",
+// but only the namespaces declared by the element and its children are known.
+func DecodeElement(d *stdxml.Decoder, start stdxml.StartElement) (interface{}, error) {
+	ns := &namespaces{}
+	ns.push(start.Attr)
+	return decodeElement(d, start, ns)
+}
+
+// decodeElement decodes the element like DecodeElement, using the namespaces that are in scope.
+// The namespaces declared by the start element must already be pushed.
+func decodeElement(d *stdxml.Decoder, start stdxml.StartElement, ns *namespaces) (interface{}, error) {
+	m := map[string]interface{}{}
+	for _, a := range start.Attr {
+		m[AttributePrefix+ns.name(a.Name)] = a.Value
+	}
+	text := new(strings.Builder)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding element %q", ns.name(start.Name))
+		}
+		switch t := token.(type) {
+		case stdxml.StartElement:
+			ns.push(t.Attr)
+			name := ns.name(t.Name)
+			child, err := decodeElement(d, t, ns)
+			ns.pop()
+			if err != nil {
+				return nil, err
+			}
+			if existing, ok := m[name]; ok {
+				if children, ok := existing.([]interface{}); ok {
+					m[name] = append(children, child)
+				} else {
+					m[name] = []interface{}{existing, child}
+				}
+			} else {
+				m[name] = child
+			}
+		case stdxml.CharData:
+			text.Write(t)
+		case stdxml.EndElement:
+			str := strings.TrimSpace(text.String())
+			if len(m) == 0 {
+				return str, nil
+			}
+			if len(str) > 0 {
+				m[TextKey] = str
+			}
+			return m, nil
+		}
+	}
+}
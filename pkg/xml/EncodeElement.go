@@ -0,0 +1,115 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// EncodeElement encodes the given value as an element with the given name.
+// Slices and arrays, except for byte slices, are encoded as repeated elements with the same name.
+// Maps are encoded using the convention described in the package documentation, with keys in alphabetical order.
+// Nil values are encoded as empty elements.
+// All other values are encoded as text using the default format for the value.
+// If the name of an element or attribute is not a valid XML name, then returns ErrInvalidName.
+func EncodeElement(e *stdxml.Encoder, name string, value interface{}) error {
+	if !isName(name) {
+		return &ErrInvalidName{Value: name}
+	}
+	if value == nil {
+		return encodeText(e, name, "")
+	}
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return encodeText(e, name, "")
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		if b, ok := v.Interface().([]byte); ok {
+			return encodeText(e, name, string(b))
+		}
+		for i := 0; i < v.Len(); i++ {
+			err := EncodeElement(e, name, v.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		attrs := make([]stdxml.Attr, 0)
+		text := ""
+		keys := make([]string, 0)
+		values := map[string]interface{}{}
+		for _, k := range v.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			value := v.MapIndex(k).Interface()
+			if key == TextKey {
+				text = fmt.Sprint(value)
+			} else if strings.HasPrefix(key, AttributePrefix) {
+				if !isName(key[len(AttributePrefix):]) {
+					return &ErrInvalidName{Value: key[len(AttributePrefix):]}
+				}
+				attrs = append(attrs, stdxml.Attr{Name: stdxml.Name{Local: key[len(AttributePrefix):]}, Value: fmt.Sprint(value)})
+			} else {
+				keys = append(keys, key)
+				values[key] = value
+			}
+		}
+		sort.Slice(attrs, func(i, j int) bool {
+			return attrs[i].Name.Local < attrs[j].Name.Local
+		})
+		sort.Strings(keys)
+		start := stdxml.StartElement{Name: stdxml.Name{Local: name}, Attr: attrs}
+		if err := e.EncodeToken(start); err != nil {
+			return errors.Wrapf(err, "error encoding element %q", name)
+		}
+		if len(text) > 0 {
+			if err := e.EncodeToken(stdxml.CharData(text)); err != nil {
+				return errors.Wrapf(err, "error encoding text of element %q", name)
+			}
+		}
+		for _, key := range keys {
+			if err := EncodeElement(e, key, values[key]); err != nil {
+				return err
+			}
+		}
+		if err := e.EncodeToken(start.End()); err != nil {
+			return errors.Wrapf(err, "error encoding element %q", name)
+		}
+		return nil
+	case reflect.Struct:
+		return e.EncodeElement(v.Interface(), stdxml.StartElement{Name: stdxml.Name{Local: name}})
+	}
+	return encodeText(e, name, fmt.Sprint(v.Interface()))
+}
+
+// encodeText encodes an element with the given name and text.
+func encodeText(e *stdxml.Encoder, name string, text string) error {
+	start := stdxml.StartElement{Name: stdxml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return errors.Wrapf(err, "error encoding element %q", name)
+	}
+	if len(text) > 0 {
+		if err := e.EncodeToken(stdxml.CharData(text)); err != nil {
+			return errors.Wrapf(err, "error encoding text of element %q", name)
+		}
+	}
+	if err := e.EncodeToken(start.End()); err != nil {
+		return errors.Wrapf(err, "error encoding element %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"fmt"
+)
+
+// ErrInvalidName is returned when a key cannot be encoded as the name of an element or attribute.
+type ErrInvalidName struct {
+	Value string
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidName) Error() string {
+	return fmt.Sprintf("invalid name %q, expecting a name that starts with a letter or underscore, e.g., \"item\" or \"x:item\"", e.Value)
+}
@@ -0,0 +1,22 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"fmt"
+)
+
+// ErrInvalidPath is returned when the path to the elements to iterate over is invalid.
+type ErrInvalidPath struct {
+	Path string
+}
+
+// Error returns the error formatted as a string.
+func (e ErrInvalidPath) Error() string {
+	return fmt.Sprintf("invalid path %q, expecting a path of element names starting with \"/\", e.g., \"/catalog/book\"", e.Path)
+}
@@ -0,0 +1,126 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// Iterator iterates trough a stream of XML
+// returning each element that matches the path on each call of Next()
+// until it reaches the end and returns io.EOF.
+type Iterator struct {
+	Decoder *stdxml.Decoder // the decoder that tokenizes the underlying stream of bytes
+	Path    []string        // the qualified names of the elements in the path, starting with the root element
+	Stack   []string        // the qualified names of the currently open elements
+	Limit   int             // Limit the number of objects to read and return from the underlying stream.
+	Count   int             // The current count of the number of objects read.
+	Limits  limits.Limits   // The maximum depth and number of keys of each object.
+	ns      *namespaces     // the namespaces declared by the currently open elements
+}
+
+// NewIteratorInput provides the input parameters for the NewIterator function.
+type NewIteratorInput struct {
+	Reader io.Reader
+	Path   string        // The path to the elements to return, e.g., "/catalog/book" or "/x:catalog/x:book".  If empty, then returns the root element as a map with its name as the only key.
+	Limit  int           // Limit the number of objects to read and return from the underlying stream.
+	Limits limits.Limits // The maximum depth and number of keys of each object.  Only MaxDepth and MaxKeys are used.
+}
+
+// NewIterator returns a new XML iterator base on the given input.
+// If the path is not empty and does not start with "/", then returns ErrInvalidPath.
+func NewIterator(input *NewIteratorInput) (*Iterator, error) {
+	path := make([]string, 0)
+	if len(input.Path) > 0 && input.Path != "/" {
+		if !strings.HasPrefix(input.Path, "/") {
+			return nil, &ErrInvalidPath{Path: input.Path}
+		}
+		path = strings.Split(input.Path[1:], "/")
+		for _, name := range path {
+			if len(name) == 0 {
+				return nil, &ErrInvalidPath{Path: input.Path}
+			}
+		}
+	}
+	return &Iterator{
+		Decoder: stdxml.NewDecoder(input.Reader),
+		Path:    path,
+		Stack:   make([]string, 0),
+		Limit:   input.Limit,
+		Count:   0,
+		Limits:  input.Limits,
+		ns:      &namespaces{},
+	}, nil
+}
+
+// matches returns true if the currently open elements and the given element match the path.
+func (it *Iterator) matches(name string) bool {
+	if len(it.Stack)+1 != len(it.Path) {
+		return false
+	}
+	for i, s := range it.Stack {
+		if s != it.Path[i] {
+			return false
+		}
+	}
+	return name == it.Path[len(it.Path)-1]
+}
+
+// Next reads from the underlying reader and returns the next matching element and error, if any.
+// When the input stream is exhausted, returns (nil, io.EOF).
+func (it *Iterator) Next() (interface{}, error) {
+
+	// If reached limit, return io.EOF
+	if it.Limit > 0 && it.Count >= it.Limit {
+		return nil, io.EOF
+	}
+
+	for {
+		token, err := it.Decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			return nil, errors.Wrap(err, "error decoding XML")
+		}
+		switch t := token.(type) {
+		case stdxml.StartElement:
+			it.ns.push(t.Attr)
+			name := it.ns.name(t.Name)
+			if len(it.Path) == 0 || it.matches(name) {
+				// Increment Counter
+				it.Count++
+				value, err := decodeElement(it.Decoder, t, it.ns)
+				it.ns.pop()
+				if err != nil {
+					return nil, errors.Wrapf(err, "error decoding XML element %d", it.Count)
+				}
+				var obj interface{} = value
+				if len(it.Path) == 0 {
+					obj = map[string]interface{}{name: value}
+				}
+				if err := it.Limits.Check(obj); err != nil {
+					return nil, errors.Wrapf(err, "error checking limits of XML element %d", it.Count)
+				}
+				return obj, nil
+			}
+			it.Stack = append(it.Stack, name)
+		case stdxml.EndElement:
+			if len(it.Stack) > 0 {
+				it.Stack = it.Stack[:len(it.Stack)-1]
+			}
+			it.ns.pop()
+		}
+	}
+}
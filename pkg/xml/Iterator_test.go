@@ -0,0 +1,132 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+const testCatalog = `<?xml version="1.0"?>
+<catalog>
+  <book id="1"><title>Go</title></book>
+  <magazine><book id="x"/></magazine>
+  <book id="2"><title>XML</title></book>
+</catalog>`
+
+func TestIterator(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(testCatalog),
+		Path:   "/catalog/book",
+		Limit:  -1,
+	})
+	require.NoError(t, err)
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"@id": "1", "title": "Go"}, obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"@id": "2", "title": "XML"}, obj)
+
+	// Should return io.EOF to indicate the reader is finished
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+
+	// Should still return io.EOF to indicate the reader is finished
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorRoot(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader("<a><b>1</b></a>"),
+		Limit:  -1,
+	})
+	require.NoError(t, err)
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": "1"}}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorNamespaces(t *testing.T) {
+	in := `<x:catalog xmlns:x="urn:x"><x:book id="1"/><book id="2"/><x:book x:id="3"/></x:catalog>`
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(in),
+		Path:   "/x:catalog/x:book",
+		Limit:  -1,
+	})
+	require.NoError(t, err)
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"@id": "1"}, obj)
+
+	obj, err = it.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"@x:id": "3"}, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimit(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(testCatalog),
+		Path:   "/catalog/book",
+		Limit:  1,
+	})
+	require.NoError(t, err)
+
+	obj, err := it.Next()
+	require.NoError(t, err)
+	require.NotNil(t, obj)
+
+	obj, err = it.Next()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, obj)
+}
+
+func TestIteratorLimits(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(testCatalog),
+		Path:   "/catalog/book",
+		Limit:  -1,
+		Limits: limits.Limits{MaxKeys: 1},
+	})
+	require.NoError(t, err)
+
+	obj, err := it.Next()
+	require.Equal(t, &limits.ErrMaxKeys{Max: 1}, errors.Cause(err))
+	require.Nil(t, obj)
+}
+
+func TestIteratorInvalidPath(t *testing.T) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: strings.NewReader(testCatalog),
+		Path:   "catalog/book",
+	})
+	assert.Equal(t, &ErrInvalidPath{Path: "catalog/book"}, err)
+	assert.Nil(t, it)
+}
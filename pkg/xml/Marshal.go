@@ -0,0 +1,68 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"bytes"
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Marshal formats an object into a slice of bytes of XML.
+// Structs are marshaled using the standard library.
+// A map with a single key is marshaled as a root element with the key as its name.
+// Other maps are marshaled as a root element named DefaultRootName.
+// Slices and arrays are marshaled as a root element named DefaultRootName with a child element named DefaultItemName for each item.
+// If the pretty parameter is set, then prints pretty output with an indent.
+func Marshal(obj interface{}, pretty bool) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	e := stdxml.NewEncoder(buf)
+	if pretty {
+		e.Indent(prefix, indent)
+	}
+
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			break
+		}
+		v = v.Elem()
+	}
+
+	var err error
+	switch v.Kind() {
+	case reflect.Struct:
+		err = e.Encode(v.Interface())
+	case reflect.Map:
+		if v.Len() == 1 {
+			k := v.MapKeys()[0]
+			if name, ok := k.Interface().(string); ok && len(name) > 0 && name != TextKey && !strings.HasPrefix(name, AttributePrefix) {
+				err = EncodeElement(e, name, v.MapIndex(k).Interface())
+				break
+			}
+		}
+		err = EncodeElement(e, DefaultRootName, v.Interface())
+	case reflect.Array, reflect.Slice:
+		err = EncodeElement(e, DefaultRootName, map[string]interface{}{DefaultItemName: v.Interface()})
+	default:
+		err = EncodeElement(e, DefaultRootName, obj)
+	}
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, "error marshaling XML")
+	}
+
+	err = e.Flush()
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, "error marshaling XML")
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,94 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalMap(t *testing.T) {
+	in := map[string]interface{}{
+		"catalog": map[string]interface{}{
+			"book": []interface{}{
+				map[string]interface{}{
+					"@id":    "1",
+					"title":  "Go",
+					"author": []interface{}{"A", "B"},
+				},
+				map[string]interface{}{
+					"@id": 2,
+					"title": map[string]interface{}{
+						"@edition": "2",
+						"#text":    "XML & more",
+					},
+					"note": nil,
+				},
+			},
+		},
+	}
+	b, err := Marshal(in, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "<catalog><book id=\"1\"><author>A</author><author>B</author><title>Go</title></book><book id=\"2\"><note></note><title edition=\"2\">XML &amp; more</title></book></catalog>", string(b))
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	in := map[string]interface{}{
+		"a": map[string]interface{}{
+			"@x": "1",
+			"b":  []interface{}{"2", "3"},
+			"c":  map[string]interface{}{"#text": "4", "@y": "5"},
+		},
+	}
+	b, err := Marshal(in, true)
+	assert.NoError(t, err)
+	out, err := Unmarshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestMarshalMapWithoutRoot(t *testing.T) {
+	in := map[string]interface{}{"a": "1", "b": "2"}
+	b, err := Marshal(in, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "<root><a>1</a><b>2</b></root>", string(b))
+}
+
+func TestMarshalInvalidName(t *testing.T) {
+	for _, name := range []string{"a b", "1x", "<", "@"} {
+		b, err := Marshal(map[string]interface{}{"root": map[string]interface{}{name: 1}}, false)
+		assert.IsType(t, &ErrInvalidName{}, errors.Cause(err))
+		assert.Empty(t, b)
+	}
+	b, err := Marshal(map[string]interface{}{"x:item": map[string]interface{}{"@x:lang": "en", "name_1.a-b": "1"}}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, `<x:item x:lang="en"><name_1.a-b>1</name_1.a-b></x:item>`, string(b))
+}
+
+func TestMarshalSlice(t *testing.T) {
+	in := []map[string]string{
+		map[string]string{"a": "1"},
+		map[string]string{"a": "2"},
+	}
+	b, err := Marshal(in, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "<root>\n  <item>\n    <a>1</a>\n  </item>\n  <item>\n    <a>2</a>\n  </item>\n</root>", string(b))
+}
+
+func TestMarshalStruct(t *testing.T) {
+	in := struct {
+		XMLName struct{} `xml:"book"`
+		ID      string   `xml:"id,attr"`
+		Title   string   `xml:"title"`
+	}{ID: "1", Title: "Go"}
+	b, err := Marshal(in, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "<book id=\"1\"><title>Go</title></book>", string(b))
+}
@@ -0,0 +1,41 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/spatialcurrent/go-pipe/pkg/pipe"
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// ReadInput provides the input parameters for the Read function.
+type ReadInput struct {
+	Reader io.Reader // the underlying reader
+	Path   string    // the path to the elements to read, e.g., "/catalog/book"
+	Limit  int
+	Limits limits.Limits // the maximum depth and number of keys of each element
+}
+
+// Read reads the elements that match the path from the input reader and returns them as a slice of type []interface{}.
+func Read(input *ReadInput) (interface{}, error) {
+	it, err := NewIterator(&NewIteratorInput{
+		Reader: input.Reader,
+		Path:   input.Path,
+		Limit:  input.Limit,
+		Limits: input.Limits,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating iterator")
+	}
+	w := pipe.NewSliceWriterWithValues([]interface{}{})
+	err = pipe.NewBuilder().Input(it).Output(w).Run()
+	return w.Values(), err
+}
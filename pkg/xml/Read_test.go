@@ -0,0 +1,28 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	obj, err := Read(&ReadInput{
+		Reader: strings.NewReader(testCatalog),
+		Path:   "/catalog/book",
+		Limit:  -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"@id": "1", "title": "Go"},
+		map[string]interface{}{"@id": "2", "title": "XML"},
+	}, obj)
+}
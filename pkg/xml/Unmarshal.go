@@ -0,0 +1,46 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"bytes"
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Unmarshal parses a slice of bytes of XML into a map with the name of the root element as the only key.
+// See the package documentation for how elements are decoded.
+// If no input is given, then returns ErrEmptyInput.
+func Unmarshal(b []byte) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	d := stdxml.NewDecoder(bytes.NewReader(b))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, ErrMissingRoot
+			}
+			return nil, errors.Wrap(err, "error unmarshaling XML")
+		}
+		if start, ok := token.(stdxml.StartElement); ok {
+			ns := &namespaces{}
+			ns.push(start.Attr)
+			value, err := decodeElement(d, start, ns)
+			if err != nil {
+				return nil, errors.Wrap(err, "error unmarshaling XML")
+			}
+			return map[string]interface{}{ns.name(start.Name): value}, nil
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// UnmarshalType parses a slice of bytes of XML into an object of a given type.
+// Structs are unmarshaled using the standard library.
+// Maps must have string keys and interface values, e.g., map[string]interface{}.
+// If no input is given, then returns ErrEmptyInput.
+func UnmarshalType(b []byte, outputType reflect.Type) (interface{}, error) {
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	switch outputType.Kind() {
+	case reflect.Struct:
+		ptr := reflect.New(outputType)
+		err := stdxml.Unmarshal(b, ptr.Interface())
+		if err != nil {
+			return nil, errors.Wrap(err, "error unmarshaling XML")
+		}
+		return ptr.Elem().Interface(), nil
+	case reflect.Map:
+		if DefaultType.ConvertibleTo(outputType) {
+			obj, err := Unmarshal(b)
+			if err != nil {
+				return nil, err
+			}
+			return reflect.ValueOf(obj).Convert(outputType).Interface(), nil
+		}
+	}
+
+	return nil, &ErrInvalidKind{Value: outputType, Expected: []reflect.Kind{reflect.Map, reflect.Struct}}
+}
@@ -0,0 +1,46 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTypeEmpty(t *testing.T) {
+	obj, err := UnmarshalType([]byte{}, DefaultType)
+	assert.Equal(t, err, ErrEmptyInput)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshalTypeMapStringInterface(t *testing.T) {
+	in := "<a x=\"1\"><b>2</b></a>"
+	expected := map[string]interface{}{"a": map[string]interface{}{"@x": "1", "b": "2"}}
+	obj, err := UnmarshalType([]byte(in), reflect.TypeOf(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalTypeStruct(t *testing.T) {
+	type book struct {
+		ID    string `xml:"id,attr"`
+		Title string `xml:"title"`
+	}
+	in := "<book id=\"1\"><title>Go</title></book>"
+	obj, err := UnmarshalType([]byte(in), reflect.TypeOf(book{}))
+	assert.NoError(t, err)
+	assert.Equal(t, book{ID: "1", Title: "Go"}, obj)
+}
+
+func TestUnmarshalTypeInvalidKind(t *testing.T) {
+	obj, err := UnmarshalType([]byte("<a/>"), reflect.TypeOf(map[string]string{}))
+	assert.IsType(t, err, &ErrInvalidKind{})
+	assert.Nil(t, obj)
+}
@@ -0,0 +1,96 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalEmpty(t *testing.T) {
+	obj, err := Unmarshal([]byte{})
+	assert.Equal(t, err, ErrEmptyInput)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshalMissingRoot(t *testing.T) {
+	obj, err := Unmarshal([]byte("<?xml version=\"1.0\"?>\n"))
+	assert.Equal(t, err, ErrMissingRoot)
+	assert.Equal(t, obj, nil)
+}
+
+func TestUnmarshal(t *testing.T) {
+	in := `<?xml version="1.0"?>
+<catalog>
+  <!-- books -->
+  <book id="1" lang="en">
+    <title>Go</title>
+    <author>A</author>
+    <author>B</author>
+  </book>
+  <book id="2">
+    <title edition="2">XML</title>
+    <note/>
+  </book>
+</catalog>`
+	expected := map[string]interface{}{
+		"catalog": map[string]interface{}{
+			"book": []interface{}{
+				map[string]interface{}{
+					"@id":    "1",
+					"@lang":  "en",
+					"title":  "Go",
+					"author": []interface{}{"A", "B"},
+				},
+				map[string]interface{}{
+					"@id": "2",
+					"title": map[string]interface{}{
+						"@edition": "2",
+						"#text":    "XML",
+					},
+					"note": "",
+				},
+			},
+		},
+	}
+	obj, err := Unmarshal([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalNamespaces(t *testing.T) {
+	in := `<feed xmlns="urn:feed" xmlns:x="urn:x">
+  <entry lang="en" x:lang="fr" xml:lang="de">
+    <title>A</title>
+    <x:title>B</x:title>
+  </entry>
+</feed>`
+	expected := map[string]interface{}{
+		"feed": map[string]interface{}{
+			"@xmlns":   "urn:feed",
+			"@xmlns:x": "urn:x",
+			"entry": map[string]interface{}{
+				"@lang":     "en",
+				"@x:lang":   "fr",
+				"@xml:lang": "de",
+				"title":     "A",
+				"x:title":   "B",
+			},
+		},
+	}
+	obj, err := Unmarshal([]byte(in))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, obj)
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	obj, err := Unmarshal([]byte("<a><b></a>"))
+	assert.Error(t, err)
+	assert.Nil(t, obj)
+}
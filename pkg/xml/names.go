@@ -0,0 +1,42 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	"unicode"
+)
+
+// isNameStart returns true if the rune can start an XML name.
+func isNameStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == ':'
+}
+
+// isNameChar returns true if the rune can be part of an XML name after the first rune.
+func isNameChar(r rune) bool {
+	return isNameStart(r) || unicode.IsDigit(r) || r == '-' || r == '.' || r == '·' || unicode.In(r, unicode.Mn, unicode.Mc)
+}
+
+// isName returns true if the string is a valid XML name, including qualified names with a namespace prefix, e.g., "x:item".
+//
+// References:
+//	- https://www.w3.org/TR/xml/#NT-Name
+func isName(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for i, r := range name {
+		if i == 0 {
+			if !isNameStart(r) {
+				return false
+			}
+		} else if !isNameChar(r) {
+			return false
+		}
+	}
+	return true
+}
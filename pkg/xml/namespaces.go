@@ -0,0 +1,69 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package xml
+
+import (
+	stdxml "encoding/xml" // import the standard xml library as stdxml
+)
+
+const (
+	xmlnsPrefix  = "xmlns"
+	xmlPrefix    = "xml"
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+)
+
+// namespaces is a stack of the namespace prefixes declared by the currently open elements.
+// The decoder replaces the prefix of each name with the URL of its namespace,
+// so the prefixes are restored by looking up the URL in the declarations that are in scope.
+type namespaces struct {
+	scopes []map[string]string // the prefix of each namespace URL declared by each open element
+}
+
+// push adds the namespaces declared by the attributes of an element that was opened.
+func (ns *namespaces) push(attrs []stdxml.Attr) {
+	scope := map[string]string{}
+	for _, a := range attrs {
+		switch {
+		case a.Name.Space == xmlnsPrefix:
+			scope[a.Value] = a.Name.Local
+		case a.Name.Space == "" && a.Name.Local == xmlnsPrefix:
+			scope[a.Value] = ""
+		}
+	}
+	ns.scopes = append(ns.scopes, scope)
+}
+
+// pop removes the namespaces declared by the element that was closed.
+func (ns *namespaces) pop() {
+	if len(ns.scopes) > 0 {
+		ns.scopes = ns.scopes[:len(ns.scopes)-1]
+	}
+}
+
+// name returns the qualified name with its namespace prefix, e.g., "x:lang".
+// Names in the default namespace are returned without a prefix.
+func (ns *namespaces) name(n stdxml.Name) string {
+	switch n.Space {
+	case "":
+		return n.Local
+	case xmlnsPrefix:
+		return xmlnsPrefix + ":" + n.Local
+	case xmlNamespace:
+		return xmlPrefix + ":" + n.Local
+	}
+	for i := len(ns.scopes) - 1; i >= 0; i-- {
+		if prefix, ok := ns.scopes[i][n.Space]; ok {
+			if len(prefix) == 0 {
+				return n.Local
+			}
+			return prefix + ":" + n.Local
+		}
+	}
+	// The prefix was not declared, so the decoder left the prefix as is.
+	return n.Space + ":" + n.Local
+}
@@ -0,0 +1,45 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+// Package xml provides an API for XML serialization of maps and structs.
+// Structs are marshaled and unmarshaled using the standard library encoding/xml package.
+// Elements are decoded into maps using the following convention.
+//	- Attributes are stored with the key "@name".
+//	- Text content is stored with the key "#text".  Whitespace around the text is trimmed.
+//	- Child elements are stored by their name.  If an element has more than one child with the same name, then the children are stored as a slice.
+//	- An element without attributes or child elements is decoded as its text content.
+//	- Element and attribute names keep their namespace prefixes, e.g., "x:item" and "@xmlns:x".  Names in the default namespace have no prefix.
+//
+// Maps are encoded using the same convention.
+package xml
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	prefix = ""
+	indent = "  "
+)
+
+const (
+	AttributePrefix = "@"     // the prefix of keys for attributes
+	TextKey         = "#text" // the key for the text content of an element
+	DefaultRootName = "root"  // the name of the root element when marshaling a map without a single key or a slice
+	DefaultItemName = "item"  // the name of the elements for each item when marshaling a slice
+)
+
+var (
+	DefaultType = reflect.TypeOf(map[string]interface{}{})
+)
+
+var (
+	ErrEmptyInput  = errors.New("empty input")
+	ErrMissingRoot = errors.New("missing root element")
+)
@@ -0,0 +1,112 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3" // import the YAML node library from https://github.com/go-yaml/yaml/tree/v3
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+// CheckLimits parses the YAML document without expanding aliases and returns an error
+// if the expanded document would exceed the maximum record size, nesting depth, number of keys in a mapping, or number of alias expansions.
+// Since aliases are counted as if they were expanded, CheckLimits protects against alias bombs.
+func CheckLimits(b []byte, l limits.Limits) error {
+	if err := l.CheckRecordBytes(len(b)); err != nil {
+		return err
+	}
+	if l.MaxDepth == 0 && l.MaxKeys == 0 && l.MaxAliases == 0 {
+		return nil
+	}
+	d := yamlv3.NewDecoder(bytes.NewReader(b))
+	for {
+		node := &yamlv3.Node{}
+		err := d.Decode(node)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errors.Wrap(err, "invalid YAML")
+		}
+		m := &measurer{limits: l, measurements: map[*yamlv3.Node]*measurement{}}
+		_, err = m.measure(node)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxAliases caps the number of aliases counted, so that the count cannot overflow.
+const maxAliases = 1 << 30
+
+// measurement is the depth and number of alias expansions of a node, as if its aliases were expanded.
+type measurement struct {
+	depth   int
+	aliases int
+}
+
+type measurer struct {
+	limits       limits.Limits
+	measurements map[*yamlv3.Node]*measurement // nil while the node is being measured
+}
+
+func (m *measurer) measure(node *yamlv3.Node) (*measurement, error) {
+	if x, ok := m.measurements[node]; ok {
+		if x == nil {
+			// The node contains an alias to itself, so it cannot be expanded.
+			return nil, &limits.ErrMaxAliases{Max: m.limits.MaxAliases}
+		}
+		return x, nil
+	}
+	m.measurements[node] = nil
+	x := &measurement{}
+	switch node.Kind {
+	case yamlv3.AliasNode:
+		target, err := m.measure(node.Alias)
+		if err != nil {
+			return nil, err
+		}
+		x.depth = target.depth
+		x.aliases = target.aliases + 1
+	case yamlv3.DocumentNode, yamlv3.MappingNode, yamlv3.SequenceNode:
+		if node.Kind == yamlv3.MappingNode {
+			if err := m.limits.CheckKeys(len(node.Content) / 2); err != nil {
+				return nil, errors.Wrapf(err, "error checking mapping at line %d, column %d", node.Line, node.Column)
+			}
+		}
+		for _, child := range node.Content {
+			y, err := m.measure(child)
+			if err != nil {
+				return nil, err
+			}
+			if y.depth > x.depth {
+				x.depth = y.depth
+			}
+			x.aliases += y.aliases
+			if x.aliases > maxAliases {
+				x.aliases = maxAliases
+			}
+		}
+		if node.Kind != yamlv3.DocumentNode {
+			x.depth++
+		}
+	}
+	if err := m.limits.CheckDepth(x.depth); err != nil {
+		return nil, errors.Wrapf(err, "error checking node at line %d, column %d", node.Line, node.Column)
+	}
+	if err := m.limits.CheckAliases(x.aliases); err != nil {
+		return nil, err
+	}
+	m.measurements[node] = x
+	return x, nil
+}
@@ -0,0 +1,38 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/limits"
+)
+
+func TestCheckLimits(t *testing.T) {
+	in := []byte("a:\n  - b: c\nd: e\n")
+	assert.NoError(t, CheckLimits(in, limits.Limits{}))
+	assert.NoError(t, CheckLimits(in, limits.Limits{MaxDepth: 3, MaxKeys: 2}))
+	assert.IsType(t, &limits.ErrMaxDepth{}, errors.Cause(CheckLimits(in, limits.Limits{MaxDepth: 2})))
+	assert.IsType(t, &limits.ErrMaxKeys{}, errors.Cause(CheckLimits(in, limits.Limits{MaxKeys: 1})))
+}
+
+func TestCheckLimitsAliases(t *testing.T) {
+	in := []byte(`a: &a ["x", "x", "x", "x"]
+b: &b [*a, *a, *a, *a]
+c: &c [*b, *b, *b, *b]
+d: &d [*c, *c, *c, *c]
+`)
+	// d expands 4 aliases of c, 16 of b, and 64 of a.
+	assert.NoError(t, CheckLimits(in, limits.Limits{MaxAliases: 4 + 16 + 64 + 4 + 16 + 4, MaxDepth: 5}))
+	assert.Equal(t, &limits.ErrMaxAliases{Max: 100}, CheckLimits(in, limits.Limits{MaxAliases: 100}))
+	assert.IsType(t, &limits.ErrMaxDepth{}, errors.Cause(CheckLimits(in, limits.Limits{MaxDepth: 4})))
+	assert.Equal(t, &limits.ErrMaxAliases{Max: 10}, CheckLimits([]byte("a: &a [*a]\n"), limits.Limits{MaxAliases: 10}))
+}
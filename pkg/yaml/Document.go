@@ -0,0 +1,390 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3" // import the YAML node library from https://github.com/go-yaml/yaml/tree/v3
+)
+
+const (
+	// DefaultIndent is the indent used when writing a document whose indent could not be detected.
+	DefaultIndent = 2
+)
+
+// Document is a YAML document that can be edited by key path and written back
+// without losing comments, anchors, aliases, key order, or scalar styles.
+// Paths are slices of keys.  A key that is an integer indexes into a sequence.
+// Indentation is normalized to the document's detected indent when written,
+// and blank lines separating entries in the original document are kept.
+type Document struct {
+	node   *yamlv3.Node          // the document node
+	indent int                   // the indent to use when writing the document
+	blank  map[*yamlv3.Node]bool // the nodes that were preceded by a blank line
+}
+
+// NewDocument parses the given YAML bytes into a Document.
+// If the input is empty, then returns a Document with an empty mapping.
+func NewDocument(b []byte) (*Document, error) {
+	node := &yamlv3.Node{}
+	err := yamlv3.Unmarshal(b, node)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing YAML document")
+	}
+	if node.Kind == 0 {
+		node = &yamlv3.Node{
+			Kind:    yamlv3.DocumentNode,
+			Content: []*yamlv3.Node{&yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}},
+		}
+	}
+	blank := map[*yamlv3.Node]bool{}
+	markBlankLines(node, bytes.Split(b, []byte("\n")), blank)
+	return &Document{node: node, indent: detectIndent(b), blank: blank}, nil
+}
+
+// firstLine returns the line number of the first line of the node, including its head comment.
+func firstLine(node *yamlv3.Node) int {
+	if node.HeadComment == "" {
+		return node.Line
+	}
+	return node.Line - (strings.Count(node.HeadComment, "\n") + 1)
+}
+
+// markBlankLines marks the mapping keys and sequence items that are preceded by a blank line.
+func markBlankLines(node *yamlv3.Node, lines [][]byte, blank map[*yamlv3.Node]bool) {
+	step := 1
+	if node.Kind == yamlv3.MappingNode {
+		step = 2
+	}
+	for i, child := range node.Content {
+		if i%step == 0 {
+			if line := firstLine(child); line > 1 && line-2 < len(lines) && len(bytes.TrimSpace(lines[line-2])) == 0 {
+				blank[child] = true
+			}
+		}
+		markBlankLines(child, lines, blank)
+	}
+}
+
+// detectIndent returns the indent of the first indented line in the given YAML bytes,
+// or DefaultIndent if there is none.
+func detectIndent(b []byte) int {
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		trimmed := bytes.TrimLeft(line, " ")
+		if len(trimmed) == 0 || trimmed[0] == '#' {
+			continue
+		}
+		if n := len(line) - len(trimmed); n > 0 {
+			return n
+		}
+	}
+	return DefaultIndent
+}
+
+// root returns the top-level node of the document.
+func (d *Document) root() *yamlv3.Node {
+	if d.node.Kind == yamlv3.DocumentNode && len(d.node.Content) > 0 {
+		return d.node.Content[0]
+	}
+	return d.node
+}
+
+// resolve follows aliases until a concrete node is found.
+func resolve(node *yamlv3.Node) *yamlv3.Node {
+	for node != nil && node.Kind == yamlv3.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// lookup returns the child of the node with the given key.
+// Keys inherited through merge keys ("<<") are included in the lookup.
+func lookup(node *yamlv3.Node, key string) *yamlv3.Node {
+	node = resolve(node)
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				return node.Content[i+1]
+			}
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Tag == "!!merge" {
+				merged := resolve(node.Content[i+1])
+				if merged.Kind == yamlv3.SequenceNode {
+					for _, m := range merged.Content {
+						if child := lookup(m, key); child != nil {
+							return child
+						}
+					}
+				} else if child := lookup(merged, key); child != nil {
+					return child
+				}
+			}
+		}
+	case yamlv3.SequenceNode:
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(node.Content) {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+// Node returns the YAML node at the given path.
+// If the path is empty, then returns the top-level node.
+// If no node exists at the path, then returns ErrPathNotFound.
+func (d *Document) Node(path []string) (*yamlv3.Node, error) {
+	node := d.root()
+	for i, key := range path {
+		node = lookup(node, key)
+		if node == nil {
+			return nil, &ErrPathNotFound{Path: path[:i+1]}
+		}
+	}
+	return resolve(node), nil
+}
+
+// Get returns the decoded value at the given path.
+// If no value exists at the path, then returns ErrPathNotFound.
+func (d *Document) Get(path []string) (interface{}, error) {
+	node, err := d.Node(path)
+	if err != nil {
+		return nil, err
+	}
+	var obj interface{}
+	err = node.Decode(&obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding value at path %q", strings.Join(path, "."))
+	}
+	return obj, nil
+}
+
+// Set sets the value at the given path, creating intermediate mappings as needed.
+// If a value already exists at the path, then the value is replaced in place,
+// keeping its comments, anchor, and (for strings) its quoting style.
+// An index equal to the length of a sequence appends to the sequence.
+func (d *Document) Set(path []string, value interface{}) error {
+	if len(path) == 0 {
+		return ErrEmptyPath
+	}
+
+	replacement := &yamlv3.Node{}
+	err := replacement.Encode(value)
+	if err != nil {
+		return errors.Wrapf(err, "error encoding value for path %q", strings.Join(path, "."))
+	}
+
+	parent := d.root()
+	for i, key := range path {
+		parent = resolve(parent)
+		last := i == len(path)-1
+		switch parent.Kind {
+		case yamlv3.MappingNode:
+			var child *yamlv3.Node
+			for j := 0; j+1 < len(parent.Content); j += 2 {
+				if parent.Content[j].Value == key {
+					child = parent.Content[j+1]
+					break
+				}
+			}
+			if child == nil {
+				// A key inherited through a merge key is overridden by an explicit copy,
+				// so that the other values inherited under the key are kept.
+				child = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+				if inherited := lookup(parent, key); inherited != nil && !last {
+					child = copyNode(resolve(inherited))
+				}
+				parent.Content = append(parent.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, child)
+			}
+			if last {
+				replace(child, replacement)
+				return nil
+			}
+			parent = child
+		case yamlv3.SequenceNode:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index > len(parent.Content) {
+				return &ErrPathNotFound{Path: path[:i+1]}
+			}
+			if index == len(parent.Content) {
+				parent.Content = append(parent.Content, &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"})
+			}
+			if last {
+				replace(parent.Content[index], replacement)
+				return nil
+			}
+			parent = parent.Content[index]
+		default:
+			if parent.Tag == "!!null" {
+				// Replace a null value with a new mapping.
+				*parent = yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map", HeadComment: parent.HeadComment, LineComment: parent.LineComment, FootComment: parent.FootComment}
+				parent.Content = append(parent.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key})
+				child := &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+				parent.Content = append(parent.Content, child)
+				if last {
+					replace(child, replacement)
+					return nil
+				}
+				parent = child
+				continue
+			}
+			return &ErrPathNotFound{Path: path[:i+1]}
+		}
+	}
+	return nil
+}
+
+// copyNode returns a deep copy of the given node.
+func copyNode(node *yamlv3.Node) *yamlv3.Node {
+	c := *node
+	c.Anchor = ""
+	c.Content = make([]*yamlv3.Node, 0, len(node.Content))
+	for _, child := range node.Content {
+		c.Content = append(c.Content, copyNode(child))
+	}
+	return &c
+}
+
+// replace replaces the contents of node with replacement in place,
+// so that aliases referring to the node continue to refer to it.
+// Comments and the anchor of the original node are kept.
+func replace(node *yamlv3.Node, replacement *yamlv3.Node) {
+	style := node.Style
+	headComment, lineComment, footComment := node.HeadComment, node.LineComment, node.FootComment
+	anchor := node.Anchor
+	wasString := node.Kind == yamlv3.ScalarNode && node.Tag == "!!str"
+	*node = *replacement
+	node.HeadComment, node.LineComment, node.FootComment = headComment, lineComment, footComment
+	node.Anchor = anchor
+	if wasString && node.Kind == yamlv3.ScalarNode && node.Tag == "!!str" && style&(yamlv3.DoubleQuotedStyle|yamlv3.SingleQuotedStyle) != 0 {
+		node.Style = style
+	}
+}
+
+// Delete removes the value at the given path.
+// If no value exists at the path, then returns ErrPathNotFound.
+func (d *Document) Delete(path []string) error {
+	if len(path) == 0 {
+		return ErrEmptyPath
+	}
+	parent, err := d.Node(path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	key := path[len(path)-1]
+	switch parent.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(parent.Content); i += 2 {
+			if parent.Content[i].Value == key {
+				parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+				return nil
+			}
+		}
+	case yamlv3.SequenceNode:
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(parent.Content) {
+			parent.Content = append(parent.Content[:i], parent.Content[i+1:]...)
+			return nil
+		}
+	}
+	return &ErrPathNotFound{Path: path}
+}
+
+// Bytes returns the document formatted as YAML.
+func (d *Document) Bytes() ([]byte, error) {
+	b, err := marshalNode(d.node, d.indent)
+	if err != nil {
+		return nil, err
+	}
+	return d.restoreBlankLines(b)
+}
+
+// restoreBlankLines inserts the blank lines of the original document into the formatted document.
+// The encoder drops blank lines, so the formatted document is parsed again
+// and its nodes are matched with the nodes of the document.
+func (d *Document) restoreBlankLines(b []byte) ([]byte, error) {
+	if len(d.blank) == 0 {
+		return b, nil
+	}
+	formatted := &yamlv3.Node{}
+	err := yamlv3.Unmarshal(b, formatted)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing formatted YAML document")
+	}
+	lines := map[int]bool{}
+	var walk func(a *yamlv3.Node, b *yamlv3.Node)
+	walk = func(a *yamlv3.Node, b *yamlv3.Node) {
+		if len(a.Content) != len(b.Content) {
+			return
+		}
+		for i := range a.Content {
+			if d.blank[a.Content[i]] {
+				lines[firstLine(b.Content[i])] = true
+			}
+			walk(a.Content[i], b.Content[i])
+		}
+	}
+	walk(d.node, formatted)
+	buf := new(bytes.Buffer)
+	for i, line := range bytes.SplitAfter(b, []byte("\n")) {
+		if lines[i+1] {
+			buf.WriteByte('\n')
+		}
+		buf.Write(line)
+	}
+	return buf.Bytes(), nil
+}
+
+// NodeBytes returns the node at the given path formatted as YAML, including comments.
+func (d *Document) NodeBytes(path []string) ([]byte, error) {
+	node, err := d.Node(path)
+	if err != nil {
+		return nil, err
+	}
+	return marshalNode(node, d.indent)
+}
+
+// marshalNode formats the node as YAML with the given indent.
+func marshalNode(node *yamlv3.Node, indent int) ([]byte, error) {
+	// The encoder writes merge keys with an explicit "!!merge" tag, so the tag is cleared while encoding.
+	merges := make([]*yamlv3.Node, 0)
+	var walk func(n *yamlv3.Node)
+	walk = func(n *yamlv3.Node) {
+		if n.Kind == yamlv3.ScalarNode && n.Tag == "!!merge" {
+			n.Tag = ""
+			merges = append(merges, n)
+		}
+		for _, child := range n.Content {
+			walk(child)
+		}
+	}
+	walk(node)
+	defer func() {
+		for _, n := range merges {
+			n.Tag = "!!merge"
+		}
+	}()
+	buf := new(bytes.Buffer)
+	e := yamlv3.NewEncoder(buf)
+	e.SetIndent(indent)
+	err := e.Encode(node)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding YAML document")
+	}
+	err = e.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "error closing YAML encoder")
+	}
+	return buf.Bytes(), nil
+}
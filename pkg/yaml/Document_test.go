@@ -0,0 +1,123 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const values = `# Default values
+replicaCount: 1 # number of pods
+
+defaults: &defaults
+  pullPolicy: IfNotPresent
+
+image:
+  <<: *defaults
+  # the image repository
+  repository: "nginx"
+  tag: stable
+
+hosts:
+  - a.example.com
+  - b.example.com
+`
+
+func TestDocumentGet(t *testing.T) {
+	d, err := NewDocument([]byte(values))
+	require.NoError(t, err)
+
+	obj, err := d.Get([]string{"replicaCount"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, obj)
+
+	obj, err = d.Get([]string{"image", "pullPolicy"})
+	assert.NoError(t, err)
+	assert.Equal(t, "IfNotPresent", obj)
+
+	obj, err = d.Get([]string{"hosts", "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "b.example.com", obj)
+
+	obj, err = d.Get([]string{"image", "missing"})
+	assert.IsType(t, &ErrPathNotFound{}, err)
+	assert.Nil(t, obj)
+}
+
+func TestDocumentSet(t *testing.T) {
+	d, err := NewDocument([]byte(values))
+	require.NoError(t, err)
+
+	require.NoError(t, d.Set([]string{"replicaCount"}, 3))
+	require.NoError(t, d.Set([]string{"image", "repository"}, "httpd"))
+	require.NoError(t, d.Set([]string{"hosts", "2"}, "c.example.com"))
+	require.NoError(t, d.Set([]string{"resources", "limits", "cpu"}, "100m"))
+
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	expected := `# Default values
+replicaCount: 3 # number of pods
+
+defaults: &defaults
+  pullPolicy: IfNotPresent
+
+image:
+  <<: *defaults
+  # the image repository
+  repository: "httpd"
+  tag: stable
+
+hosts:
+  - a.example.com
+  - b.example.com
+  - c.example.com
+resources:
+  limits:
+    cpu: 100m
+`
+	assert.Equal(t, expected, string(b))
+}
+
+func TestDocumentDelete(t *testing.T) {
+	d, err := NewDocument([]byte(values))
+	require.NoError(t, err)
+
+	require.NoError(t, d.Delete([]string{"image", "tag"}))
+	require.NoError(t, d.Delete([]string{"hosts", "0"}))
+	assert.IsType(t, &ErrPathNotFound{}, d.Delete([]string{"image", "tag"}))
+
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	expected := `# Default values
+replicaCount: 1 # number of pods
+
+defaults: &defaults
+  pullPolicy: IfNotPresent
+
+image:
+  <<: *defaults
+  # the image repository
+  repository: "nginx"
+
+hosts:
+  - b.example.com
+`
+	assert.Equal(t, expected, string(b))
+}
+
+func TestDocumentEmpty(t *testing.T) {
+	d, err := NewDocument([]byte{})
+	require.NoError(t, err)
+	require.NoError(t, d.Set([]string{"a", "b"}, true))
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "a:\n  b: true\n", string(b))
+}
@@ -0,0 +1,24 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"fmt"
+)
+
+// ErrDuplicateKey is used when a mapping includes the same key more than once.
+type ErrDuplicateKey struct {
+	Key    string // the duplicate key
+	Line   int    // the line of the duplicate key, starting at 1
+	Column int    // the column of the duplicate key, starting at 1
+}
+
+// Error returns the error formatted as a string.
+func (e ErrDuplicateKey) Error() string {
+	return fmt.Sprintf("duplicate key %q at line %d, column %d", e.Key, e.Line, e.Column)
+}
@@ -8,11 +8,35 @@
 package yaml
 
 import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+
 	goyaml "gopkg.in/yaml.v2" // import the YAML library from https://github.com/go-yaml/yaml
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
 )
 
 // Marshal formats an object into a slice of bytes of YAML.
-//
+// Numbers given as json.Number are written with their original text, so that no precision is lost,
+// since the YAML library converts them into int64 or float64.
 func Marshal(obj interface{}) ([]byte, error) {
-	return goyaml.Marshal(obj)
+	prefix := newPlaceholder()
+	numbers := make([]string, 0)
+	obj = number.Replace(obj, func(value interface{}) (interface{}, bool) {
+		if n, ok := value.(json.Number); ok && len(n) > 0 {
+			numbers = append(numbers, n.String())
+			return prefix + strconv.Itoa(len(numbers)-1), true
+		}
+		return nil, false
+	})
+	b, err := goyaml.Marshal(obj)
+	if err != nil || len(numbers) == 0 {
+		return b, err
+	}
+	expression := regexp.MustCompile(regexp.QuoteMeta(prefix) + "[0-9]+")
+	return expression.ReplaceAllFunc(b, func(m []byte) []byte {
+		i, _ := strconv.Atoi(string(m[len(prefix):]))
+		return []byte(numbers[i])
+	}), nil
 }
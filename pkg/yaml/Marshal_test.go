@@ -8,6 +8,7 @@
 package yaml
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -71,3 +72,9 @@ func TestMarshalStruct(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "a: \"1\"\nb: \"2\"\nc: \"3\"\n", string(b))
 }
+
+func TestMarshalNumber(t *testing.T) {
+	b, err := Marshal(map[string]interface{}{"a": json.Number("92233720368547758070"), "b": []interface{}{json.Number("2.50")}})
+	assert.NoError(t, err)
+	assert.Equal(t, "a: 92233720368547758070\nb:\n- 2.50\n", string(b))
+}
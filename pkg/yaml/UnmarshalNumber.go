@@ -0,0 +1,121 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3" // import the YAML node library from https://github.com/go-yaml/yaml/tree/v3
+
+	"github.com/spatialcurrent/go-simple-serializer/pkg/number"
+)
+
+// UnmarshalNumber parses a slice of bytes into an object like Unmarshal,
+// but decodes numbers using the given number mode, so that large integers do not lose precision.
+// The document is first parsed into YAML nodes, and the text of each integer and float scalar is parsed with number.Parse.
+// If the mode is empty or float, then simply uses Unmarshal.
+// See the number package for the supported modes.
+func UnmarshalNumber(b []byte, mode string) (interface{}, error) {
+
+	if len(mode) == 0 || mode == number.ModeFloat {
+		return Unmarshal(b)
+	}
+
+	if len(b) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	if bytes.HasPrefix(b, BoundaryMarker) {
+		s := NewDocumentScanner(bytes.NewReader(b), true)
+		obj := make([]interface{}, 0)
+		i := 0
+		for s.Scan() {
+			if d := s.Bytes(); len(d) > 0 {
+				element, err := UnmarshalNumber(d, mode)
+				if err != nil {
+					return obj, errors.Wrapf(err, "error scanning document %d", i)
+				}
+				obj = append(obj, element)
+				i++
+			}
+		}
+		if err := s.Err(); err != nil {
+			return obj, errors.Wrap(err, fmt.Sprintf("error scanning YAML %q", string(b)))
+		}
+		return obj, nil
+	}
+
+	node := &yamlv3.Node{}
+	err := yamlv3.Unmarshal(b, node)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error unmarshaling YAML %q", string(b)))
+	}
+
+	// Replace each number with a quoted placeholder, so that the YAML library does not parse it.
+	prefix := newPlaceholder()
+	numbers := map[string]string{}
+	replaceNumbers(node, prefix, numbers)
+
+	if len(numbers) > 0 {
+		b, err = yamlv3.Marshal(node)
+		if err != nil {
+			return nil, errors.Wrap(err, "error formatting YAML nodes")
+		}
+	}
+
+	obj, err := Unmarshal(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(numbers) > 0 {
+		obj = number.Replace(obj, func(value interface{}) (interface{}, bool) {
+			if str, ok := value.(string); ok {
+				if n, ok := numbers[str]; ok {
+					return json.Number(n), true
+				}
+			}
+			return nil, false
+		})
+	}
+
+	return number.Convert(obj, mode)
+}
+
+// replaceNumbers replaces the integer and float scalars in the node with quoted placeholders
+// and adds the normalized text of each number to the map of numbers.
+// Keys and aliases are left as is.
+func replaceNumbers(node *yamlv3.Node, prefix string, numbers map[string]string) {
+	switch node.Kind {
+	case yamlv3.DocumentNode, yamlv3.SequenceNode:
+		for _, child := range node.Content {
+			replaceNumbers(child, prefix, numbers)
+		}
+	case yamlv3.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			replaceNumbers(node.Content[i], prefix, numbers)
+		}
+	case yamlv3.ScalarNode:
+		if tag := node.ShortTag(); tag != "!!int" && tag != "!!float" {
+			return
+		}
+		n, ok := number.Normalize(node.Value)
+		if !ok {
+			return
+		}
+		placeholder := prefix + strconv.Itoa(len(numbers))
+		numbers[placeholder] = n
+		node.Tag = "!!str"
+		node.Value = placeholder
+		node.Style = yamlv3.DoubleQuotedStyle
+	}
+}
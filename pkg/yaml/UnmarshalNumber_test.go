@@ -0,0 +1,57 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalNumber(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("id: 92233720368547758070\nx: 0x1F\nf: .5\nb: yes\ns: \"1\"\nl: [1, 2.50]\n"), "number")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id": json.Number("92233720368547758070"),
+		"x":  json.Number("31"),
+		"f":  json.Number("0.5"),
+		"b":  true,
+		"s":  "1",
+		"l":  []interface{}{json.Number("1"), json.Number("2.50")},
+	}, obj)
+}
+
+func TestUnmarshalNumberBig(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("a: &a 92233720368547758070\nb: *a\n"), "big")
+	assert.NoError(t, err)
+	i, _ := new(big.Int).SetString("92233720368547758070", 10)
+	assert.Equal(t, map[string]interface{}{"a": i, "b": i}, obj)
+}
+
+func TestUnmarshalNumberNaN(t *testing.T) {
+	for _, mode := range []string{"float", "number", "int64", "big"} {
+		t.Run(mode, func(t *testing.T) {
+			obj, err := UnmarshalNumber([]byte("a: .nan\nb: .inf\nc: -.inf\n"), mode)
+			assert.NoError(t, err)
+			m, ok := obj.(map[string]interface{})
+			assert.True(t, ok)
+			assert.True(t, math.IsNaN(m["a"].(float64)))
+			assert.Equal(t, math.Inf(1), m["b"])
+			assert.Equal(t, math.Inf(-1), m["c"])
+		})
+	}
+}
+
+func TestUnmarshalNumberDocuments(t *testing.T) {
+	obj, err := UnmarshalNumber([]byte("---\n92233720368547758070\n---\na: 1\n"), "number")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{json.Number("92233720368547758070"), map[string]interface{}{"a": json.Number("1")}}, obj)
+}
@@ -0,0 +1,96 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	goyaml "gopkg.in/yaml.v2" // import the YAML library from https://github.com/go-yaml/yaml
+	yamlv3 "gopkg.in/yaml.v3" // import the YAML node library from https://github.com/go-yaml/yaml/tree/v3
+)
+
+// Validate checks that the slice of bytes is YAML that can be decoded without ambiguity,
+// and returns an error describing the first problem found, if any.
+// Validate is used by the serializer when strict mode is enabled.
+//
+//  - Invalid UTF-8 returns ErrInvalidUTF8 wrapped with the location of the invalid byte.
+//  - Mappings with the same key more than once return ErrDuplicateKey.
+//  - If the output type is a struct, then keys that do not match a struct field return an error.
+func Validate(b []byte, outputType reflect.Type) error {
+
+	if len(b) == 0 {
+		return ErrEmptyInput
+	}
+
+	if !utf8.Valid(b) {
+		offset := 0
+		for offset < len(b) {
+			r, size := utf8.DecodeRune(b[offset:])
+			if r == utf8.RuneError && size == 1 {
+				break
+			}
+			offset += size
+		}
+		line := bytes.Count(b[:offset], []byte("\n")) + 1
+		column := offset - bytes.LastIndexByte(b[:offset], '\n')
+		return errors.Wrapf(ErrInvalidUTF8, "invalid byte at line %d, column %d", line, column)
+	}
+
+	d := yamlv3.NewDecoder(bytes.NewReader(b))
+	for {
+		node := &yamlv3.Node{}
+		err := d.Decode(node)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errors.Wrap(err, "invalid YAML")
+		}
+		err = checkKeys(node)
+		if err != nil {
+			return err
+		}
+	}
+
+	if outputType != nil && outputType.Kind() == reflect.Struct {
+		err := goyaml.UnmarshalStrict(b, reflect.New(outputType).Interface())
+		if err != nil {
+			return errors.Wrapf(err, "error decoding YAML into %q", outputType)
+		}
+	}
+
+	return nil
+}
+
+// checkKeys returns ErrDuplicateKey if a mapping within the node includes the same scalar key twice.
+func checkKeys(node *yamlv3.Node) error {
+	if node.Kind == yamlv3.MappingNode {
+		keys := map[string]struct{}{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yamlv3.ScalarNode || key.Tag == "!!merge" {
+				continue
+			}
+			if _, ok := keys[key.Value]; ok {
+				return &ErrDuplicateKey{Key: key.Value, Line: key.Line, Column: key.Column}
+			}
+			keys[key.Value] = struct{}{}
+		}
+	}
+	for _, child := range node.Content {
+		err := checkKeys(child)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
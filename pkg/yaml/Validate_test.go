@@ -0,0 +1,40 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	err := Validate([]byte("a: 1\nb:\n  a: 2\n---\na: 3\n"), nil)
+	assert.NoError(t, err)
+}
+
+func TestValidateDuplicateKey(t *testing.T) {
+	err := Validate([]byte("a: 1\nb:\n  c: 2\n  c: 3\n"), nil)
+	assert.Equal(t, &ErrDuplicateKey{Key: "c", Line: 4, Column: 3}, err)
+}
+
+func TestValidateInvalidUTF8(t *testing.T) {
+	err := Validate([]byte("a: \"\xff\"\n"), nil)
+	assert.Equal(t, ErrInvalidUTF8, errors.Cause(err))
+}
+
+func TestValidateUnknownField(t *testing.T) {
+	type point struct {
+		X int `yaml:"x"`
+		Y int `yaml:"y"`
+	}
+	assert.NoError(t, Validate([]byte("x: 1\ny: 2\n"), reflect.TypeOf(point{})))
+	assert.Error(t, Validate([]byte("x: 1\nz: 2\n"), reflect.TypeOf(point{})))
+}
@@ -0,0 +1,21 @@
+// =================================================================
+//
+// Copyright (C) 2019 Spatial Current, Inc. - All Rights Reserved
+// Released as open source under the MIT License.  See LICENSE file.
+//
+// =================================================================
+
+package yaml
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// newPlaceholder returns a random prefix for the strings that temporarily replace numbers,
+// so that the text of each number is kept while the YAML library encodes or decodes the rest of the document.
+func newPlaceholder() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return "gss-number-" + hex.EncodeToString(b) + "-"
+}
@@ -25,5 +25,7 @@ var (
 
 var (
 	ErrEmptyInput  = errors.New("empty input")
+	ErrEmptyPath   = errors.New("empty path")
 	ErrInvalidRune = errors.New("invalid rune")
+	ErrInvalidUTF8 = errors.New("invalid utf-8")
 )
@@ -11,7 +11,7 @@ set -euo pipefail
 
 DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
 
-expectedFormats="bson,csv,fmt,go,gob,hcl,json,jsonl,properties,tags,toml,tsv,yaml"
+expectedFormats="avro,bson,cbor,csv,dotenv,env,fixedwidth,fmt,geojson,geojsonl,go,gob,hcl,html,ini,json,json5,jsonl,jsonseq,logfmt,markdown,msgpack,parquet,plist,properties,protobuf,regex,sql,table,tags,toml,tsv,xlsx,xml,yaml"
 
 testFormats() {
   formats=$(gss formats -f csv)
@@ -63,6 +63,34 @@ testJSONLTags() {
   assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
 }
 
+testJSONLTable() {
+  local input='{"a":"x","b":1}\n{"a":"yy","b":22}'
+  local expected='+----+----+\n| a  |  b |\n+----+----+\n| x  |  1 |\n| yy | 22 |\n+----+----+'
+  local output=$(echo -e "${input}" | gss -i jsonl -o table -s)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testJSONLMarkdownStream() {
+  local input='{"a":"x","b":1}\n{"a":"yy","b":22}'
+  local expected='| a   | b   |\n| --- | --- |\n| x   |   1 |\n| yy  |  22 |'
+  local output=$(echo -e "${input}" | gss -i jsonl -o markdown --output-header a,b)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testFixedWidthJSONL() {
+  local input='0001alice\n0002bob'
+  local expected='{"id":"0001","name":"alice"}\n{"id":"0002","name":"bob"}'
+  local output=$(echo -e "${input}" | gss -i fixedwidth --input-header id:0:4,name:4:0 -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testJSONLFixedWidth() {
+  local input='{"id":1,"name":"alice"}\n{"id":2,"name":"bob"}'
+  local expected='1   alice\n2   bob  '
+  local output=$(echo -e "${input}" | gss -i jsonl -o fixedwidth --output-header id:0:4,name:4:5)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
 testJSONLFmt() {
   local input='{"a":"x"}\n{"b":"y"}\n{"c":"z"}'
   local expected='map[a:x]\nmap[b:y]\nmap[c:z]'
@@ -85,6 +113,118 @@ testJSONGoFit() {
 }
 
 
+testJSONSeqJSONL() {
+  local input='\x1e{"a":"x"}\n\x1e{\n  "b": "y"\n}\n\x1e{"c":'
+  local expected='{"a":"x"}\n{"b":"y"}'
+  local output=$(echo -ne "${input}" | gss -i jsonseq -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testJSONLAvroJSONL() {
+  local input='{"a":"x","b":1}\n{"a":"y","c":[true]}'
+  local expected='{"a":"x","b":1,"c":null}\n{"a":"y","b":null,"c":[true]}'
+  local output=$(echo -e "${input}" | gss -i jsonl -o avro --output-codec deflate | gss -i avro -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testCSVParquetJSONL() {
+  local input='a,b,c\nx,1,true\ny,2,false'
+  local expected='{"a":"x","c":true}\n{"a":"y","c":false}'
+  echo -e "${input}" | gss -i csv -o parquet > "${SHUNIT_TMPDIR}/test.parquet"
+  local output=$(gss -i parquet --input-uri "${SHUNIT_TMPDIR}/test.parquet" --input-columns a,c -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testCSVXLSXJSONL() {
+  local input='region,name\nnorth,a\nsouth,b\nnorth,c'
+  local expected='{"name":"a","region":"north"}\n{"name":"c","region":"north"}'
+  local output=$(echo -e "${input}" | gss -i csv -o xlsx --output-sheet-key region | gss -i xlsx --input-sheet north -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testCSVSQL() {
+  local input='a,b\nx,1\ny,'
+  local expected='INSERT INTO "t" ("a", "b") VALUES\n(\x27x\x27, 1),\n(\x27y\x27, NULL);'
+  local output=$(echo -e "${input}" | gss -i csv -o sql --output-table t)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testJSONPlistJSON() {
+  local input='{"a":"x","b":[1,2.5,true]}'
+  local expected='{"a":"x","b":[1,2.5,true]}'
+  local output=$(echo -e "${input}" | gss -i json -o plist --output-plist-format binary | gss -i plist -o json)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testCSVGeoJSONCSV() {
+  local input='name,lon,lat\na,1.5,2\nb,,'
+  local expected='name,lat,lon\na,2,1.5\nb,,'
+  local output=$(echo -e "${input}" | gss -i csv -o geojson | gss -i geojson --input-flatten -o csv --output-sorted)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testJSONLBSONJSONL() {
+  local input='{"a":"x","b":1}\n{"a":"y","b":2}'
+  local expected='{"a":"x","b":{"$numberDouble":"1.0"}}\n{"a":"y","b":{"$numberDouble":"2.0"}}'
+  local output=$(echo -e "${input}" | gss -i jsonl -o bson | gss -i bson -o jsonl --output-extended-json canonical)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testJSON5JSON() {
+  local input="{\n  // comment\n  a: \x27x\x27,\n  b: [0x10, .5,],\n}"
+  local expected='{"a":"x","b":[16,0.5]}'
+  local output=$(echo -e "${input}" | gss -i json5 -o json)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testLatin1CSVJSONL() {
+  local input='name\ncaf\xe9'
+  local expected='{"name":"caf\xc3\xa9"}'
+  local output=$(echo -e "${input}" | gss -i csv --input-encoding latin1 -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testStrictUTF8JSON() {
+  local output
+  output=$(printf '{"a":"\xff"}' | gss -i json --input-encoding utf-8 --input-strict -o json 2>&1)
+  assertNotEquals "expected invalid utf-8 to fail" "0" "$?"
+}
+
+testJSONJSONNumberBig() {
+  local input='{"f":1.5,"id":92233720368547758070}'
+  local expected='{"f":1.5,"id":92233720368547758070}'
+  local output=$(echo "${input}" | gss -i json -o json --input-number big)
+  assertEquals "unexpected output" "${expected}" "${output}"
+}
+
+testJSONBSONNumberBig() {
+  local input='{"f":1.5,"id":12345678901234567890123}'
+  local expected='{"f":1.5,"id":12345678901234567890123}'
+  local output=$(echo "${input}" | gss -i json -o bson --input-number big | gss -i bson -o json --input-number big)
+  assertEquals "unexpected output" "${expected}" "${output}"
+}
+
+testJSONLJSONSeq() {
+  local input='{"a":"x"}\n{"b":"y"}'
+  local expected='\x1e{"a":"x"}\n\x1e{"b":"y"}'
+  local output=$(echo -e "${input}" | gss -i jsonl -o jsonseq)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testRegexJSONL() {
+  local input='INFO: started\nnot a match\nWARN: disk full'
+  local expected='{"level":"INFO","message":"started"}\n{"level":"WARN","message":"disk full"}'
+  local output=$(echo -e "${input}" | gss -i regex --input-pattern '^(?P<level>[A-Z]+): (?P<message>.*)$' -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
+testRegexNoMatchRaw() {
+  local input='INFO: started\nnot a match'
+  local expected='{"level":"INFO","message":"started"}\n{"raw":"not a match"}'
+  local output=$(echo -e "${input}" | gss -i regex --input-pattern '^(?P<level>[A-Z]+): (?P<message>.*)$' --input-no-match raw -o jsonl)
+  assertEquals "unexpected output" "$(echo -e "${expected}")" "${output}"
+}
+
 testHCLJSON() {
   local expected='{"data":[{"aws_caller_identity":[{"current":[{}]}]}]}'
   local output=$(echo 'data "aws_caller_identity" "current" {}' | gss -i  hcl -o json)